@@ -3,7 +3,10 @@ package v1
 import (
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "kubevirt.io/api/core/v1"
 	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
@@ -19,16 +22,50 @@ const HyperConvergedName = "kubevirt-hyperconverged"
 type HyperConvergedUninstallStrategy string
 
 const (
-	HyperConvergedUninstallStrategyRemoveWorkloads                HyperConvergedUninstallStrategy = "RemoveWorkloads"
-	HyperConvergedUninstallStrategyBlockUninstallIfWorkloadsExist HyperConvergedUninstallStrategy = "BlockUninstallIfWorkloadsExist"
+	HyperConvergedUninstallStrategyRemoveWorkloads                  HyperConvergedUninstallStrategy = "RemoveWorkloads"
+	HyperConvergedUninstallStrategyBlockUninstallIfWorkloadsExist   HyperConvergedUninstallStrategy = "BlockUninstallIfWorkloadsExist"
+	HyperConvergedUninstallStrategyBlockUninstallAndReportWorkloads HyperConvergedUninstallStrategy = "BlockUninstallAndReportWorkloads"
+)
+
+// UninstallBackupStorageKind is the kind of the objects that hold the backup of the workloads manifests
+// +kubebuilder:validation:Enum=Secret;ConfigMap
+type UninstallBackupStorageKind string
+
+const (
+	UninstallBackupStorageKindSecret    UninstallBackupStorageKind = "Secret"
+	UninstallBackupStorageKindConfigMap UninstallBackupStorageKind = "ConfigMap"
+)
+
+// TopologySpreadPolicy defines whether to spread the replicas of a Deployment across a topology domain
+// +kubebuilder:validation:Enum=Auto;Enabled;Disabled
+type TopologySpreadPolicy string
+
+const (
+	// TopologySpreadPolicyAuto spreads the replicas if the cluster nodes span more than one topology domain
+	TopologySpreadPolicyAuto TopologySpreadPolicy = "Auto"
+	// TopologySpreadPolicyEnabled always spreads the replicas
+	TopologySpreadPolicyEnabled TopologySpreadPolicy = "Enabled"
+	// TopologySpreadPolicyDisabled never spreads the replicas
+	TopologySpreadPolicyDisabled TopologySpreadPolicy = "Disabled"
 )
 
 type HyperConvergedTuningPolicy string
 
-// HyperConvergedAnnotationTuningPolicy defines a static configuration of the kubevirt query per seconds (qps) and burst values
-// through annotation values.
 const (
+	// HyperConvergedAnnotationTuningPolicy defines a static configuration of the kubevirt query per seconds (qps) and
+	// burst values through annotation values.
 	HyperConvergedAnnotationTuningPolicy HyperConvergedTuningPolicy = "annotation"
+	// HyperConvergedSmallTuningPolicy defines predefined rate limits, suitable for small clusters
+	HyperConvergedSmallTuningPolicy HyperConvergedTuningPolicy = "small"
+	// HyperConvergedMediumTuningPolicy defines predefined rate limits, suitable for medium-sized clusters
+	HyperConvergedMediumTuningPolicy HyperConvergedTuningPolicy = "medium"
+	// HyperConvergedLargeTuningPolicy defines predefined rate limits, suitable for large clusters, with high VM density
+	HyperConvergedLargeTuningPolicy HyperConvergedTuningPolicy = "large"
+	// HyperConvergedCustomTuningPolicy uses the rate limits from the spec.virtualization.tuning field
+	HyperConvergedCustomTuningPolicy HyperConvergedTuningPolicy = "custom"
+	// HyperConvergedAutoTuningPolicy uses the rate limits that HCO recommends, according to the cluster size, as
+	// reported in the status.tuningRecommendation field
+	HyperConvergedAutoTuningPolicy HyperConvergedTuningPolicy = "auto"
 )
 
 // HyperConvergedSpec defines the desired state of HyperConverged
@@ -147,6 +184,11 @@ type HyperConvergedSpec struct {
 	// +k8s:conversion-gen=false
 	FeatureGates featuregates.HyperConvergedFeatureGates `json:"featureGates,omitempty"`
 
+	// FeatureGatesPolicy defines how HCO enforces the lifecycle phases of the feature gates in the featureGates list
+	// +optional
+	// +k8s:conversion-gen=false
+	FeatureGatesPolicy *FeatureGatesPolicy `json:"featureGatesPolicy,omitempty"`
+
 	// Virtualization contains all the configurations for virtualization
 	// +kubebuilder:default={"liveMigrationConfig": {"completionTimeoutPerGiB": 20, "parallelMigrationsPerCluster": 5, "parallelOutboundMigrationsPerNode": 1, "progressTimeout": 150, "allowAutoConverge": false, "allowPostCopy": false, "allowWorkloadDisruption": false}, "virtualMachineOptions": {"disableFreePageReporting": false, "disableSerialConsoleLog": false}, "vmiCPUAllocationRatio": 10}
 	// +kubebuilder:validation:XValidation:rule="!has(self.vmiCPUAllocationRatio) || self.vmiCPUAllocationRatio > 0",message="vmiCPUAllocationRatio must be greater than 0"
@@ -181,6 +223,15 @@ type HyperConvergedSpec struct {
 	// +optional
 	// +k8s:conversion-gen=false
 	Observability *ObservabilityConfig `json:"observability,omitempty"`
+
+	// Overrides is a list of patches to apply to the operand CRs, on top of the configurations that HCO generates
+	// from the HyperConverged spec. The overrides are applied in the list order, and are validated against the
+	// generated operand CRs when the HyperConverged CR is created or updated.
+	// Modifications done by the overrides won't be reconciled back by HCO to the opinionated defaults.
+	// +listType=atomic
+	// +optional
+	// +k8s:conversion-gen=false
+	Overrides []OperandOverride `json:"overrides,omitempty"`
 }
 
 // ObservabilityConfig contains configurations for the observability controller
@@ -203,6 +254,201 @@ type ObservabilityConfig struct {
 	// +listType=atomic
 	// +kubebuilder:validation:XValidation:rule="(self.size() <= 1) || !self.exists(r, (r == 'none'))",message="'none' cannot be combined with other values"
 	AllowedRecordingRules []string `json:"allowedRecordingRules,omitempty"`
+
+	// Silences is a list of Alertmanager silences that HCO creates, and keeps active for as long as they are in the
+	// list. A silence that is removed from the list is expired by HCO.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Silences []AlertSilence `json:"silences,omitempty"`
+
+	// AlertOverrides is a list of modifications of the alerts that HCO deploys, e.g. to change the threshold or the
+	// severity of an alert, or to add labels and annotations to it. The overrides are applied in the list order, to
+	// the PrometheusRules of both HCO and the observability controller.
+	// +optional
+	// +listType=atomic
+	AlertOverrides []AlertOverride `json:"alertOverrides,omitempty"`
+
+	// Alerting contains configurations that apply to all the alerts that HCO deploys, in the PrometheusRules of both
+	// HCO and the observability controller. The alertOverrides are applied on top of these configurations.
+	// +optional
+	Alerting *AlertingConfig `json:"alerting,omitempty"`
+
+	// Dashboards configures the Perses dashboards that HCO deploys, when the Perses operator is installed.
+	// +optional
+	Dashboards *DashboardsConfig `json:"dashboards,omitempty"`
+}
+
+// DashboardsConfig configures the Perses dashboards that HCO deploys
+// +k8s:openapi-gen=true
+type DashboardsConfig struct {
+	// DisabledDashboards is a list of the built-in dashboards that HCO should not deploy. HCO removes the disabled
+	// dashboards, if they were already deployed.
+	// +listType=set
+	// +optional
+	DisabledDashboards []BuiltInDashboard `json:"disabledDashboards,omitempty"`
+
+	// DatasourceName is the name of the Perses datasource that the built-in dashboards query. By default, the
+	// built-in dashboards query the default Prometheus datasource, that HCO deploys.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	DatasourceName string `json:"datasourceName,omitempty"`
+
+	// AdditionalDashboardConfigMaps is a list of names of ConfigMaps, in the HyperConverged namespace, that contain
+	// additional PersesDashboard manifests, one manifest in each key of the ConfigMap data. HCO deploys these
+	// dashboards in the HyperConverged namespace, alongside the built-in dashboards.
+	// +listType=set
+	// +optional
+	AdditionalDashboardConfigMaps []string `json:"additionalDashboardConfigMaps,omitempty"`
+}
+
+// BuiltInDashboard is the name of a Perses dashboard that HCO deploys
+// +kubebuilder:validation:Enum=cnv-node-memory-overview;cnv-virtual-machines-by-time-in-status;cnv-virtual-machines-inventory;cnv-virtual-machines-service-level;cnv-virtual-machines-top-consumers;cnv-virtual-machines-utilization
+type BuiltInDashboard string
+
+// AlertingConfig contains configurations that apply to all the alerts that HCO deploys
+// +k8s:openapi-gen=true
+type AlertingConfig struct {
+	// RunbookBaseURL replaces the location of the runbooks of the alerts, e.g. to point to runbooks that are hosted
+	// in a disconnected environment. The runbook_url annotation of each alert is set to the base URL, followed by
+	// the alert name. The alerts that link to a specific runbook document keep their runbook_url.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	RunbookBaseURL string `json:"runbookBaseURL,omitempty"`
+
+	// AdditionalLabels are added to the labels of all the alerts, e.g. to route the alerts by team, and replace the
+	// existing labels with the same names. Use severityMapping to modify the severities of the alerts.
+	// +optional
+	AdditionalLabels map[string]string `json:"additionalLabels,omitempty"`
+
+	// SeverityMapping replaces the severities of the alerts; e.g. to deploy all the critical alerts as warnings.
+	// +optional
+	// +listType=map
+	// +listMapKey=from
+	SeverityMapping []AlertSeverityMapping `json:"severityMapping,omitempty"`
+}
+
+// AlertSeverityMapping replaces an alert severity with another one
+// +k8s:openapi-gen=true
+type AlertSeverityMapping struct {
+	// From is the severity to replace
+	From AlertSeverity `json:"from"`
+
+	// To is the severity to use instead
+	To AlertSeverity `json:"to"`
+}
+
+// AlertSeverity is the severity of an alert
+// +kubebuilder:validation:Enum=critical;warning;info
+type AlertSeverity string
+
+const (
+	AlertSeverityCritical AlertSeverity = "critical"
+	AlertSeverityWarning  AlertSeverity = "warning"
+	AlertSeverityInfo     AlertSeverity = "info"
+)
+
+// AlertOverride modifies an alert that HCO deploys
+// +k8s:openapi-gen=true
+type AlertOverride struct {
+	// Alert is the name of the alert to modify
+	// +kubebuilder:validation:MinLength=1
+	Alert string `json:"alert"`
+
+	// MatchSeverity limits the override to the rule of the alert with this severity, for the alerts that are deployed
+	// with more than one severity. By default, all the rules of the alert are modified.
+	// +optional
+	MatchSeverity AlertSeverity `json:"matchSeverity,omitempty"`
+
+	// Threshold replaces the number that the alert expression is compared with, e.g. 0.9 in
+	// "instance:node_cpu_utilisation:rate1m >= 0.9". Only supported for the alerts whose expression ends with a
+	// comparison to a number.
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	// +optional
+	Threshold string `json:"threshold,omitempty"`
+
+	// For replaces the duration that the alert condition must be true, before the alert fires.
+	// This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)
+	// +optional
+	For *metav1.Duration `json:"for,omitempty"`
+
+	// Severity replaces the severity of the alert
+	// +optional
+	Severity AlertSeverity `json:"severity,omitempty"`
+
+	// Labels are added to the labels of the alert, and replace the existing labels with the same names. Use the
+	// severity field to modify the severity of the alert.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are added to the annotations of the alert, and replace the existing annotations with the same
+	// names, e.g. runbook_url.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// AlertSilence is an Alertmanager silence that is managed by HCO
+// +k8s:openapi-gen=true
+type AlertSilence struct {
+	// Name identifies the silence in the list. It is added to the comment of the Alertmanager silence.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Matchers is the list of the matchers of the silenced alerts. An alert is silenced if it matches all the
+	// matchers.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Matchers []AlertSilenceMatcher `json:"matchers"`
+
+	// Comment is a free text description of the silence
+	// +optional
+	Comment string `json:"comment,omitempty"`
+
+	// The 'duration' (i.e. lifetime) of each Alertmanager silence that HCO creates.
+	// This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)
+	// +kubebuilder:default="24h0m0s"
+	// +default="24h0m0s"
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// The amount of time before the end of the current Alertmanager silence, that HCO replaces it with a new one.
+	// Must be at least one hour, because the silences are checked hourly.
+	// This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)
+	// +kubebuilder:default="2h0m0s"
+	// +default="2h0m0s"
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// AlertSilenceMatcherType is the type of the comparison of an alert silence matcher
+// +kubebuilder:validation:Enum=Equal;NotEqual;Regex;NotRegex
+type AlertSilenceMatcherType string
+
+const (
+	AlertSilenceMatcherEqual    AlertSilenceMatcherType = "Equal"
+	AlertSilenceMatcherNotEqual AlertSilenceMatcherType = "NotEqual"
+	AlertSilenceMatcherRegex    AlertSilenceMatcherType = "Regex"
+	AlertSilenceMatcherNotRegex AlertSilenceMatcherType = "NotRegex"
+)
+
+// AlertSilenceMatcher matches an alert label
+// +k8s:openapi-gen=true
+type AlertSilenceMatcher struct {
+	// Name is the name of the alert label, e.g. alertname
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Value is the value, or the regular expression if the type is Regex or NotRegex, to compare the label with
+	Value string `json:"value"`
+
+	// Type is the type of the comparison; one of Equal, NotEqual, Regex and NotRegex
+	// +kubebuilder:default=Equal
+	// +default="Equal"
+	// +optional
+	Type AlertSilenceMatcherType `json:"type,omitempty"`
 }
 
 // ObservabilityWorkloadsConfig defines filtering for workload metrics
@@ -216,22 +462,202 @@ type ObservabilityWorkloadsConfig struct {
 	AllowedMetrics []string `json:"allowedMetrics,omitempty"`
 }
 
+// OperandOverrideTarget is the kind of the operand CR to patch
+// +kubebuilder:validation:Enum=KubeVirt;CDI;NetworkAddonsConfig;SSP
+type OperandOverrideTarget string
+
+const (
+	OperandOverrideTargetKubeVirt            OperandOverrideTarget = "KubeVirt"
+	OperandOverrideTargetCDI                 OperandOverrideTarget = "CDI"
+	OperandOverrideTargetNetworkAddonsConfig OperandOverrideTarget = "NetworkAddonsConfig"
+	OperandOverrideTargetSSP                 OperandOverrideTarget = "SSP"
+)
+
+// OperandOverridePatchType is the type of the patch of an operand override
+// +kubebuilder:validation:Enum=JSONPatch;StrategicMerge
+type OperandOverridePatchType string
+
+const (
+	OperandOverridePatchTypeJSONPatch      OperandOverridePatchType = "JSONPatch"
+	OperandOverridePatchTypeStrategicMerge OperandOverridePatchType = "StrategicMerge"
+)
+
+// OperandOverride is a patch to apply to the spec of one of the operand CRs
+// +kubebuilder:validation:XValidation:rule="has(self.type) && self.type == 'StrategicMerge' ? (has(self.strategicMergePatch) && !has(self.jsonPatch)) : (has(self.jsonPatch) && !has(self.strategicMergePatch))",message="jsonPatch must be set if type is JSONPatch, and strategicMergePatch must be set if type is StrategicMerge"
+type OperandOverride struct {
+	// Target is the kind of the operand CR to patch
+	Target OperandOverrideTarget `json:"target"`
+
+	// Type is the type of the patch; either JSONPatch or StrategicMerge
+	// +kubebuilder:default=JSONPatch
+	// +optional
+	Type OperandOverridePatchType `json:"type,omitempty"`
+
+	// JSONPatch is a list of JSON patch operations, as defined in RFC6902, to apply to the operand CR. Only the
+	// spec fields can be patched.
+	// +listType=atomic
+	// +optional
+	JSONPatch []JSONPatchOperation `json:"jsonPatch,omitempty"`
+
+	// StrategicMergePatch is a partial operand CR, that only contains the spec field, to merge into the operand CR.
+	// +optional
+	StrategicMergePatch *apiextensionsv1.JSON `json:"strategicMergePatch,omitempty"`
+}
+
+// JSONPatchOperation is a single JSON patch operation, as defined in RFC6902
+type JSONPatchOperation struct {
+	// Op is the patch operation
+	// +kubebuilder:validation:Enum=add;remove;replace;move;copy;test
+	Op string `json:"op"`
+
+	// Path is the JSON pointer to the modified field. It must point to a field under the spec field.
+	// +kubebuilder:validation:Pattern=`^/spec/`
+	Path string `json:"path"`
+
+	// From is the JSON pointer to the source field of the move and copy operations
+	// +optional
+	From string `json:"from,omitempty"`
+
+	// Value is the value to set, for the add, replace and test operations
+	// +optional
+	Value *apiextensionsv1.JSON `json:"value,omitempty"`
+}
+
+// EffectiveOperandOverride describes an operand override that is currently applied to its target operand CR
+type EffectiveOperandOverride struct {
+	// Index is the index of the override in the spec.overrides list
+	Index int `json:"index"`
+
+	// Target is the kind of the patched operand CR
+	Target OperandOverrideTarget `json:"target"`
+
+	// Type is the type of the patch
+	Type OperandOverridePatchType `json:"type"`
+}
+
+// TuningConfig defines the client rate limits of the KubeVirt components
+type TuningConfig struct {
+	// API is the rate limit of virt-api
+	// +optional
+	API *RateLimit `json:"api,omitempty"`
+
+	// Controller is the rate limit of virt-controller
+	// +optional
+	Controller *RateLimit `json:"controller,omitempty"`
+
+	// Handler is the rate limit of virt-handler
+	// +optional
+	Handler *RateLimit `json:"handler,omitempty"`
+
+	// Webhook is the rate limit of the virt-api webhooks
+	// +optional
+	Webhook *RateLimit `json:"webhook,omitempty"`
+}
+
+// RateLimit defines the token bucket rate limiter of a kubernetes API client
+type RateLimit struct {
+	// QPS is the number of queries per second the client may send
+	// +kubebuilder:validation:Minimum=1
+	QPS int32 `json:"qps"`
+
+	// Burst is the maximum number of queries the client may send at once, above the QPS rate
+	// +kubebuilder:validation:Minimum=1
+	Burst int32 `json:"burst"`
+}
+
+// EffectiveTuning describes the client rate limits that HCO currently sets for the KubeVirt components
+type EffectiveTuning struct {
+	// Policy is the tuning policy the rate limits are taken from
+	Policy HyperConvergedTuningPolicy `json:"policy"`
+
+	// API is the rate limit of virt-api
+	// +optional
+	API *EffectiveRateLimit `json:"api,omitempty"`
+
+	// Controller is the rate limit of virt-controller
+	// +optional
+	Controller *EffectiveRateLimit `json:"controller,omitempty"`
+
+	// Handler is the rate limit of virt-handler
+	// +optional
+	Handler *EffectiveRateLimit `json:"handler,omitempty"`
+
+	// Webhook is the rate limit of the virt-api webhooks
+	// +optional
+	Webhook *EffectiveRateLimit `json:"webhook,omitempty"`
+}
+
+// EffectiveRateLimit describes the token bucket rate limiter that HCO sets for a KubeVirt component
+type EffectiveRateLimit struct {
+	// QPS is the number of queries per second the client may send. The QPS of the annotation tuning policy may be
+	// fractional, e.g. "500m" for 0.5 queries per second.
+	QPS resource.Quantity `json:"qps"`
+
+	// Burst is the maximum number of queries the client may send at once, above the QPS rate
+	Burst int32 `json:"burst"`
+}
+
+// TuningRecommendation describes the client rate limits and the live migration parallelism that HCO recommends,
+// according to the last sample of the cluster size
+type TuningRecommendation struct {
+	// Nodes is the number of the nodes that can run workloads
+	Nodes int32 `json:"nodes"`
+
+	// VMIs is the number of the virtual machine instances in the cluster
+	VMIs int32 `json:"vmis"`
+
+	// CurrentParallelMigrations is the maximum number of the concurrent live migrations, as implied by the current
+	// spec.virtualization.liveMigrationConfig field and by the number of the nodes
+	CurrentParallelMigrations int32 `json:"currentParallelMigrations"`
+
+	// Profile is the predefined tuning profile that the recommended rate limits are based on. Once a profile is
+	// selected, the cluster size must drop 10% below the profile thresholds before a smaller profile is recommended.
+	Profile HyperConvergedTuningPolicy `json:"profile"`
+
+	// RateLimits is the recommended client rate limits of the KubeVirt components
+	RateLimits TuningConfig `json:"rateLimits"`
+
+	// ParallelMigrationsPerCluster is the recommended value of the
+	// spec.virtualization.liveMigrationConfig.parallelMigrationsPerCluster field
+	ParallelMigrationsPerCluster uint32 `json:"parallelMigrationsPerCluster"`
+
+	// ParallelOutboundMigrationsPerNode is the recommended value of the
+	// spec.virtualization.liveMigrationConfig.parallelOutboundMigrationsPerNode field
+	ParallelOutboundMigrationsPerNode uint32 `json:"parallelOutboundMigrationsPerNode"`
+}
+
 // VirtualizationConfig contains all the virtualization configurations
 type VirtualizationConfig struct {
 	// TuningPolicy allows configuring the mode in which the RateLimits of kubevirt are set.
 	// If TuningPolicy is not present the default kubevirt values are used.
 	// It can be set to `annotation` for fine-tuning the kubevirt queryPerSeconds (QPS) and burst values.
 	// QPS and burst values are taken from the annotation hco.kubevirt.io/tuningPolicy
-	// +kubebuilder:validation:Enum=annotation;highBurst
+	// It can be set to one of the `small`, `medium` or `large` predefined profiles, to `custom`, to use the
+	// per-component values from the tuning field, or to `auto`, to use the values that HCO recommends according to the
+	// cluster size.
+	// +kubebuilder:validation:Enum=annotation;highBurst;small;medium;large;custom;auto
 	// +optional
 	TuningPolicy HyperConvergedTuningPolicy `json:"tuningPolicy,omitempty"`
 
+	// Tuning holds the rate limits of the KubeVirt components, when the tuningPolicy is set to `custom`. A component
+	// that is not set here uses the KubeVirt default rate limits.
+	// +optional
+	Tuning *TuningConfig `json:"tuning,omitempty"`
+
 	// Live migration limits and timeouts are applied so that migration processes do not
 	// overwhelm the cluster.
 	// +kubebuilder:default={"completionTimeoutPerGiB": 20, "parallelMigrationsPerCluster": 5, "parallelOutboundMigrationsPerNode": 1, "progressTimeout": 150, "allowAutoConverge": false, "allowPostCopy": false, "allowWorkloadDisruption": false}
 	// +optional
 	LiveMigrationConfig LiveMigrationConfigurations `json:"liveMigrationConfig,omitempty"`
 
+	// MigrationPolicies is a list of named live migration profiles. HCO deploys a KubeVirt MigrationPolicy for each
+	// profile. A profile overrides the cluster-wide liveMigrationConfig for the VMs that match its selectors. The
+	// selectors of two profiles must not overlap, so that each VM matches one profile at the most.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	MigrationPolicies []MigrationPolicyProfile `json:"migrationPolicies,omitempty"`
+
 	// PermittedHostDevices holds information about devices allowed for passthrough
 	// +optional
 	PermittedHostDevices *PermittedHostDevices `json:"permittedHostDevices,omitempty"`
@@ -308,6 +734,13 @@ type VirtualizationConfig struct {
 	// +kubebuilder:validation:Enum=AggregateToDefault;Manual
 	RoleAggregationStrategy *v1.RoleAggregationStrategy `json:"roleAggregationStrategy,omitempty"`
 
+	// AIE contains the launcher replacement rules of the AIE (Accelerated Infrastructure Enablement) webhook. The
+	// webhook is deployed when the hco.kubevirt.io/deployAIE annotation is set to "true". When this field is set, HCO
+	// renders the kubevirt-aie-launcher-config ConfigMap from these rules, and reverts any modification of the
+	// ConfigMap. When this field is not set, the ConfigMap is created with no rules, and can be edited directly.
+	// +optional
+	AIE *AIEConfig `json:"aie,omitempty"`
+
 	// VmiCPUAllocationRatio defines, for each requested virtual CPU,
 	// how much physical CPU to request per VMI from the
 	// hosting node. The value is in fraction of a CPU thread (or
@@ -461,16 +894,40 @@ type DeploymentConfig struct {
 	// UninstallStrategy defines how to proceed on uninstall when workloads (VirtualMachines, DataVolumes) still exist.
 	// BlockUninstallIfWorkloadsExist will prevent the CR from being removed when workloads still exist.
 	// BlockUninstallIfWorkloadsExist is the safest choice to protect your workloads from accidental data loss, so it's strongly advised.
+	// BlockUninstallAndReportWorkloads also prevents the CR from being removed when workloads still exist, and lists
+	// the blocking VirtualMachines, DataVolumes and DataImportCrons, by namespace, in the deletion error and in the
+	// UninstallBlocked condition.
 	// RemoveWorkloads will cause all the workloads to be cascading deleted on uninstallation.
 	// WARNING: please notice that RemoveWorkloads will cause your workloads to be deleted as soon as this CR will be, even accidentally, deleted.
 	// Please correctly consider the implications of this option before setting it.
 	// BlockUninstallIfWorkloadsExist is the default behavior.
 	// +kubebuilder:default=BlockUninstallIfWorkloadsExist
 	// +default="BlockUninstallIfWorkloadsExist"
-	// +kubebuilder:validation:Enum=RemoveWorkloads;BlockUninstallIfWorkloadsExist
+	// +kubebuilder:validation:Enum=RemoveWorkloads;BlockUninstallIfWorkloadsExist;BlockUninstallAndReportWorkloads
 	// +optional
 	UninstallStrategy HyperConvergedUninstallStrategy `json:"uninstallStrategy,omitempty"`
 
+	// UninstallBackup configures a backup of the manifests of the workloads, before they are removed on uninstall,
+	// when the uninstallStrategy is RemoveWorkloads.
+	// +optional
+	UninstallBackup *UninstallBackupConfig `json:"uninstallBackup,omitempty"`
+
+	// HighAvailability configures how the replicas of the Deployments that HCO deploys directly (e.g. the console
+	// plugin, the console proxy and the AIE webhook) are spread across zones and nodes, and their
+	// PodDisruptionBudgets.
+	// +optional
+	HighAvailability *HighAvailabilityConfig `json:"highAvailability,omitempty"`
+
+	// Components configures the resource requirements, the priority class and the number of replicas of the
+	// workloads that HCO deploys directly, instead of the default values.
+	// +optional
+	Components *ComponentsConfig `json:"components,omitempty"`
+
+	// Standalone configures the HCO deployment on Kubernetes clusters that are not OpenShift, and are not managed by
+	// OLM. It provides replacements for the OpenShift-only features. This field is ignored on OpenShift.
+	// +optional
+	Standalone *StandaloneConfig `json:"standalone,omitempty"`
+
 	// LogVerbosityConfig configures the verbosity level of Kubevirt's different components. The higher
 	// the value - the higher the log verbosity.
 	// +optional
@@ -493,8 +950,187 @@ type DeploymentConfig struct {
 	// +kubebuilder:default=true
 	// +default=true
 	DeployNetworkResourcesInjector *bool `json:"deployNetworkResourcesInjector,omitempty"`
+
+	// PausedOperands is a list of operand CRs that HCO stops reconciling. HCO does not revert any modification of a
+	// paused operand CR, but keeps reconciling all the other operands, and keeps reporting the paused operand
+	// conditions. Use it to temporarily hand-edit an operand CR, e.g. during an incident. Once the operand is removed
+	// from this list, HCO overwrites the manual modifications.
+	// +listType=set
+	// +optional
+	PausedOperands []OperandKind `json:"pausedOperands,omitempty"`
+}
+
+// UninstallBackupConfig configures the backup of the workloads manifests, before HCO removes them on uninstall
+type UninstallBackupConfig struct {
+	// Enable if true, HCO stores the manifests of the VirtualMachines, DataVolumes and DataImportCrons in the
+	// HyperConverged namespace, before removing them. The uninstallation does not proceed until the backup succeeds.
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	Enable *bool `json:"enable,omitempty"`
+
+	// StorageKind is the kind of the objects that hold the backup; Secret or ConfigMap. The manifests may contain
+	// sensitive data, like cloud-init user data, so Secret is the default.
+	// +optional
+	// +kubebuilder:default=Secret
+	// +default="Secret"
+	StorageKind UninstallBackupStorageKind `json:"storageKind,omitempty"`
+}
+
+// HighAvailabilityConfig configures the topology spread constraints and the PodDisruptionBudgets of the Deployments
+// that HCO deploys directly: the console plugin, the console proxy, the AIE webhook, the network resources injector and
+// the observability controller. The HCO operator and webhook Deployments are deployed by OLM, and are not affected.
+type HighAvailabilityConfig struct {
+	// ZoneSpread controls the spreading of the replicas across zones, using the topology.kubernetes.io/zone node
+	// label. Auto spreads the replicas if the worker nodes span more than one zone.
+	// +optional
+	// +kubebuilder:default=Auto
+	// +default="Auto"
+	ZoneSpread TopologySpreadPolicy `json:"zoneSpread,omitempty"`
+
+	// HostnameSpread controls the spreading of the replicas across nodes. Auto spreads the replicas if the cluster has
+	// more than one worker node.
+	// +optional
+	// +kubebuilder:default=Auto
+	// +default="Auto"
+	HostnameSpread TopologySpreadPolicy `json:"hostnameSpread,omitempty"`
+
+	// PodDisruptionBudget configures the PodDisruptionBudgets of the Deployments
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetConfig `json:"podDisruptionBudget,omitempty"`
+}
+
+// PodDisruptionBudgetConfig configures the PodDisruptionBudgets of the Deployments that HCO deploys directly. A
+// PodDisruptionBudget is only created for a Deployment with more than one replica, because protecting a single
+// replica would block node drains.
+type PodDisruptionBudgetConfig struct {
+	// Enable if false, HCO does not create PodDisruptionBudgets for its Deployments, and removes the existing ones.
+	// +optional
+	// +kubebuilder:default=true
+	// +default=true
+	Enable *bool `json:"enable,omitempty"`
+
+	// MinAvailable is the number, or the percentage, of the replicas of each Deployment that must remain available
+	// during a voluntary disruption, like a node drain. It must be lower than the number of replicas of the
+	// Deployments, so it does not block node drains. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+}
+
+// ComponentsConfig configures the workloads that HCO deploys directly. A field that is not set keeps the default
+// value of the component.
+type ComponentsConfig struct {
+	// ConsolePlugin configures the kubevirt console plugin Deployment
+	// +optional
+	ConsolePlugin *ComponentDeploymentConfig `json:"consolePlugin,omitempty"`
+
+	// ConsoleProxy configures the kubevirt apiserver proxy Deployment, used by the console plugin
+	// +optional
+	ConsoleProxy *ComponentDeploymentConfig `json:"consoleProxy,omitempty"`
+
+	// AIEWebhook configures the AIE webhook Deployment
+	// +optional
+	AIEWebhook *ComponentDeploymentConfig `json:"aieWebhook,omitempty"`
+
+	// NetworkResourcesInjector configures the network resources injector Deployment
+	// +optional
+	NetworkResourcesInjector *ComponentDeploymentConfig `json:"networkResourcesInjector,omitempty"`
+
+	// ObservabilityController configures the observability controller Deployment. The observability controller always
+	// runs a single replica.
+	// +optional
+	ObservabilityController *ComponentPodConfig `json:"observabilityController,omitempty"`
+
+	// WaspAgent configures the wasp-agent DaemonSet
+	// +optional
+	WaspAgent *ComponentPodConfig `json:"waspAgent,omitempty"`
+}
+
+// ComponentPodConfig configures the pods of a workload that HCO deploys directly
+type ComponentPodConfig struct {
+	// Resources replaces the default resource requirements of the component containers
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// PriorityClassName replaces the default priority class of the component pods
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+}
+
+// ComponentDeploymentConfig configures a Deployment that HCO deploys directly
+type ComponentDeploymentConfig struct {
+	ComponentPodConfig `json:",inline"`
+
+	// Replicas replaces the default number of replicas of the Deployment. By default, the Deployment has two replicas
+	// if the infrastructure is highly available, and one replica otherwise.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// StandaloneConfig configures the HCO deployment on Kubernetes clusters that are not OpenShift
+type StandaloneConfig struct {
+	// CLIDownloads exposes the virtctl downloads server outside the cluster, in place of the OpenShift Route and
+	// ConsoleCLIDownload. The downloads server is not exposed if this field is not set.
+	// +optional
+	CLIDownloads *CLIDownloadsConfig `json:"cliDownloads,omitempty"`
+
+	// DeployGoldenImages if true, HCO deploys the DataImportCrons of the golden images (the common boot images, and the
+	// dataImportCronTemplates from the spec) directly, in place of SSP. The golden images are imported into
+	// spec.workloadSources.commonBootImageNamespace, or into the kubevirt-os-images namespace if it is not set; the
+	// namespace must exist. Defaults to false, because importing the golden images consumes storage.
+	// +optional
+	DeployGoldenImages *bool `json:"deployGoldenImages,omitempty"`
+}
+
+// CLIDownloadsConfig configures how the virtctl downloads server is exposed outside the cluster
+type CLIDownloadsConfig struct {
+	// Host is the external host name of the virtctl downloads server
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// Exposure controls how the downloads server is exposed. Ingress (the default) makes HCO create an Ingress for
+	// the hyperconverged-cluster-cli-download Service. None makes HCO only deploy the Service, so it can be exposed
+	// by a user-managed Gateway API HTTPRoute, or by any other means.
+	// +optional
+	// +kubebuilder:default=Ingress
+	// +default="Ingress"
+	Exposure CLIDownloadsExposure `json:"exposure,omitempty"`
+
+	// IngressClassName is the class of the Ingress. The default IngressClass of the cluster is used if it is not set.
+	// +optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+
+	// TLSSecretName is the name of a TLS Secret, in the HyperConverged namespace, with the certificate of the host.
+	// The Ingress does not terminate TLS if it is not set.
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
 }
 
+// CLIDownloadsExposure defines how the virtctl downloads server is exposed
+// +kubebuilder:validation:Enum=Ingress;None
+type CLIDownloadsExposure string
+
+const (
+	CLIDownloadsExposureIngress CLIDownloadsExposure = "Ingress"
+	CLIDownloadsExposureNone    CLIDownloadsExposure = "None"
+)
+
+// OperandKind is the kind of an operand CR, deployed by HCO
+// +kubebuilder:validation:Enum=KubeVirt;CDI;NetworkAddonsConfig;SSP;AAQ;MigController;FileRestoreOperator
+type OperandKind string
+
+const (
+	OperandKindKubeVirt            OperandKind = "KubeVirt"
+	OperandKindCDI                 OperandKind = "CDI"
+	OperandKindNetworkAddonsConfig OperandKind = "NetworkAddonsConfig"
+	OperandKindSSP                 OperandKind = "SSP"
+	OperandKindAAQ                 OperandKind = "AAQ"
+	OperandKindMigController       OperandKind = "MigController"
+	OperandKindFileRestoreOperator OperandKind = "FileRestoreOperator"
+)
+
 // CertRotateConfigCA contains the tunables for TLS certificates.
 // +k8s:openapi-gen=true
 type CertRotateConfigCA struct {
@@ -558,6 +1194,54 @@ type NodePlacements struct {
 	// Workload describes node scheduling configuration for workload entities
 	// +optional
 	Workload *sdkapi.NodePlacement `json:"workload,omitempty"`
+	// Components defines per-component node placement overrides. Each field set in a component override replaces the
+	// same field of the infra or the workload node placement, for this component only.
+	// +listType=map
+	// +listMapKey=component
+	// +optional
+	Components []ComponentNodePlacement `json:"components,omitempty"`
+}
+
+// NodePlacementComponent is a component that its node placement can be overridden. The components that have both
+// infrastructure and workload entities, have a separate value for each one of them.
+// +kubebuilder:validation:Enum=kubevirtInfra;kubevirtWorkload;cdiInfra;cdiWorkload;networkAddonsInfra;networkAddonsWorkload;aaqInfra;aaqWorkload;ssp;consolePlugin;waspAgent;migrationController
+type NodePlacementComponent string
+
+const (
+	// NodePlacementComponentKubeVirtInfra is the KubeVirt infrastructure components (e.g. virt-controller and virt-api)
+	NodePlacementComponentKubeVirtInfra NodePlacementComponent = "kubevirtInfra"
+	// NodePlacementComponentKubeVirtWorkload is the KubeVirt workload components (e.g. virt-handler)
+	NodePlacementComponentKubeVirtWorkload NodePlacementComponent = "kubevirtWorkload"
+	// NodePlacementComponentCDIInfra is the CDI infrastructure components
+	NodePlacementComponentCDIInfra NodePlacementComponent = "cdiInfra"
+	// NodePlacementComponentCDIWorkload is the CDI workload pods (e.g. importers)
+	NodePlacementComponentCDIWorkload NodePlacementComponent = "cdiWorkload"
+	// NodePlacementComponentNetworkAddonsInfra is the cluster network addons infrastructure components
+	NodePlacementComponentNetworkAddonsInfra NodePlacementComponent = "networkAddonsInfra"
+	// NodePlacementComponentNetworkAddonsWorkload is the cluster network addons workload components
+	NodePlacementComponentNetworkAddonsWorkload NodePlacementComponent = "networkAddonsWorkload"
+	// NodePlacementComponentAAQInfra is the application aware quota infrastructure components
+	NodePlacementComponentAAQInfra NodePlacementComponent = "aaqInfra"
+	// NodePlacementComponentAAQWorkload is the application aware quota workload components
+	NodePlacementComponentAAQWorkload NodePlacementComponent = "aaqWorkload"
+	// NodePlacementComponentSSP is the SSP components (e.g. the template validator)
+	NodePlacementComponentSSP NodePlacementComponent = "ssp"
+	// NodePlacementComponentConsolePlugin is the console plugin and the console proxy
+	NodePlacementComponentConsolePlugin NodePlacementComponent = "consolePlugin"
+	// NodePlacementComponentWaspAgent is the wasp-agent DaemonSet
+	NodePlacementComponentWaspAgent NodePlacementComponent = "waspAgent"
+	// NodePlacementComponentMigrationController is the migration controller
+	NodePlacementComponentMigrationController NodePlacementComponent = "migrationController"
+)
+
+// ComponentNodePlacement defines the node placement override of a single component
+// +k8s:conversion-gen=false
+type ComponentNodePlacement struct {
+	// Component is the component to override its node placement
+	Component NodePlacementComponent `json:"component"`
+
+	// NodePlacement is the node placement override of the component
+	sdkapi.NodePlacement `json:",inline"`
 }
 
 // LiveMigrationConfigurations - Live migration limits and timeouts are applied so that migration processes do not
@@ -640,6 +1324,55 @@ type LiveMigrationConfigurations struct {
 	AllowWorkloadDisruption *bool `json:"allowWorkloadDisruption,omitempty"`
 }
 
+// MigrationPolicyProfile is a named set of live migration settings, that applies to the VMs that match its selectors.
+// A field that is not set here, is taken from spec.virtualization.liveMigrationConfig.
+// +k8s:openapi-gen=true
+type MigrationPolicyProfile struct {
+	// Name is the name of the profile, e.g. "latency-sensitive" or "bulk". The name of the MigrationPolicy that HCO
+	// deploys for the profile is the profile name, with the "hco-" prefix.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=59
+	// +required
+	Name string `json:"name"`
+
+	// NamespaceSelector is the labels of the namespaces of the VMs that the profile applies to. If not set, the
+	// profile applies to the VMs in all the namespaces.
+	// +optional
+	NamespaceSelector map[string]string `json:"namespaceSelector,omitempty"`
+
+	// VMSelector is the labels of the VMIs that the profile applies to. If not set, the profile applies to all the
+	// VMIs in the selected namespaces.
+	// +optional
+	VMSelector map[string]string `json:"vmSelector,omitempty"`
+
+	// Bandwidth limit of each migration, the value is quantity of bytes per second (e.g. 2048Mi = 2048MiB/sec)
+	// +optional
+	// +kubebuilder:validation:Pattern=^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+	BandwidthPerMigration *string `json:"bandwidthPerMigration,omitempty"`
+
+	// CompletionTimeoutPerGiB is the completion timeout of the migration of each GiB of the guest. See
+	// spec.virtualization.liveMigrationConfig.completionTimeoutPerGiB.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	CompletionTimeoutPerGiB *int64 `json:"completionTimeoutPerGiB,omitempty"`
+
+	// AllowAutoConverge allows the platform to compromise performance/availability of VMIs to
+	// guarantee successful VMI live migrations.
+	// +optional
+	AllowAutoConverge *bool `json:"allowAutoConverge,omitempty"`
+
+	// AllowPostCopy allows KubeVirt to switch to post-copy live-migration, when the pre-copy live-migration reaches
+	// its completion timeout.
+	// +optional
+	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
+
+	// AllowWorkloadDisruption indicates that the migration shouldn't be canceled after the acceptable completion
+	// time is exceeded. Instead, if permitted, migration will be switched to post-copy or the VMI will be paused to
+	// allow the migration to complete.
+	// +optional
+	AllowWorkloadDisruption *bool `json:"allowWorkloadDisruption,omitempty"`
+}
+
 // VirtualMachineOptions holds the cluster level information regarding the virtual machine.
 // +k8s:conversion-gen=false
 type VirtualMachineOptions struct {
@@ -674,6 +1407,72 @@ type VirtualMachineOptions struct {
 	DefaultRuntimeClass *string `json:"defaultRuntimeClass,omitempty"`
 }
 
+// AIEConfig contains the configuration of the AIE webhook
+// +k8s:openapi-gen=true
+type AIEConfig struct {
+	// Rules is an ordered list of launcher replacement rules. The rules are evaluated in order; the first matching
+	// rule wins.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Rules []AIELauncherRule `json:"rules,omitempty"`
+}
+
+// AIELauncherRule replaces the virt-launcher compute container image of the matching virtual machines with an
+// alternative launcher image
+// +k8s:openapi-gen=true
+type AIELauncherRule struct {
+	// Name is the identifier of the rule
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Image is the alternative launcher container image to use, when the rule matches
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+
+	// DeviceNames is a list of device resource names (GPUs or host devices). The rule matches a virtual machine that
+	// requests one of these devices. At least one of deviceNames and vmLabels must be set; they are OR'd.
+	// +listType=set
+	// +optional
+	DeviceNames []string `json:"deviceNames,omitempty"`
+
+	// VMLabels matches the virtual machines that have all of these labels. At least one of deviceNames and vmLabels
+	// must be set; they are OR'd.
+	// +optional
+	VMLabels map[string]string `json:"vmLabels,omitempty"`
+
+	// NodeSelector, when set, restricts the scheduling of the matching virtual machines to the nodes that have all
+	// of these labels.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// AIERuleStatus describes the state of an AIE launcher replacement rule
+// +k8s:openapi-gen=true
+type AIERuleStatus struct {
+	// Name is the name of the rule
+	Name string `json:"name"`
+
+	// ImageDigest is the digest of the rule image, as resolved from the image registry. Not set while the digest is
+	// being resolved.
+	// +optional
+	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// ImageError is the reason why the digest of the rule image could not be resolved
+	// +optional
+	ImageError string `json:"imageError,omitempty"`
+
+	// MatchingPermittedHostDevices is the number of the enabled devices in
+	// spec.virtualization.permittedHostDevices, whose resource name is in the deviceNames of the rule
+	MatchingPermittedHostDevices int32 `json:"matchingPermittedHostDevices"`
+
+	// MatchingNodes is the number of the nodes that match the nodeSelector of the rule. Not set if the rule has no
+	// nodeSelector.
+	// +optional
+	MatchingNodes *int32 `json:"matchingNodes,omitempty"`
+}
+
 // PermittedHostDevices holds information about devices allowed for passthrough
 // +k8s:openapi-gen=true
 type PermittedHostDevices struct {
@@ -868,11 +1667,205 @@ type HyperConvergedStatus struct {
 
 	// NodeInfo holds information about the cluster nodes
 	NodeInfo NodeInfoStatus `json:"nodeInfo,omitempty"`
+
+	// EffectiveOverrides is the list of the spec.overrides entries that are currently applied to the operand CRs, and
+	// that modified them
+	// +listType=atomic
+	// +optional
+	EffectiveOverrides []EffectiveOperandOverride `json:"effectiveOverrides,omitempty"`
+
+	// Components is a list of the reconciliation state of each one of the operand CRs, deployed by HCO. Unlike the
+	// Conditions field, that aggregates the conditions of all the operands, each item in this list only describes a
+	// single operand.
+	// +listType=map
+	// +listMapKey=kind
+	// +optional
+	Components []ComponentStatus `json:"components,omitempty"`
+
+	// FeatureGates is the effective state of all the feature gates that are supported by HCO, and of any unknown
+	// feature gate that is listed in spec.featureGates.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	FeatureGates []FeatureGateStatus `json:"featureGates,omitempty"`
+
+	// AIERules is the state of the AIE launcher replacement rules, in spec.virtualization.aie.rules
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	AIERules []AIERuleStatus `json:"aieRules,omitempty"`
+
+	// SwapNodes is the swap readiness of the nodes that run wasp-agent
+	// +listType=map
+	// +listMapKey=nodeName
+	// +optional
+	SwapNodes []NodeSwapStatus `json:"swapNodes,omitempty"`
+
+	// EffectiveTuning is the client rate limits that HCO sets for the KubeVirt components, according to the
+	// spec.virtualization.tuningPolicy field. It is not set if no tuning policy is used.
+	// +optional
+	EffectiveTuning *EffectiveTuning `json:"effectiveTuning,omitempty"`
+
+	// TuningRecommendation is the client rate limits and the live migration parallelism that HCO recommends, according
+	// to the cluster size. HCO samples the cluster size periodically. The recommended rate limits are applied when the
+	// spec.virtualization.tuningPolicy field is set to `auto`.
+	// +optional
+	TuningRecommendation *TuningRecommendation `json:"tuningRecommendation,omitempty"`
+
+	// MigrationPolicies is the state of the live migration profiles, in spec.virtualization.migrationPolicies
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	MigrationPolicies []MigrationPolicyStatus `json:"migrationPolicies,omitempty"`
+}
+
+// MigrationPolicyStatus describes the state of a live migration profile
+// +k8s:openapi-gen=true
+type MigrationPolicyStatus struct {
+	// Name is the name of the profile
+	Name string `json:"name"`
+
+	// MigrationPolicy is the name of the KubeVirt MigrationPolicy that HCO deploys for the profile
+	MigrationPolicy string `json:"migrationPolicy"`
+
+	// VMIs is the number of the running VMs that the profile applies to. HCO counts the VMs periodically.
+	VMIs int32 `json:"vmis"`
+}
+
+// NodeSwapStatus describes the swap readiness of a node
+// +k8s:openapi-gen=true
+type NodeSwapStatus struct {
+	// NodeName is the name of the node
+	NodeName string `json:"nodeName"`
+
+	// Ready is true if wasp-agent is ready on the node, and the node is ready and has no memory pressure
+	Ready bool `json:"ready"`
+
+	// Reason is a CamelCase reason for the swap readiness of the node
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human-readable message about the swap readiness of the node
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 type Version struct {
 	Name    string `json:"name,omitempty"`
 	Version string `json:"version,omitempty"`
+
+	// UpgradeJournal is the name of the ConfigMap, in the HyperConverged namespace, that records the state of the
+	// HyperConverged CR and of the operand CRs, as they were before the last upgrade of this component, and the
+	// upgrade patches that were applied during this upgrade.
+	// +optional
+	UpgradeJournal string `json:"upgradeJournal,omitempty"`
+}
+
+// ComponentStatus describes the reconciliation state of a single operand CR
+type ComponentStatus struct {
+	// Name is the name of the operand CR
+	Name string `json:"name"`
+
+	// Kind is the kind of the operand CR
+	Kind string `json:"kind"`
+
+	// ObservedVersion is the version of the operand, as reported in the status of its CR
+	// +optional
+	ObservedVersion string `json:"observedVersion,omitempty"`
+
+	// LastAppliedGeneration is the metadata.generation of the operand CR, as found after HCO last applied it
+	// +optional
+	LastAppliedGeneration int64 `json:"lastAppliedGeneration,omitempty"`
+
+	// Conditions are the conditions of the operand CR, as reported by the operand
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// LastReconcileError is the error of the last reconciliation of the operand CR, if it failed. It is cleared once
+	// the operand CR is successfully reconciled.
+	// +optional
+	LastReconcileError string `json:"lastReconcileError,omitempty"`
+
+	// Paused indicates that HCO does not reconcile the operand CR, because it is listed in
+	// spec.deployment.pausedOperands
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// FeatureGateStatus describes the effective state of a single feature gate
+type FeatureGateStatus struct {
+	// Name is the feature gate name
+	Name string `json:"name"`
+
+	// Phase is the lifecycle phase of the feature gate; one of GA, beta, alpha, deprecated, discontinued or unknown.
+	// An unknown feature gate is not supported by HCO, and is ignored.
+	Phase string `json:"phase"`
+
+	// State is the effective state of the feature gate
+	State featuregates.State `json:"state"`
+
+	// Defaulted is true if the feature gate is not listed in spec.featureGates, and so its state is the default
+	// state of its phase
+	// +optional
+	Defaulted bool `json:"defaulted,omitempty"`
+
+	// KubeVirtFeatureGates is the list of the KubeVirt feature gates that HCO enables when this feature gate is enabled
+	// +listType=atomic
+	// +optional
+	KubeVirtFeatureGates []string `json:"kubevirtFeatureGates,omitempty"`
+
+	// KubeVirtFeatureGatesWhenDisabled is the list of the KubeVirt feature gates that HCO enables when this feature
+	// gate is disabled, as a fallback
+	// +listType=atomic
+	// +optional
+	KubeVirtFeatureGatesWhenDisabled []string `json:"kubevirtFeatureGatesWhenDisabled,omitempty"`
+
+	// CDIFeatureGates is the list of the CDI feature gates that HCO enables when this feature gate is enabled
+	// +listType=atomic
+	// +optional
+	CDIFeatureGates []string `json:"cdiFeatureGates,omitempty"`
+}
+
+// FeatureGatePolicyAction is the action HCO takes for a feature gate in a specific lifecycle phase
+// +kubebuilder:validation:Enum=Allow;Warn;Deny;Remove
+type FeatureGatePolicyAction string
+
+const (
+	// FeatureGatePolicyAllow accepts the feature gate silently
+	FeatureGatePolicyAllow FeatureGatePolicyAction = "Allow"
+	// FeatureGatePolicyWarn accepts the feature gate, with an admission warning
+	FeatureGatePolicyWarn FeatureGatePolicyAction = "Warn"
+	// FeatureGatePolicyDeny rejects a request that adds or enables the feature gate
+	FeatureGatePolicyDeny FeatureGatePolicyAction = "Deny"
+	// FeatureGatePolicyRemove accepts the feature gate with an admission warning, and then HCO removes it from the
+	// featureGates list, and emits an event
+	FeatureGatePolicyRemove FeatureGatePolicyAction = "Remove"
+)
+
+// FeatureGatesPolicy defines the action HCO takes for the feature gates in each lifecycle phase.
+// The alpha action applies to the enabled alpha feature gates. The deprecated and the discontinued actions apply to
+// any deprecated or discontinued feature gate in the featureGates list, whether enabled or not.
+type FeatureGatesPolicy struct {
+	// Alpha is the action for the enabled alpha feature gates. The default is Allow.
+	// +optional
+	Alpha FeatureGatePolicyAction `json:"alpha,omitempty"`
+
+	// Deprecated is the action for the deprecated feature gates. The default is Warn.
+	// +optional
+	Deprecated FeatureGatePolicyAction `json:"deprecated,omitempty"`
+
+	// Discontinued is the action for the discontinued feature gates. Discontinued feature gates are always ignored.
+	// The default is Warn.
+	// +optional
+	Discontinued FeatureGatePolicyAction `json:"discontinued,omitempty"`
+
+	// NoAlphaFeatureGates forbids the alpha feature gates in the cluster, e.g. in production clusters. When set,
+	// enabling an alpha feature gate is denied, regardless of the alpha action, and an alert is fired if an alpha
+	// feature gate is still enabled.
+	// +optional
+	NoAlphaFeatureGates bool `json:"noAlphaFeatureGates,omitempty"`
 }
 
 // LogVerbosityConfiguration configures log verbosity for different components
@@ -964,6 +1957,67 @@ type HigherWorkloadDensityConfiguration struct {
 	// +kubebuilder:default=100
 	// +default=100
 	MemoryOvercommitPercentage int `json:"memoryOvercommitPercentage,omitempty"`
+
+	// Swap configures the wasp-agent DaemonSet, that enables swap on the cluster nodes, to allow memory overcommit.
+	// The wasp-agent DaemonSet is deployed when memoryOvercommitPercentage is higher than 100, unless swap.enabled
+	// is false.
+	// +optional
+	Swap *SwapConfig `json:"swap,omitempty"`
+}
+
+// SwapConfig configures the wasp-agent DaemonSet
+// +k8s:openapi-gen=true
+type SwapConfig struct {
+	// Enabled controls the deployment of the wasp-agent DaemonSet, when memoryOvercommitPercentage is higher than
+	// 100. Set it to false if swap is managed by another component; for example, when swap is opted into the
+	// platform autopilot by the platform.kubevirt.io/autopilot annotation. The mutating webhook sets this field to
+	// false if the annotation opts swap into the autopilot, and the field is not set.
+	// Default: true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// NodeSelector restricts the wasp-agent DaemonSet to the nodes that have all of these labels. It is added to the
+	// node selector of spec.deployment.nodePlacements.components.waspAgent, if set.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Verbosity is the log verbosity of wasp-agent
+	// Default: 1
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	Verbosity *int32 `json:"verbosity,omitempty"`
+
+	// SwapUtilizationThresholdPercentage is the percentage of the node swap space that may be used, before
+	// wasp-agent starts evicting pods from the node.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SwapUtilizationThresholdPercentage *int32 `json:"swapUtilizationThresholdPercentage,omitempty"`
+
+	// Eviction configures when wasp-agent evicts pods from a node, because of a high swap traffic
+	// +optional
+	Eviction *SwapEvictionConfig `json:"eviction,omitempty"`
+}
+
+// SwapEvictionConfig configures the swap traffic thresholds for the pod eviction. wasp-agent evicts pods from a node,
+// if the average swap traffic of the node, over the averageWindowSizeSeconds window, exceeds one of the thresholds.
+// +k8s:openapi-gen=true
+type SwapEvictionConfig struct {
+	// MaxAverageSwapInPagesPerSecond is the maximum average number of pages that are swapped in, per second
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxAverageSwapInPagesPerSecond *int64 `json:"maxAverageSwapInPagesPerSecond,omitempty"`
+
+	// MaxAverageSwapOutPagesPerSecond is the maximum average number of pages that are swapped out, per second
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxAverageSwapOutPagesPerSecond *int64 `json:"maxAverageSwapOutPagesPerSecond,omitempty"`
+
+	// AverageWindowSizeSeconds is the size of the window, in seconds, to calculate the average swap traffic in
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	AverageWindowSizeSeconds *int32 `json:"averageWindowSizeSeconds,omitempty"`
 }
 
 // KubeMacPoolConfig defines kubemacpool MAC address range configuration
@@ -1009,9 +2063,23 @@ const (
 	// This condition is exposed only when its value is True, and is otherwise hidden.
 	ConditionTaintedConfiguration = "TaintedConfiguration"
 
+	// ConditionOperandsPaused indicates that the reconciliation of some of the operand CRs is paused, using the
+	// spec.deployment.pausedOperands field.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionOperandsPaused = "OperandsPaused"
+
+	// ConditionUninstallBlocked indicates that the HyperConverged CR can't be removed, because workloads still exist.
+	// This condition is exposed only when the uninstallStrategy is BlockUninstallAndReportWorkloads, and its message
+	// lists the blocking workloads, by namespace.
+	ConditionUninstallBlocked = "UninstallBlocked"
+
 	// ConditionNetworkResourcesInjectorReady indicates whether the network resources injector
 	// deployment is fully ready (all replicas running).
 	ConditionNetworkResourcesInjectorReady = "VirtNetworkResourcesInjectorReady"
+
+	// ConditionTuningRecommendation indicates whether the KubeVirt client rate limits match the rate limits that HCO
+	// recommends for the cluster size. The condition message describes the recommendation.
+	ConditionTuningRecommendation = "TuningRecommendation"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
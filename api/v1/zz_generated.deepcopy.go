@@ -28,13 +28,220 @@ import (
 	featuregates "github.com/kubevirt/hyperconverged-cluster-operator/api/v1/featuregates"
 	configv1 "github.com/openshift/api/config/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	apicorev1 "kubevirt.io/api/core/v1"
 	v1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
 	v1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIEConfig) DeepCopyInto(out *AIEConfig) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AIELauncherRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIEConfig.
+func (in *AIEConfig) DeepCopy() *AIEConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AIEConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIELauncherRule) DeepCopyInto(out *AIELauncherRule) {
+	*out = *in
+	if in.DeviceNames != nil {
+		in, out := &in.DeviceNames, &out.DeviceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VMLabels != nil {
+		in, out := &in.VMLabels, &out.VMLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIELauncherRule.
+func (in *AIELauncherRule) DeepCopy() *AIELauncherRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AIELauncherRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIERuleStatus) DeepCopyInto(out *AIERuleStatus) {
+	*out = *in
+	if in.MatchingNodes != nil {
+		in, out := &in.MatchingNodes, &out.MatchingNodes
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIERuleStatus.
+func (in *AIERuleStatus) DeepCopy() *AIERuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AIERuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertOverride) DeepCopyInto(out *AlertOverride) {
+	*out = *in
+	if in.For != nil {
+		in, out := &in.For, &out.For
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertOverride.
+func (in *AlertOverride) DeepCopy() *AlertOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSeverityMapping) DeepCopyInto(out *AlertSeverityMapping) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSeverityMapping.
+func (in *AlertSeverityMapping) DeepCopy() *AlertSeverityMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSeverityMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSilence) DeepCopyInto(out *AlertSilence) {
+	*out = *in
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]AlertSilenceMatcher, len(*in))
+		copy(*out, *in)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSilence.
+func (in *AlertSilence) DeepCopy() *AlertSilence {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSilence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSilenceMatcher) DeepCopyInto(out *AlertSilenceMatcher) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSilenceMatcher.
+func (in *AlertSilenceMatcher) DeepCopy() *AlertSilenceMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSilenceMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertingConfig) DeepCopyInto(out *AlertingConfig) {
+	*out = *in
+	if in.AdditionalLabels != nil {
+		in, out := &in.AdditionalLabels, &out.AdditionalLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SeverityMapping != nil {
+		in, out := &in.SeverityMapping, &out.SeverityMapping
+		*out = make([]AlertSeverityMapping, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertingConfig.
+func (in *AlertingConfig) DeepCopy() *AlertingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationAwareConfigurations) DeepCopyInto(out *ApplicationAwareConfigurations) {
 	*out = *in
@@ -66,6 +273,27 @@ func (in *ApplicationAwareConfigurations) DeepCopy() *ApplicationAwareConfigurat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CLIDownloadsConfig) DeepCopyInto(out *CLIDownloadsConfig) {
+	*out = *in
+	if in.IngressClassName != nil {
+		in, out := &in.IngressClassName, &out.IngressClassName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CLIDownloadsConfig.
+func (in *CLIDownloadsConfig) DeepCopy() *CLIDownloadsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CLIDownloadsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertRotateConfigCA) DeepCopyInto(out *CertRotateConfigCA) {
 	*out = *in
@@ -118,6 +346,161 @@ func (in *CertRotateConfigServer) DeepCopy() *CertRotateConfigServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentDeploymentConfig) DeepCopyInto(out *ComponentDeploymentConfig) {
+	*out = *in
+	in.ComponentPodConfig.DeepCopyInto(&out.ComponentPodConfig)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentDeploymentConfig.
+func (in *ComponentDeploymentConfig) DeepCopy() *ComponentDeploymentConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentDeploymentConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentNodePlacement) DeepCopyInto(out *ComponentNodePlacement) {
+	*out = *in
+	in.NodePlacement.DeepCopyInto(&out.NodePlacement)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentNodePlacement.
+func (in *ComponentNodePlacement) DeepCopy() *ComponentNodePlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentNodePlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentPodConfig) DeepCopyInto(out *ComponentPodConfig) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentPodConfig.
+func (in *ComponentPodConfig) DeepCopy() *ComponentPodConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentPodConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentsConfig) DeepCopyInto(out *ComponentsConfig) {
+	*out = *in
+	if in.ConsolePlugin != nil {
+		in, out := &in.ConsolePlugin, &out.ConsolePlugin
+		*out = new(ComponentDeploymentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConsoleProxy != nil {
+		in, out := &in.ConsoleProxy, &out.ConsoleProxy
+		*out = new(ComponentDeploymentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AIEWebhook != nil {
+		in, out := &in.AIEWebhook, &out.AIEWebhook
+		*out = new(ComponentDeploymentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkResourcesInjector != nil {
+		in, out := &in.NetworkResourcesInjector, &out.NetworkResourcesInjector
+		*out = new(ComponentDeploymentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObservabilityController != nil {
+		in, out := &in.ObservabilityController, &out.ObservabilityController
+		*out = new(ComponentPodConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WaspAgent != nil {
+		in, out := &in.WaspAgent, &out.WaspAgent
+		*out = new(ComponentPodConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentsConfig.
+func (in *ComponentsConfig) DeepCopy() *ComponentsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardsConfig) DeepCopyInto(out *DashboardsConfig) {
+	*out = *in
+	if in.DisabledDashboards != nil {
+		in, out := &in.DisabledDashboards, &out.DisabledDashboards
+		*out = make([]BuiltInDashboard, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalDashboardConfigMaps != nil {
+		in, out := &in.AdditionalDashboardConfigMaps, &out.AdditionalDashboardConfigMaps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardsConfig.
+func (in *DashboardsConfig) DeepCopy() *DashboardsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataImportCronStatus) DeepCopyInto(out *DataImportCronStatus) {
 	*out = *in
@@ -189,6 +572,26 @@ func (in *DeploymentConfig) DeepCopyInto(out *DeploymentConfig) {
 		*out = new(NodePlacements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UninstallBackup != nil {
+		in, out := &in.UninstallBackup, &out.UninstallBackup
+		*out = new(UninstallBackupConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HighAvailability != nil {
+		in, out := &in.HighAvailability, &out.HighAvailability
+		*out = new(HighAvailabilityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = new(ComponentsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Standalone != nil {
+		in, out := &in.Standalone, &out.Standalone
+		*out = new(StandaloneConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LogVerbosityConfig != nil {
 		in, out := &in.LogVerbosityConfig, &out.LogVerbosityConfig
 		*out = new(LogVerbosityConfiguration)
@@ -209,6 +612,11 @@ func (in *DeploymentConfig) DeepCopyInto(out *DeploymentConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PausedOperands != nil {
+		in, out := &in.PausedOperands, &out.PausedOperands
+		*out = make([]OperandKind, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -222,9 +630,151 @@ func (in *DeploymentConfig) DeepCopy() *DeploymentConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveOperandOverride) DeepCopyInto(out *EffectiveOperandOverride) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveOperandOverride.
+func (in *EffectiveOperandOverride) DeepCopy() *EffectiveOperandOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveOperandOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveRateLimit) DeepCopyInto(out *EffectiveRateLimit) {
+	*out = *in
+	out.QPS = in.QPS.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveRateLimit.
+func (in *EffectiveRateLimit) DeepCopy() *EffectiveRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveTuning) DeepCopyInto(out *EffectiveTuning) {
+	*out = *in
+	if in.API != nil {
+		in, out := &in.API, &out.API
+		*out = new(EffectiveRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Controller != nil {
+		in, out := &in.Controller, &out.Controller
+		*out = new(EffectiveRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Handler != nil {
+		in, out := &in.Handler, &out.Handler
+		*out = new(EffectiveRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(EffectiveRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveTuning.
+func (in *EffectiveTuning) DeepCopy() *EffectiveTuning {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveTuning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureGateStatus) DeepCopyInto(out *FeatureGateStatus) {
+	*out = *in
+	if in.KubeVirtFeatureGates != nil {
+		in, out := &in.KubeVirtFeatureGates, &out.KubeVirtFeatureGates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KubeVirtFeatureGatesWhenDisabled != nil {
+		in, out := &in.KubeVirtFeatureGatesWhenDisabled, &out.KubeVirtFeatureGatesWhenDisabled
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CDIFeatureGates != nil {
+		in, out := &in.CDIFeatureGates, &out.CDIFeatureGates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureGateStatus.
+func (in *FeatureGateStatus) DeepCopy() *FeatureGateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureGateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureGatesPolicy) DeepCopyInto(out *FeatureGatesPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureGatesPolicy.
+func (in *FeatureGatesPolicy) DeepCopy() *FeatureGatesPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureGatesPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HighAvailabilityConfig) DeepCopyInto(out *HighAvailabilityConfig) {
+	*out = *in
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HighAvailabilityConfig.
+func (in *HighAvailabilityConfig) DeepCopy() *HighAvailabilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HighAvailabilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HigherWorkloadDensityConfiguration) DeepCopyInto(out *HigherWorkloadDensityConfiguration) {
 	*out = *in
+	if in.Swap != nil {
+		in, out := &in.Swap, &out.Swap
+		*out = new(SwapConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -327,6 +877,11 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FeatureGatesPolicy != nil {
+		in, out := &in.FeatureGatesPolicy, &out.FeatureGatesPolicy
+		*out = new(FeatureGatesPolicy)
+		**out = **in
+	}
 	in.Virtualization.DeepCopyInto(&out.Virtualization)
 	if in.Storage != nil {
 		in, out := &in.Storage, &out.Storage
@@ -346,6 +901,13 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 		*out = new(ObservabilityConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]OperandOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -392,6 +954,52 @@ func (in *HyperConvergedStatus) DeepCopyInto(out *HyperConvergedStatus) {
 		**out = **in
 	}
 	in.NodeInfo.DeepCopyInto(&out.NodeInfo)
+	if in.EffectiveOverrides != nil {
+		in, out := &in.EffectiveOverrides, &out.EffectiveOverrides
+		*out = make([]EffectiveOperandOverride, len(*in))
+		copy(*out, *in)
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make([]FeatureGateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AIERules != nil {
+		in, out := &in.AIERules, &out.AIERules
+		*out = make([]AIERuleStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SwapNodes != nil {
+		in, out := &in.SwapNodes, &out.SwapNodes
+		*out = make([]NodeSwapStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.EffectiveTuning != nil {
+		in, out := &in.EffectiveTuning, &out.EffectiveTuning
+		*out = new(EffectiveTuning)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TuningRecommendation != nil {
+		in, out := &in.TuningRecommendation, &out.TuningRecommendation
+		*out = new(TuningRecommendation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MigrationPolicies != nil {
+		in, out := &in.MigrationPolicies, &out.MigrationPolicies
+		*out = make([]MigrationPolicyStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -436,6 +1044,27 @@ func (in *HyperConvergedWorkloadUpdateStrategy) DeepCopy() *HyperConvergedWorklo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONPatchOperation) DeepCopyInto(out *JSONPatchOperation) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONPatchOperation.
+func (in *JSONPatchOperation) DeepCopy() *JSONPatchOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONPatchOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeMacPoolConfig) DeepCopyInto(out *KubeMacPoolConfig) {
 	*out = *in
@@ -536,64 +1165,135 @@ func (in *LogVerbosityConfiguration) DeepCopyInto(out *LogVerbosityConfiguration
 		*out = new(int32)
 		**out = **in
 	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogVerbosityConfiguration.
-func (in *LogVerbosityConfiguration) DeepCopy() *LogVerbosityConfiguration {
-	if in == nil {
-		return nil
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogVerbosityConfiguration.
+func (in *LogVerbosityConfiguration) DeepCopy() *LogVerbosityConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LogVerbosityConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MediatedDeviceTypes != nil {
+		in, out := &in.MediatedDeviceTypes, &out.MediatedDeviceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeMediatedDeviceTypes != nil {
+		in, out := &in.NodeMediatedDeviceTypes, &out.NodeMediatedDeviceTypes
+		*out = make([]NodeMediatedDeviceTypesConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediatedDevicesConfiguration.
+func (in *MediatedDevicesConfiguration) DeepCopy() *MediatedDevicesConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MediatedDevicesConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediatedHostDevice) DeepCopyInto(out *MediatedHostDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediatedHostDevice.
+func (in *MediatedHostDevice) DeepCopy() *MediatedHostDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(MediatedHostDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationPolicyProfile) DeepCopyInto(out *MigrationPolicyProfile) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.VMSelector != nil {
+		in, out := &in.VMSelector, &out.VMSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BandwidthPerMigration != nil {
+		in, out := &in.BandwidthPerMigration, &out.BandwidthPerMigration
+		*out = new(string)
+		**out = **in
+	}
+	if in.CompletionTimeoutPerGiB != nil {
+		in, out := &in.CompletionTimeoutPerGiB, &out.CompletionTimeoutPerGiB
+		*out = new(int64)
+		**out = **in
 	}
-	out := new(LogVerbosityConfiguration)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
-	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
+	if in.AllowAutoConverge != nil {
+		in, out := &in.AllowAutoConverge, &out.AllowAutoConverge
 		*out = new(bool)
 		**out = **in
 	}
-	if in.MediatedDeviceTypes != nil {
-		in, out := &in.MediatedDeviceTypes, &out.MediatedDeviceTypes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.AllowPostCopy != nil {
+		in, out := &in.AllowPostCopy, &out.AllowPostCopy
+		*out = new(bool)
+		**out = **in
 	}
-	if in.NodeMediatedDeviceTypes != nil {
-		in, out := &in.NodeMediatedDeviceTypes, &out.NodeMediatedDeviceTypes
-		*out = make([]NodeMediatedDeviceTypesConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.AllowWorkloadDisruption != nil {
+		in, out := &in.AllowWorkloadDisruption, &out.AllowWorkloadDisruption
+		*out = new(bool)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediatedDevicesConfiguration.
-func (in *MediatedDevicesConfiguration) DeepCopy() *MediatedDevicesConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationPolicyProfile.
+func (in *MigrationPolicyProfile) DeepCopy() *MigrationPolicyProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(MediatedDevicesConfiguration)
+	out := new(MigrationPolicyProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MediatedHostDevice) DeepCopyInto(out *MediatedHostDevice) {
+func (in *MigrationPolicyStatus) DeepCopyInto(out *MigrationPolicyStatus) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediatedHostDevice.
-func (in *MediatedHostDevice) DeepCopy() *MediatedHostDevice {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationPolicyStatus.
+func (in *MigrationPolicyStatus) DeepCopy() *MigrationPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MediatedHostDevice)
+	out := new(MigrationPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -696,6 +1396,13 @@ func (in *NodePlacements) DeepCopyInto(out *NodePlacements) {
 		in, out := &in.Workload, &out.Workload
 		*out = (*in).DeepCopy()
 	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentNodePlacement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -709,6 +1416,22 @@ func (in *NodePlacements) DeepCopy() *NodePlacements {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeSwapStatus) DeepCopyInto(out *NodeSwapStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSwapStatus.
+func (in *NodeSwapStatus) DeepCopy() *NodeSwapStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSwapStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObservabilityConfig) DeepCopyInto(out *ObservabilityConfig) {
 	*out = *in
@@ -727,6 +1450,30 @@ func (in *ObservabilityConfig) DeepCopyInto(out *ObservabilityConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Silences != nil {
+		in, out := &in.Silences, &out.Silences
+		*out = make([]AlertSilence, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AlertOverrides != nil {
+		in, out := &in.AlertOverrides, &out.AlertOverrides
+		*out = make([]AlertOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Alerting != nil {
+		in, out := &in.Alerting, &out.Alerting
+		*out = new(AlertingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dashboards != nil {
+		in, out := &in.Dashboards, &out.Dashboards
+		*out = new(DashboardsConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -761,6 +1508,34 @@ func (in *ObservabilityWorkloadsConfig) DeepCopy() *ObservabilityWorkloadsConfig
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandOverride) DeepCopyInto(out *OperandOverride) {
+	*out = *in
+	if in.JSONPatch != nil {
+		in, out := &in.JSONPatch, &out.JSONPatch
+		*out = make([]JSONPatchOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StrategicMergePatch != nil {
+		in, out := &in.StrategicMergePatch, &out.StrategicMergePatch
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandOverride.
+func (in *OperandOverride) DeepCopy() *OperandOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PciHostDevice) DeepCopyInto(out *PciHostDevice) {
 	*out = *in
@@ -831,6 +1606,48 @@ func (in *PersistentReservationConfiguration) DeepCopy() *PersistentReservationC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetConfig) DeepCopyInto(out *PodDisruptionBudgetConfig) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetConfig.
+func (in *PodDisruptionBudgetConfig) DeepCopy() *PodDisruptionBudgetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimit) DeepCopyInto(out *RateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
+func (in *RateLimit) DeepCopy() *RateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityConfig) DeepCopyInto(out *SecurityConfig) {
 	*out = *in
@@ -853,6 +1670,32 @@ func (in *SecurityConfig) DeepCopy() *SecurityConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StandaloneConfig) DeepCopyInto(out *StandaloneConfig) {
+	*out = *in
+	if in.CLIDownloads != nil {
+		in, out := &in.CLIDownloads, &out.CLIDownloads
+		*out = new(CLIDownloadsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeployGoldenImages != nil {
+		in, out := &in.DeployGoldenImages, &out.DeployGoldenImages
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StandaloneConfig.
+func (in *StandaloneConfig) DeepCopy() *StandaloneConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StandaloneConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageConfig) DeepCopyInto(out *StorageConfig) {
 	*out = *in
@@ -920,6 +1763,133 @@ func (in *StorageImportConfig) DeepCopy() *StorageImportConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwapConfig) DeepCopyInto(out *SwapConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Verbosity != nil {
+		in, out := &in.Verbosity, &out.Verbosity
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SwapUtilizationThresholdPercentage != nil {
+		in, out := &in.SwapUtilizationThresholdPercentage, &out.SwapUtilizationThresholdPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Eviction != nil {
+		in, out := &in.Eviction, &out.Eviction
+		*out = new(SwapEvictionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwapConfig.
+func (in *SwapConfig) DeepCopy() *SwapConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SwapConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwapEvictionConfig) DeepCopyInto(out *SwapEvictionConfig) {
+	*out = *in
+	if in.MaxAverageSwapInPagesPerSecond != nil {
+		in, out := &in.MaxAverageSwapInPagesPerSecond, &out.MaxAverageSwapInPagesPerSecond
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxAverageSwapOutPagesPerSecond != nil {
+		in, out := &in.MaxAverageSwapOutPagesPerSecond, &out.MaxAverageSwapOutPagesPerSecond
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AverageWindowSizeSeconds != nil {
+		in, out := &in.AverageWindowSizeSeconds, &out.AverageWindowSizeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwapEvictionConfig.
+func (in *SwapEvictionConfig) DeepCopy() *SwapEvictionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SwapEvictionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TuningConfig) DeepCopyInto(out *TuningConfig) {
+	*out = *in
+	if in.API != nil {
+		in, out := &in.API, &out.API
+		*out = new(RateLimit)
+		**out = **in
+	}
+	if in.Controller != nil {
+		in, out := &in.Controller, &out.Controller
+		*out = new(RateLimit)
+		**out = **in
+	}
+	if in.Handler != nil {
+		in, out := &in.Handler, &out.Handler
+		*out = new(RateLimit)
+		**out = **in
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(RateLimit)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TuningConfig.
+func (in *TuningConfig) DeepCopy() *TuningConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TuningConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TuningRecommendation) DeepCopyInto(out *TuningRecommendation) {
+	*out = *in
+	in.RateLimits.DeepCopyInto(&out.RateLimits)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TuningRecommendation.
+func (in *TuningRecommendation) DeepCopy() *TuningRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(TuningRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *USBHostDevice) DeepCopyInto(out *USBHostDevice) {
 	*out = *in
@@ -957,6 +1927,27 @@ func (in *USBSelector) DeepCopy() *USBSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UninstallBackupConfig) DeepCopyInto(out *UninstallBackupConfig) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UninstallBackupConfig.
+func (in *UninstallBackupConfig) DeepCopy() *UninstallBackupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UninstallBackupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Version) DeepCopyInto(out *Version) {
 	*out = *in
@@ -1012,7 +2003,19 @@ func (in *VirtualMachineOptions) DeepCopy() *VirtualMachineOptions {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualizationConfig) DeepCopyInto(out *VirtualizationConfig) {
 	*out = *in
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(TuningConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	in.LiveMigrationConfig.DeepCopyInto(&out.LiveMigrationConfig)
+	if in.MigrationPolicies != nil {
+		in, out := &in.MigrationPolicies, &out.MigrationPolicies
+		*out = make([]MigrationPolicyProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.PermittedHostDevices != nil {
 		in, out := &in.PermittedHostDevices, &out.PermittedHostDevices
 		*out = new(PermittedHostDevices)
@@ -1042,7 +2045,7 @@ func (in *VirtualizationConfig) DeepCopyInto(out *VirtualizationConfig) {
 	if in.HigherWorkloadDensity != nil {
 		in, out := &in.HigherWorkloadDensity, &out.HigherWorkloadDensity
 		*out = new(HigherWorkloadDensityConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.LiveUpdateConfiguration != nil {
 		in, out := &in.LiveUpdateConfiguration, &out.LiveUpdateConfiguration
@@ -1069,6 +2072,11 @@ func (in *VirtualizationConfig) DeepCopyInto(out *VirtualizationConfig) {
 		*out = new(apicorev1.RoleAggregationStrategy)
 		**out = **in
 	}
+	if in.AIE != nil {
+		in, out := &in.AIE, &out.AIE
+		*out = new(AIEConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VmiCPUAllocationRatio != nil {
 		in, out := &in.VmiCPUAllocationRatio, &out.VmiCPUAllocationRatio
 		*out = new(int)
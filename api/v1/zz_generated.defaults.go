@@ -138,6 +138,36 @@ func SetObjectDefaults_HyperConverged(in *HyperConverged) {
 	if in.Spec.Deployment.UninstallStrategy == "" {
 		in.Spec.Deployment.UninstallStrategy = "BlockUninstallIfWorkloadsExist"
 	}
+	if in.Spec.Deployment.UninstallBackup != nil {
+		if in.Spec.Deployment.UninstallBackup.Enable == nil {
+			var ptrVar1 bool = false
+			in.Spec.Deployment.UninstallBackup.Enable = &ptrVar1
+		}
+		if in.Spec.Deployment.UninstallBackup.StorageKind == "" {
+			in.Spec.Deployment.UninstallBackup.StorageKind = "Secret"
+		}
+	}
+	if in.Spec.Deployment.HighAvailability != nil {
+		if in.Spec.Deployment.HighAvailability.ZoneSpread == "" {
+			in.Spec.Deployment.HighAvailability.ZoneSpread = "Auto"
+		}
+		if in.Spec.Deployment.HighAvailability.HostnameSpread == "" {
+			in.Spec.Deployment.HighAvailability.HostnameSpread = "Auto"
+		}
+		if in.Spec.Deployment.HighAvailability.PodDisruptionBudget != nil {
+			if in.Spec.Deployment.HighAvailability.PodDisruptionBudget.Enable == nil {
+				var ptrVar1 bool = true
+				in.Spec.Deployment.HighAvailability.PodDisruptionBudget.Enable = &ptrVar1
+			}
+		}
+	}
+	if in.Spec.Deployment.Standalone != nil {
+		if in.Spec.Deployment.Standalone.CLIDownloads != nil {
+			if in.Spec.Deployment.Standalone.CLIDownloads.Exposure == "" {
+				in.Spec.Deployment.Standalone.CLIDownloads.Exposure = "Ingress"
+			}
+		}
+	}
 	if in.Spec.Deployment.ApplicationAwareConfig != nil {
 		if in.Spec.Deployment.ApplicationAwareConfig.Enable == nil {
 			var ptrVar1 bool = false
@@ -152,6 +182,27 @@ func SetObjectDefaults_HyperConverged(in *HyperConverged) {
 		var ptrVar1 bool = true
 		in.Spec.Deployment.DeployNetworkResourcesInjector = &ptrVar1
 	}
+	if in.Spec.Observability != nil {
+		for i := range in.Spec.Observability.Silences {
+			a := &in.Spec.Observability.Silences[i]
+			for j := range a.Matchers {
+				b := &a.Matchers[j]
+				if b.Type == "" {
+					b.Type = "Equal"
+				}
+			}
+			if a.Duration == nil {
+				if err := json.Unmarshal([]byte(`"24h0m0s"`), &a.Duration); err != nil {
+					panic(err)
+				}
+			}
+			if a.RenewBefore == nil {
+				if err := json.Unmarshal([]byte(`"2h0m0s"`), &a.RenewBefore); err != nil {
+					panic(err)
+				}
+			}
+		}
+	}
 }
 
 func SetObjectDefaults_HyperConvergedList(in *HyperConvergedList) {
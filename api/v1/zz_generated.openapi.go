@@ -31,9 +31,18 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AIEConfig":                            schema_kubevirt_hyperconverged_cluster_operator_api_v1_AIEConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AIELauncherRule":                      schema_kubevirt_hyperconverged_cluster_operator_api_v1_AIELauncherRule(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AIERuleStatus":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1_AIERuleStatus(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertOverride":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1_AlertOverride(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertSeverityMapping":                 schema_kubevirt_hyperconverged_cluster_operator_api_v1_AlertSeverityMapping(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertSilence":                         schema_kubevirt_hyperconverged_cluster_operator_api_v1_AlertSilence(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertSilenceMatcher":                  schema_kubevirt_hyperconverged_cluster_operator_api_v1_AlertSilenceMatcher(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertingConfig":                       schema_kubevirt_hyperconverged_cluster_operator_api_v1_AlertingConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.ApplicationAwareConfigurations":       schema_kubevirt_hyperconverged_cluster_operator_api_v1_ApplicationAwareConfigurations(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.CertRotateConfigCA":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1_CertRotateConfigCA(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.CertRotateConfigServer":               schema_kubevirt_hyperconverged_cluster_operator_api_v1_CertRotateConfigServer(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.DashboardsConfig":                     schema_kubevirt_hyperconverged_cluster_operator_api_v1_DashboardsConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.HyperConverged":                       schema_kubevirt_hyperconverged_cluster_operator_api_v1_HyperConverged(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.HyperConvergedCertConfig":             schema_kubevirt_hyperconverged_cluster_operator_api_v1_HyperConvergedCertConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.HyperConvergedSpec":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1_HyperConvergedSpec(ref),
@@ -44,18 +53,459 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.LogVerbosityConfiguration":            schema_kubevirt_hyperconverged_cluster_operator_api_v1_LogVerbosityConfiguration(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.MediatedDevicesConfiguration":         schema_kubevirt_hyperconverged_cluster_operator_api_v1_MediatedDevicesConfiguration(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.MediatedHostDevice":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1_MediatedHostDevice(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.MigrationPolicyProfile":               schema_kubevirt_hyperconverged_cluster_operator_api_v1_MigrationPolicyProfile(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.MigrationPolicyStatus":                schema_kubevirt_hyperconverged_cluster_operator_api_v1_MigrationPolicyStatus(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.NodeMediatedDeviceTypesConfig":        schema_kubevirt_hyperconverged_cluster_operator_api_v1_NodeMediatedDeviceTypesConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.NodeSwapStatus":                       schema_kubevirt_hyperconverged_cluster_operator_api_v1_NodeSwapStatus(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.ObservabilityConfig":                  schema_kubevirt_hyperconverged_cluster_operator_api_v1_ObservabilityConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.ObservabilityWorkloadsConfig":         schema_kubevirt_hyperconverged_cluster_operator_api_v1_ObservabilityWorkloadsConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.PciHostDevice":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1_PciHostDevice(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.PermittedHostDevices":                 schema_kubevirt_hyperconverged_cluster_operator_api_v1_PermittedHostDevices(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.PersistentReservationConfiguration":   schema_kubevirt_hyperconverged_cluster_operator_api_v1_PersistentReservationConfiguration(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.StorageImportConfig":                  schema_kubevirt_hyperconverged_cluster_operator_api_v1_StorageImportConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.SwapConfig":                           schema_kubevirt_hyperconverged_cluster_operator_api_v1_SwapConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.SwapEvictionConfig":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1_SwapEvictionConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.USBHostDevice":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1_USBHostDevice(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.USBSelector":                          schema_kubevirt_hyperconverged_cluster_operator_api_v1_USBSelector(ref),
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1_AIEConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AIEConfig contains the configuration of the AIE webhook",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules is an ordered list of launcher replacement rules. The rules are evaluated in order; the first matching rule wins.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AIELauncherRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AIELauncherRule"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1_AIELauncherRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AIELauncherRule replaces the virt-launcher compute container image of the matching virtual machines with an alternative launcher image",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the identifier of the rule",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the alternative launcher container image to use, when the rule matches",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deviceNames": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DeviceNames is a list of device resource names (GPUs or host devices). The rule matches a virtual machine that requests one of these devices. At least one of deviceNames and vmLabels must be set; they are OR'd.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"vmLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VMLabels matches the virtual machines that have all of these labels. At least one of deviceNames and vmLabels must be set; they are OR'd.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector, when set, restricts the scheduling of the matching virtual machines to the nodes that have all of these labels.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "image"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1_AIERuleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AIERuleStatus describes the state of an AIE launcher replacement rule",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the rule",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imageDigest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageDigest is the digest of the rule image, as resolved from the image registry. Not set while the digest is being resolved.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imageError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageError is the reason why the digest of the rule image could not be resolved",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"matchingPermittedHostDevices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MatchingPermittedHostDevices is the number of the enabled devices in spec.virtualization.permittedHostDevices, whose resource name is in the deviceNames of the rule",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"matchingNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MatchingNodes is the number of the nodes that match the nodeSelector of the rule. Not set if the rule has no nodeSelector.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "matchingPermittedHostDevices"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1_AlertOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertOverride modifies an alert that HCO deploys",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"alert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Alert is the name of the alert to modify",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"matchSeverity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MatchSeverity limits the override to the rule of the alert with this severity, for the alerts that are deployed with more than one severity. By default, all the rules of the alert are modified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"threshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Threshold replaces the number that the alert expression is compared with, e.g. 0.9 in \"instance:node_cpu_utilisation:rate1m >= 0.9\". Only supported for the alerts whose expression ends with a comparison to a number.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"for": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For replaces the duration that the alert condition must be true, before the alert fires. This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"severity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Severity replaces the severity of the alert",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are added to the labels of the alert, and replace the existing labels with the same names. Use the severity field to modify the severity of the alert.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations are added to the annotations of the alert, and replace the existing annotations with the same names, e.g. runbook_url.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"alert"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1_AlertSeverityMapping(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertSeverityMapping replaces an alert severity with another one",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "From is the severity to replace",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Description: "To is the severity to use instead",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"from", "to"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1_AlertSilence(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertSilence is an Alertmanager silence that is managed by HCO",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name identifies the silence in the list. It is added to the comment of the Alertmanager silence.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"matchers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Matchers is the list of the matchers of the silenced alerts. An alert is silenced if it matches all the matchers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertSilenceMatcher"),
+									},
+								},
+							},
+						},
+					},
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comment is a free text description of the silence",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The 'duration' (i.e. lifetime) of each Alertmanager silence that HCO creates. This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)",
+							Default:     "24h0m0s",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"renewBefore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The amount of time before the end of the current Alertmanager silence, that HCO replaces it with a new one. Must be at least one hour, because the silences are checked hourly. This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)",
+							Default:     "2h0m0s",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"name", "matchers"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertSilenceMatcher", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1_AlertSilenceMatcher(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertSilenceMatcher matches an alert label",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the alert label, e.g. alertname",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value, or the regular expression if the type is Regex or NotRegex, to compare the label with",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the comparison; one of Equal, NotEqual, Regex and NotRegex",
+							Default:     "Equal",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1_AlertingConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertingConfig contains configurations that apply to all the alerts that HCO deploys",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"runbookBaseURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunbookBaseURL replaces the location of the runbooks of the alerts, e.g. to point to runbooks that are hosted in a disconnected environment. The runbook_url annotation of each alert is set to the base URL, followed by the alert name. The alerts that link to a specific runbook document keep their runbook_url.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"additionalLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalLabels are added to the labels of all the alerts, e.g. to route the alerts by team, and replace the existing labels with the same names. Use severityMapping to modify the severities of the alerts.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"severityMapping": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"from",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "SeverityMapping replaces the severities of the alerts; e.g. to deploy all the critical alerts as warnings.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertSeverityMapping"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertSeverityMapping"},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1_ApplicationAwareConfigurations(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -157,6 +607,64 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1_CertRotateConfigServ
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1_DashboardsConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DashboardsConfig configures the Perses dashboards that HCO deploys",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disabledDashboards": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DisabledDashboards is a list of the built-in dashboards that HCO should not deploy. HCO removes the disabled dashboards, if they were already deployed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"datasourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DatasourceName is the name of the Perses datasource that the built-in dashboards query. By default, the built-in dashboards query the default Prometheus datasource, that HCO deploys.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"additionalDashboardConfigMaps": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalDashboardConfigMaps is a list of names of ConfigMaps, in the HyperConverged namespace, that contain additional PersesDashboard manifests, one manifest in each key of the ConfigMap data. HCO deploys these dashboards in the HyperConverged namespace, alongside the built-in dashboards.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1_HyperConverged(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -253,6 +761,12 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1_HyperConvergedSpec(r
 							},
 						},
 					},
+					"featureGatesPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FeatureGatesPolicy defines how HCO enforces the lifecycle phases of the feature gates in the featureGates list",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.FeatureGatesPolicy"),
+						},
+					},
 					"virtualization": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Virtualization contains all the configurations for virtualization",
@@ -299,11 +813,29 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1_HyperConvergedSpec(r
 							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.ObservabilityConfig"),
 						},
 					},
+					"overrides": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Overrides is a list of patches to apply to the operand CRs, on top of the configurations that HCO generates from the HyperConverged spec. The overrides are applied in the list order, and are validated against the generated operand CRs when the HyperConverged CR is created or updated. Modifications done by the overrides won't be reconciled back by HCO to the opinionated defaults.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.OperandOverride"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.DeploymentConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.NetworkingConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.ObservabilityConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.SecurityConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.StorageConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.VirtualizationConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.WorkloadSourcesConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1/featuregates.FeatureGate"},
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.DeploymentConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.FeatureGatesPolicy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.NetworkingConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.ObservabilityConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.OperandOverride", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.SecurityConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.StorageConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.VirtualizationConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.WorkloadSourcesConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1/featuregates.FeatureGate"},
 	}
 }
 
@@ -413,16 +945,151 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1_HyperConvergedStatus
 					},
 					"nodeInfo": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeInfo holds information about the cluster nodes",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.NodeInfoStatus"),
+							Description: "NodeInfo holds information about the cluster nodes",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.NodeInfoStatus"),
+						},
+					},
+					"effectiveOverrides": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "EffectiveOverrides is the list of the spec.overrides entries that are currently applied to the operand CRs, and that modified them",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.EffectiveOperandOverride"),
+									},
+								},
+							},
+						},
+					},
+					"components": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"kind",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Components is a list of the reconciliation state of each one of the operand CRs, deployed by HCO. Unlike the Conditions field, that aggregates the conditions of all the operands, each item in this list only describes a single operand.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.ComponentStatus"),
+									},
+								},
+							},
+						},
+					},
+					"featureGates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "FeatureGates is the effective state of all the feature gates that are supported by HCO, and of any unknown feature gate that is listed in spec.featureGates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.FeatureGateStatus"),
+									},
+								},
+							},
+						},
+					},
+					"aieRules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AIERules is the state of the AIE launcher replacement rules, in spec.virtualization.aie.rules",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AIERuleStatus"),
+									},
+								},
+							},
+						},
+					},
+					"swapNodes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"nodeName",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "SwapNodes is the swap readiness of the nodes that run wasp-agent",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.NodeSwapStatus"),
+									},
+								},
+							},
+						},
+					},
+					"effectiveTuning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EffectiveTuning is the client rate limits that HCO sets for the KubeVirt components, according to the spec.virtualization.tuningPolicy field. It is not set if no tuning policy is used.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.EffectiveTuning"),
+						},
+					},
+					"tuningRecommendation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TuningRecommendation is the client rate limits and the live migration parallelism that HCO recommends, according to the cluster size. HCO samples the cluster size periodically. The recommended rate limits are applied when the spec.virtualization.tuningPolicy field is set to `auto`.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.TuningRecommendation"),
+						},
+					},
+					"migrationPolicies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationPolicies is the state of the live migration profiles, in spec.virtualization.migrationPolicies",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.MigrationPolicyStatus"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.DataImportCronTemplateStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.NodeInfoStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.Version", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AIERuleStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.ComponentStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.DataImportCronTemplateStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.EffectiveOperandOverride", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.EffectiveTuning", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.FeatureGateStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.MigrationPolicyStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.NodeInfoStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.NodeSwapStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.TuningRecommendation", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.Version", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
 	}
 }
 
@@ -716,6 +1383,131 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1_MediatedHostDevice(r
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1_MigrationPolicyProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MigrationPolicyProfile is a named set of live migration settings, that applies to the VMs that match its selectors. A field that is not set here, is taken from spec.virtualization.liveMigrationConfig.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the profile, e.g. \"latency-sensitive\" or \"bulk\". The name of the MigrationPolicy that HCO deploys for the profile is the profile name, with the \"hco-\" prefix.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector is the labels of the namespaces of the VMs that the profile applies to. If not set, the profile applies to the VMs in all the namespaces.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"vmSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VMSelector is the labels of the VMIs that the profile applies to. If not set, the profile applies to all the VMIs in the selected namespaces.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"bandwidthPerMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bandwidth limit of each migration, the value is quantity of bytes per second (e.g. 2048Mi = 2048MiB/sec)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"completionTimeoutPerGiB": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTimeoutPerGiB is the completion timeout of the migration of each GiB of the guest. See spec.virtualization.liveMigrationConfig.completionTimeoutPerGiB.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"allowAutoConverge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowAutoConverge allows the platform to compromise performance/availability of VMIs to guarantee successful VMI live migrations.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"allowPostCopy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPostCopy allows KubeVirt to switch to post-copy live-migration, when the pre-copy live-migration reaches its completion timeout.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"allowWorkloadDisruption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowWorkloadDisruption indicates that the migration shouldn't be canceled after the acceptable completion time is exceeded. Instead, if permitted, migration will be switched to post-copy or the VMI will be paused to allow the migration to complete.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1_MigrationPolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MigrationPolicyStatus describes the state of a live migration profile",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the profile",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"migrationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationPolicy is the name of the KubeVirt MigrationPolicy that HCO deploys for the profile",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vmis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VMIs is the number of the running VMs that the profile applies to. HCO counts the VMs periodically.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "migrationPolicy", "vmis"},
+			},
+		},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1_NodeMediatedDeviceTypesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -763,6 +1555,50 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1_NodeMediatedDeviceTy
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1_NodeSwapStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeSwapStatus describes the swap readiness of a node",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeName is the name of the node",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ready is true if wasp-agent is ready on the node, and the node is ready and has no memory pressure",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a CamelCase reason for the swap readiness of the node",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable message about the swap readiness of the node",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"nodeName", "ready"},
+			},
+		},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1_ObservabilityConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -814,11 +1650,62 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1_ObservabilityConfig(
 							},
 						},
 					},
+					"silences": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Silences is a list of Alertmanager silences that HCO creates, and keeps active for as long as they are in the list. A silence that is removed from the list is expired by HCO.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertSilence"),
+									},
+								},
+							},
+						},
+					},
+					"alertOverrides": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AlertOverrides is a list of modifications of the alerts that HCO deploys, e.g. to change the threshold or the severity of an alert, or to add labels and annotations to it. The overrides are applied in the list order, to the PrometheusRules of both HCO and the observability controller.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertOverride"),
+									},
+								},
+							},
+						},
+					},
+					"alerting": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Alerting contains configurations that apply to all the alerts that HCO deploys, in the PrometheusRules of both HCO and the observability controller. The alertOverrides are applied on top of these configurations.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertingConfig"),
+						},
+					},
+					"dashboards": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dashboards configures the Perses dashboards that HCO deploys, when the Perses operator is installed.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.DashboardsConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.ObservabilityWorkloadsConfig"},
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertOverride", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertSilence", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.AlertingConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.DashboardsConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1.ObservabilityWorkloadsConfig"},
 	}
 }
 
@@ -1025,6 +1912,97 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1_StorageImportConfig(
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1_SwapConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SwapConfig configures the wasp-agent DaemonSet",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled controls the deployment of the wasp-agent DaemonSet, when memoryOvercommitPercentage is higher than 100. Set it to false if swap is managed by another component; for example, when swap is opted into the platform autopilot by the platform.kubevirt.io/autopilot annotation. The mutating webhook sets this field to false if the annotation opts swap into the autopilot, and the field is not set. Default: true",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector restricts the wasp-agent DaemonSet to the nodes that have all of these labels. It is added to the node selector of spec.deployment.nodePlacements.components.waspAgent, if set.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"verbosity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verbosity is the log verbosity of wasp-agent Default: 1",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"swapUtilizationThresholdPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SwapUtilizationThresholdPercentage is the percentage of the node swap space that may be used, before wasp-agent starts evicting pods from the node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"eviction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Eviction configures when wasp-agent evicts pods from a node, because of a high swap traffic",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1.SwapEvictionConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1.SwapEvictionConfig"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1_SwapEvictionConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SwapEvictionConfig configures the swap traffic thresholds for the pod eviction. wasp-agent evicts pods from a node, if the average swap traffic of the node, over the averageWindowSizeSeconds window, exceeds one of the thresholds.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxAverageSwapInPagesPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAverageSwapInPagesPerSecond is the maximum average number of pages that are swapped in, per second",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxAverageSwapOutPagesPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAverageSwapOutPagesPerSecond is the maximum average number of pages that are swapped out, per second",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"averageWindowSizeSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AverageWindowSizeSeconds is the size of the window, in seconds, to calculate the average swap traffic in",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1_USBHostDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
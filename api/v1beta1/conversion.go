@@ -32,6 +32,17 @@ type v1OnlyFields struct {
 	MultiArchEnabled               *bool                              `json:"multiArchEnabled,omitempty"`
 	FeatureGates                   hcov1fg.HyperConvergedFeatureGates `json:"featureGates,omitempty"`
 	Observability                  *hcov1.ObservabilityConfig         `json:"observability,omitempty"`
+	Overrides                      []hcov1.OperandOverride            `json:"overrides,omitempty"`
+	PausedOperands                 []hcov1.OperandKind                `json:"pausedOperands,omitempty"`
+	FeatureGatesPolicy             *hcov1.FeatureGatesPolicy          `json:"featureGatesPolicy,omitempty"`
+	UninstallBackup                *hcov1.UninstallBackupConfig       `json:"uninstallBackup,omitempty"`
+	NodePlacementComponents        []hcov1.ComponentNodePlacement     `json:"nodePlacementComponents,omitempty"`
+	HighAvailability               *hcov1.HighAvailabilityConfig      `json:"highAvailability,omitempty"`
+	Components                     *hcov1.ComponentsConfig            `json:"components,omitempty"`
+	Standalone                     *hcov1.StandaloneConfig            `json:"standalone,omitempty"`
+	AIE                            *hcov1.AIEConfig                   `json:"aie,omitempty"`
+	Tuning                         *hcov1.TuningConfig                `json:"tuning,omitempty"`
+	MigrationPolicies              []hcov1.MigrationPolicyProfile     `json:"migrationPolicies,omitempty"`
 }
 
 func (fields *v1OnlyFields) isEmpty() bool {
@@ -40,7 +51,18 @@ func (fields *v1OnlyFields) isEmpty() bool {
 		fields.PersistentReservationEnabled == nil &&
 		fields.MultiArchEnabled == nil &&
 		fields.FeatureGates == nil &&
-		fields.Observability == nil
+		fields.Observability == nil &&
+		fields.Overrides == nil &&
+		fields.PausedOperands == nil &&
+		fields.FeatureGatesPolicy == nil &&
+		fields.UninstallBackup == nil &&
+		fields.NodePlacementComponents == nil &&
+		fields.HighAvailability == nil &&
+		fields.Components == nil &&
+		fields.Standalone == nil &&
+		fields.AIE == nil &&
+		fields.Tuning == nil &&
+		fields.MigrationPolicies == nil
 }
 
 // Implement the conversion.Convertible interface, to be used in the conversion webhook.
@@ -242,8 +264,8 @@ func convertVMOptionsV1ToV1beta1(v1VMOptions *hcov1.VirtualMachineOptions, v1bet
 func convertVirtualizationV1beta1ToV1(v1beta1Spec HyperConvergedSpec, v1VirtConfig *hcov1.VirtualizationConfig) error {
 	// the "highBurst" policy is deprecated in v1. We don't need to copy it because it's now the
 	// default value in KubeVirt.
-	if v1beta1Spec.TuningPolicy == hcov1.HyperConvergedAnnotationTuningPolicy {
-		v1VirtConfig.TuningPolicy = hcov1.HyperConvergedAnnotationTuningPolicy
+	if v1beta1Spec.TuningPolicy != HyperConvergedHighBurstProfile { //nolint SA1019
+		v1VirtConfig.TuningPolicy = v1beta1Spec.TuningPolicy
 	}
 
 	v1beta1Spec.LiveMigrationConfig.DeepCopyInto(&v1VirtConfig.LiveMigrationConfig)
@@ -620,6 +642,56 @@ func restoreV1OnlyFields(src *HyperConverged, dst *hcov1.HyperConverged) error {
 		dst.Spec.Observability = v1Fields.Observability.DeepCopy()
 	}
 
+	for _, override := range v1Fields.Overrides {
+		dst.Spec.Overrides = append(dst.Spec.Overrides, *override.DeepCopy())
+	}
+
+	if len(v1Fields.PausedOperands) > 0 {
+		dst.Spec.Deployment.PausedOperands = slices.Clone(v1Fields.PausedOperands)
+	}
+
+	if v1Fields.FeatureGatesPolicy != nil {
+		dst.Spec.FeatureGatesPolicy = v1Fields.FeatureGatesPolicy.DeepCopy()
+	}
+
+	if v1Fields.UninstallBackup != nil {
+		dst.Spec.Deployment.UninstallBackup = v1Fields.UninstallBackup.DeepCopy()
+	}
+
+	if len(v1Fields.NodePlacementComponents) > 0 {
+		if dst.Spec.Deployment.NodePlacements == nil {
+			dst.Spec.Deployment.NodePlacements = &hcov1.NodePlacements{}
+		}
+
+		for _, component := range v1Fields.NodePlacementComponents {
+			dst.Spec.Deployment.NodePlacements.Components = append(dst.Spec.Deployment.NodePlacements.Components, *component.DeepCopy())
+		}
+	}
+
+	if v1Fields.HighAvailability != nil {
+		dst.Spec.Deployment.HighAvailability = v1Fields.HighAvailability.DeepCopy()
+	}
+
+	if v1Fields.Components != nil {
+		dst.Spec.Deployment.Components = v1Fields.Components.DeepCopy()
+	}
+
+	if v1Fields.Standalone != nil {
+		dst.Spec.Deployment.Standalone = v1Fields.Standalone.DeepCopy()
+	}
+
+	if v1Fields.AIE != nil {
+		dst.Spec.Virtualization.AIE = v1Fields.AIE.DeepCopy()
+	}
+
+	if v1Fields.Tuning != nil {
+		dst.Spec.Virtualization.Tuning = v1Fields.Tuning.DeepCopy()
+	}
+
+	for _, profile := range v1Fields.MigrationPolicies {
+		dst.Spec.Virtualization.MigrationPolicies = append(dst.Spec.Virtualization.MigrationPolicies, *profile.DeepCopy())
+	}
+
 	return nil
 }
 
@@ -652,6 +724,59 @@ func storeV1OnlyFields(src *hcov1.HyperConverged, dst *HyperConverged) error {
 		v1Fields.Observability = src.Spec.Observability.DeepCopy()
 	}
 
+	if len(src.Spec.Overrides) > 0 {
+		v1Fields.Overrides = make([]hcov1.OperandOverride, len(src.Spec.Overrides))
+		for i, override := range src.Spec.Overrides {
+			v1Fields.Overrides[i] = *override.DeepCopy()
+		}
+	}
+
+	if len(src.Spec.Deployment.PausedOperands) > 0 {
+		v1Fields.PausedOperands = slices.Clone(src.Spec.Deployment.PausedOperands)
+	}
+
+	if src.Spec.FeatureGatesPolicy != nil {
+		v1Fields.FeatureGatesPolicy = src.Spec.FeatureGatesPolicy.DeepCopy()
+	}
+
+	if src.Spec.Deployment.UninstallBackup != nil {
+		v1Fields.UninstallBackup = src.Spec.Deployment.UninstallBackup.DeepCopy()
+	}
+
+	if np := src.Spec.Deployment.NodePlacements; np != nil && len(np.Components) > 0 {
+		v1Fields.NodePlacementComponents = make([]hcov1.ComponentNodePlacement, len(np.Components))
+		for i, component := range np.Components {
+			v1Fields.NodePlacementComponents[i] = *component.DeepCopy()
+		}
+	}
+
+	if src.Spec.Deployment.HighAvailability != nil {
+		v1Fields.HighAvailability = src.Spec.Deployment.HighAvailability.DeepCopy()
+	}
+
+	if src.Spec.Deployment.Components != nil {
+		v1Fields.Components = src.Spec.Deployment.Components.DeepCopy()
+	}
+
+	if src.Spec.Deployment.Standalone != nil {
+		v1Fields.Standalone = src.Spec.Deployment.Standalone.DeepCopy()
+	}
+
+	if src.Spec.Virtualization.AIE != nil {
+		v1Fields.AIE = src.Spec.Virtualization.AIE.DeepCopy()
+	}
+
+	if src.Spec.Virtualization.Tuning != nil {
+		v1Fields.Tuning = src.Spec.Virtualization.Tuning.DeepCopy()
+	}
+
+	if len(src.Spec.Virtualization.MigrationPolicies) > 0 {
+		v1Fields.MigrationPolicies = make([]hcov1.MigrationPolicyProfile, len(src.Spec.Virtualization.MigrationPolicies))
+		for i, profile := range src.Spec.Virtualization.MigrationPolicies {
+			v1Fields.MigrationPolicies[i] = *profile.DeepCopy()
+		}
+	}
+
 	if v1Fields.isEmpty() {
 		return nil
 	}
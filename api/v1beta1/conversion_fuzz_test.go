@@ -1,6 +1,7 @@
 package v1beta1
 
 import (
+	"fmt"
 	"math/rand/v2"
 	"testing"
 	"time"
@@ -8,7 +9,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	kubevirtv1 "kubevirt.io/api/core/v1"
 	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
@@ -178,6 +181,19 @@ func randomV1beta1HC(r *rand.Rand) *HyperConverged {
 		hc.Spec.HigherWorkloadDensity = &hcov1.HigherWorkloadDensityConfiguration{
 			MemoryOvercommitPercentage: r.IntN(200) + 10,
 		}
+		if r.IntN(2) == 1 {
+			hc.Spec.HigherWorkloadDensity.Swap = &hcov1.SwapConfig{
+				Enabled:                            randPtr(r, r.IntN(2) == 1),
+				NodeSelector:                       map[string]string{randString(r): randString(r)},
+				Verbosity:                          randPtr(r, r.Int32N(11)),
+				SwapUtilizationThresholdPercentage: randPtr(r, r.Int32N(100)+1),
+				Eviction: &hcov1.SwapEvictionConfig{
+					MaxAverageSwapInPagesPerSecond:  randPtr(r, r.Int64N(1000000)+1),
+					MaxAverageSwapOutPagesPerSecond: randPtr(r, r.Int64N(1000000)+1),
+					AverageWindowSizeSeconds:        randPtr(r, r.Int32N(300)+1),
+				},
+			}
+		}
 	}
 
 	if r.IntN(2) == 1 {
@@ -345,6 +361,23 @@ func randomV1HC(r *rand.Rand) *hcov1.HyperConverged {
 				NodeSelector: map[string]string{randString(r): randString(r)},
 			},
 		}
+
+		if r.IntN(2) == 1 {
+			hc.Spec.Deployment.NodePlacements.Components = []hcov1.ComponentNodePlacement{
+				{
+					Component: hcov1.NodePlacementComponentKubeVirtInfra,
+					NodePlacement: sdkapi.NodePlacement{
+						NodeSelector: map[string]string{randString(r): randString(r)},
+					},
+				},
+				{
+					Component: hcov1.NodePlacementComponentConsolePlugin,
+					NodePlacement: sdkapi.NodePlacement{
+						NodeSelector: map[string]string{randString(r): randString(r)},
+					},
+				},
+			}
+		}
 	}
 
 	if r.IntN(2) == 1 {
@@ -384,6 +417,19 @@ func randomV1HC(r *rand.Rand) *hcov1.HyperConverged {
 		hc.Spec.Virtualization.HigherWorkloadDensity = &hcov1.HigherWorkloadDensityConfiguration{
 			MemoryOvercommitPercentage: r.IntN(200) + 10,
 		}
+		if r.IntN(2) == 1 {
+			hc.Spec.Virtualization.HigherWorkloadDensity.Swap = &hcov1.SwapConfig{
+				Enabled:                            randPtr(r, r.IntN(2) == 1),
+				NodeSelector:                       map[string]string{randString(r): randString(r)},
+				Verbosity:                          randPtr(r, r.Int32N(11)),
+				SwapUtilizationThresholdPercentage: randPtr(r, r.Int32N(100)+1),
+				Eviction: &hcov1.SwapEvictionConfig{
+					MaxAverageSwapInPagesPerSecond:  randPtr(r, r.Int64N(1000000)+1),
+					MaxAverageSwapOutPagesPerSecond: randPtr(r, r.Int64N(1000000)+1),
+					AverageWindowSizeSeconds:        randPtr(r, r.Int32N(300)+1),
+				},
+			}
+		}
 	}
 
 	if r.IntN(2) == 1 {
@@ -533,6 +579,91 @@ func randomV1HC(r *rand.Rand) *hcov1.HyperConverged {
 		hc.Spec.Deployment.DeployNetworkResourcesInjector = new(r.IntN(2) == 1)
 	}
 
+	if r.IntN(2) == 1 {
+		hc.Spec.Deployment.PausedOperands = []hcov1.OperandKind{hcov1.OperandKindKubeVirt, hcov1.OperandKindCDI}
+	}
+
+	if r.IntN(2) == 1 {
+		hc.Spec.Deployment.UninstallBackup = &hcov1.UninstallBackupConfig{
+			Enable:      randPtr(r, r.IntN(2) == 1),
+			StorageKind: hcov1.UninstallBackupStorageKindSecret,
+		}
+		if r.IntN(2) == 1 {
+			hc.Spec.Deployment.UninstallBackup.StorageKind = hcov1.UninstallBackupStorageKindConfigMap
+		}
+	}
+
+	if r.IntN(2) == 1 {
+		hc.Spec.Deployment.HighAvailability = &hcov1.HighAvailabilityConfig{
+			ZoneSpread:     hcov1.TopologySpreadPolicyAuto,
+			HostnameSpread: hcov1.TopologySpreadPolicyDisabled,
+			PodDisruptionBudget: &hcov1.PodDisruptionBudgetConfig{
+				Enable:       randPtr(r, r.IntN(2) == 1),
+				MinAvailable: randPtr(r, intstr.FromInt32(r.Int32N(3))),
+			},
+		}
+	}
+
+	if r.IntN(2) == 1 {
+		hc.Spec.Deployment.Components = &hcov1.ComponentsConfig{
+			ConsolePlugin: &hcov1.ComponentDeploymentConfig{
+				Replicas: randPtr(r, r.Int32N(3)+1),
+			},
+			WaspAgent: &hcov1.ComponentPodConfig{
+				PriorityClassName: randString(r),
+			},
+		}
+	}
+
+	if r.IntN(2) == 1 {
+		hc.Spec.Deployment.Standalone = &hcov1.StandaloneConfig{
+			CLIDownloads: &hcov1.CLIDownloadsConfig{
+				Host:             randString(r),
+				Exposure:         hcov1.CLIDownloadsExposureIngress,
+				IngressClassName: randPtr(r, randString(r)),
+			},
+			DeployGoldenImages: randPtr(r, r.IntN(2) == 1),
+		}
+	}
+
+	if r.IntN(2) == 1 {
+		hc.Spec.Virtualization.AIE = &hcov1.AIEConfig{
+			Rules: []hcov1.AIELauncherRule{
+				{
+					Name:         randString(r),
+					Image:        randString(r),
+					DeviceNames:  randStringSlice(r),
+					VMLabels:     map[string]string{randString(r): randString(r)},
+					NodeSelector: map[string]string{randString(r): randString(r)},
+				},
+			},
+		}
+	}
+
+	if r.IntN(2) == 1 {
+		hc.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedCustomTuningPolicy
+		hc.Spec.Virtualization.Tuning = &hcov1.TuningConfig{
+			API:     &hcov1.RateLimit{QPS: r.Int32N(500) + 1, Burst: r.Int32N(1000) + 1},
+			Handler: &hcov1.RateLimit{QPS: r.Int32N(500) + 1, Burst: r.Int32N(1000) + 1},
+		}
+	}
+
+	if r.IntN(2) == 1 {
+		hc.Spec.Virtualization.MigrationPolicies = []hcov1.MigrationPolicyProfile{
+			{
+				Name:              "latency-sensitive",
+				VMSelector:        map[string]string{randString(r): randString(r)},
+				AllowAutoConverge: new(r.IntN(2) == 1),
+			},
+			{
+				Name:                    "bulk",
+				NamespaceSelector:       map[string]string{randString(r): randString(r)},
+				BandwidthPerMigration:   new(fmt.Sprintf("%dMi", r.IntN(1000)+1)),
+				CompletionTimeoutPerGiB: new(r.Int64N(800) + 1),
+			},
+		}
+	}
+
 	if r.IntN(2) == 1 {
 		hc.Spec.Observability = &hcov1.ObservabilityConfig{
 			AllowedAlerts:         randStringSlice(r),
@@ -543,6 +674,76 @@ func randomV1HC(r *rand.Rand) *hcov1.HyperConverged {
 				AllowedMetrics: randStringSlice(r),
 			}
 		}
+		if r.IntN(2) == 1 {
+			hc.Spec.Observability.Silences = []hcov1.AlertSilence{
+				{
+					Name: randString(r),
+					Matchers: []hcov1.AlertSilenceMatcher{
+						{Name: "alertname", Value: randString(r), Type: hcov1.AlertSilenceMatcherEqual},
+					},
+					Comment:     randString(r),
+					Duration:    randPtr(r, metav1.Duration{Duration: time.Duration(r.IntN(48)+3) * time.Hour}),
+					RenewBefore: randPtr(r, metav1.Duration{Duration: time.Duration(r.IntN(2)+1) * time.Hour}),
+				},
+			}
+		}
+		if r.IntN(2) == 1 {
+			hc.Spec.Observability.AlertOverrides = []hcov1.AlertOverride{
+				{
+					Alert:       randString(r),
+					Threshold:   "0.8",
+					For:         randPtr(r, metav1.Duration{Duration: time.Duration(r.IntN(60)+1) * time.Minute}),
+					Severity:    hcov1.AlertSeverityCritical,
+					Labels:      map[string]string{"team": randString(r)},
+					Annotations: map[string]string{"runbook_url": randString(r)},
+				},
+			}
+		}
+		if r.IntN(2) == 1 {
+			hc.Spec.Observability.Alerting = &hcov1.AlertingConfig{
+				RunbookBaseURL:   "https://" + randString(r),
+				AdditionalLabels: map[string]string{"team": randString(r)},
+				SeverityMapping: []hcov1.AlertSeverityMapping{
+					{From: hcov1.AlertSeverityCritical, To: hcov1.AlertSeverityWarning},
+				},
+			}
+		}
+		if r.IntN(2) == 1 {
+			hc.Spec.Observability.Dashboards = &hcov1.DashboardsConfig{
+				DisabledDashboards:            []hcov1.BuiltInDashboard{"cnv-virtual-machines-inventory"},
+				DatasourceName:                randString(r),
+				AdditionalDashboardConfigMaps: []string{randString(r)},
+			}
+		}
+	}
+
+	if r.IntN(2) == 1 {
+		hc.Spec.Overrides = []hcov1.OperandOverride{
+			{
+				Target: hcov1.OperandOverrideTargetKubeVirt,
+				Type:   hcov1.OperandOverridePatchTypeJSONPatch,
+				JSONPatch: []hcov1.JSONPatchOperation{
+					{
+						Op:    "add",
+						Path:  "/spec/" + randString(r),
+						Value: &apiextensionsv1.JSON{Raw: []byte(`"` + randString(r) + `"`)},
+					},
+				},
+			},
+			{
+				Target:              hcov1.OperandOverrideTargetCDI,
+				Type:                hcov1.OperandOverridePatchTypeStrategicMerge,
+				StrategicMergePatch: &apiextensionsv1.JSON{Raw: []byte(`{"spec":{"` + randString(r) + `":true}}`)},
+			},
+		}
+	}
+
+	if r.IntN(2) == 1 {
+		hc.Spec.FeatureGatesPolicy = &hcov1.FeatureGatesPolicy{
+			Alpha:               hcov1.FeatureGatePolicyWarn,
+			Deprecated:          hcov1.FeatureGatePolicyRemove,
+			NoAlphaFeatureGates: r.IntN(2) == 1,
+		}
 	}
 
 	return hc
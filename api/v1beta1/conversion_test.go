@@ -2666,6 +2666,23 @@ var _ = Describe("api/v1beta1", func() {
 				Expect(v1VirtConfig.TuningPolicy).To(Equal(hcov1.HyperConvergedAnnotationTuningPolicy))
 			})
 
+			DescribeTable("should convert the tuning profiles", func(policy hcov1.HyperConvergedTuningPolicy) {
+				v1beta1Spec := HyperConvergedSpec{
+					TuningPolicy: policy,
+				}
+
+				var v1VirtConfig hcov1.VirtualizationConfig
+				Expect(convertVirtualizationV1beta1ToV1(v1beta1Spec, &v1VirtConfig)).To(Succeed())
+
+				Expect(v1VirtConfig.TuningPolicy).To(Equal(policy))
+			},
+				Entry("small", hcov1.HyperConvergedSmallTuningPolicy),
+				Entry("medium", hcov1.HyperConvergedMediumTuningPolicy),
+				Entry("large", hcov1.HyperConvergedLargeTuningPolicy),
+				Entry("custom", hcov1.HyperConvergedCustomTuningPolicy),
+				Entry("auto", hcov1.HyperConvergedAutoTuningPolicy),
+			)
+
 			It("should not convert tuningPolicy if it's 'highBurst'", func() {
 				v1beta1Spec := HyperConvergedSpec{
 					TuningPolicy: HyperConvergedHighBurstProfile, //nolint SA1019
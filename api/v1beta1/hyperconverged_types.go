@@ -46,7 +46,10 @@ type HyperConvergedSpec struct {
 	// If TuningPolicy is not present the default kubevirt values are used.
 	// It can be set to `annotation` for fine-tuning the kubevirt queryPerSeconds (qps) and burst values.
 	// Qps and burst values are taken from the annotation hco.kubevirt.io/tuningPolicy
-	// +kubebuilder:validation:Enum=annotation;highBurst
+	// It can be set to one of the `small`, `medium` or `large` predefined profiles, or to `auto`, to use the values
+	// that HCO recommends according to the cluster size. The `custom` policy uses the per-component values from the
+	// tuning field, that is only available in the v1 API.
+	// +kubebuilder:validation:Enum=annotation;highBurst;small;medium;large;custom;auto
 	// +optional
 	// +k8s:conversion-gen=false
 	TuningPolicy hcov1.HyperConvergedTuningPolicy `json:"tuningPolicy,omitempty"`
@@ -169,13 +172,16 @@ type HyperConvergedSpec struct {
 	// UninstallStrategy defines how to proceed on uninstall when workloads (VirtualMachines, DataVolumes) still exist.
 	// BlockUninstallIfWorkloadsExist will prevent the CR from being removed when workloads still exist.
 	// BlockUninstallIfWorkloadsExist is the safest choice to protect your workloads from accidental data loss, so it's strongly advised.
+	// BlockUninstallAndReportWorkloads also prevents the CR from being removed when workloads still exist, and lists
+	// the blocking VirtualMachines, DataVolumes and DataImportCrons, by namespace, in the deletion error and in the
+	// UninstallBlocked condition.
 	// RemoveWorkloads will cause all the workloads to be cascading deleted on uninstallation.
 	// WARNING: please notice that RemoveWorkloads will cause your workloads to be deleted as soon as this CR will be, even accidentally, deleted.
 	// Please correctly consider the implications of this option before setting it.
 	// BlockUninstallIfWorkloadsExist is the default behaviour.
 	// +kubebuilder:default=BlockUninstallIfWorkloadsExist
 	// +default="BlockUninstallIfWorkloadsExist"
-	// +kubebuilder:validation:Enum=RemoveWorkloads;BlockUninstallIfWorkloadsExist
+	// +kubebuilder:validation:Enum=RemoveWorkloads;BlockUninstallIfWorkloadsExist;BlockUninstallAndReportWorkloads
 	// +optional
 	// +k8s:conversion-gen=false
 	UninstallStrategy hcov1.HyperConvergedUninstallStrategy `json:"uninstallStrategy,omitempty"`
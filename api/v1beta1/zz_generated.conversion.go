@@ -254,6 +254,7 @@ func Convert_v1beta1_HyperConvergedSpec_To_v1_HyperConvergedSpec(in *HyperConver
 
 func autoConvert_v1_HyperConvergedSpec_To_v1beta1_HyperConvergedSpec(in *v1.HyperConvergedSpec, out *HyperConvergedSpec, s conversion.Scope) error {
 	// INFO: in.FeatureGates opted out of conversion generation
+	// INFO: in.FeatureGatesPolicy opted out of conversion generation
 	// INFO: in.Virtualization opted out of conversion generation
 	// INFO: in.Storage opted out of conversion generation
 	// INFO: in.Networking opted out of conversion generation
@@ -261,6 +262,7 @@ func autoConvert_v1_HyperConvergedSpec_To_v1beta1_HyperConvergedSpec(in *v1.Hype
 	// INFO: in.Security opted out of conversion generation
 	// INFO: in.Deployment opted out of conversion generation
 	// INFO: in.Observability opted out of conversion generation
+	// INFO: in.Overrides opted out of conversion generation
 	return nil
 }
 
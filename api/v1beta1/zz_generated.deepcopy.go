@@ -435,7 +435,7 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 	if in.HigherWorkloadDensity != nil {
 		in, out := &in.HigherWorkloadDensity, &out.HigherWorkloadDensity
 		*out = new(apiv1.HigherWorkloadDensityConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.EnableCommonBootImageImport != nil {
 		in, out := &in.EnableCommonBootImageImport, &out.EnableCommonBootImageImport
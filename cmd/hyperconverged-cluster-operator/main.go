@@ -53,6 +53,7 @@ import (
 
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	migrationv1alpha1 "kubevirt.io/kubevirt-migration-operator/api/v1alpha1"
@@ -113,6 +114,7 @@ var (
 		monitoringv1.AddToScheme,
 		apiextensionsv1.AddToScheme,
 		kubevirtcorev1.AddToScheme,
+		migrationsv1alpha1.AddToScheme,
 		coordinationv1.AddToScheme,
 		operatorsapiv2.AddToScheme,
 		imagev1.Install,
@@ -374,6 +376,10 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo, persesAvai
 				Label: labelSelector,
 				Field: namespaceSelector,
 			},
+			&corev1.Pod{}: {
+				Label: labelSelector,
+				Field: namespaceSelector,
+			},
 			//nolint:staticcheck
 			&corev1.Endpoints{}: {
 				Field: namespaceSelector,
@@ -409,6 +415,9 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo, persesAvai
 			&admissionregistrationv1.MutatingWebhookConfiguration{}: {
 				Label: labelSelector,
 			},
+			&migrationsv1alpha1.MigrationPolicy{}: {
+				Label: labelSelector,
+			},
 		},
 	}
 
@@ -472,6 +481,16 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo, persesAvai
 		},
 	}
 
+	cacheOptionsByObjectForKubernetes := map[client.Object]cache.ByObject{
+		&networkingv1.Ingress{}: {
+			Label: labelSelector,
+			Field: namespaceSelector,
+		},
+		&cdiv1beta1.DataImportCron{}: {
+			Label: labelSelector,
+		},
+	}
+
 	if ci.IsMonitoringAvailable() {
 		maps.Copy(cacheOptions.ByObject, cacheOptionsByObjectForMonitoring)
 	}
@@ -480,6 +499,8 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo, persesAvai
 	}
 	if ci.IsOpenshift() {
 		maps.Copy(cacheOptions.ByObject, cacheOptionsByObjectForOpenshift)
+	} else {
+		maps.Copy(cacheOptions.ByObject, cacheOptionsByObjectForKubernetes)
 	}
 
 	return cacheOptions
@@ -145,6 +145,16 @@ func main() {
 			TLSOpts:  []func(*tls.Config){tlssecprofile.MutateTLSConfig},
 		}),
 		Cache: getCacheOption(operatorNamespace, hcoutil.GetClusterInfo()),
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				// the workloads are only listed on deletion of the HyperConverged CR; don't cache them
+				DisableFor: []client.Object{
+					&kubevirtcorev1.VirtualMachine{},
+					&cdiv1beta1.DataVolume{},
+					&cdiv1beta1.DataImportCron{},
+				},
+			},
+		},
 	})
 	cmdHelper.ExitOnError(err, "failed to create manager")
 
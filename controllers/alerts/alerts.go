@@ -9,6 +9,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/alertoverrides"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -21,6 +23,8 @@ const (
 
 type AlertRuleReconciler struct {
 	theRule *promv1.PrometheusRule
+	// the PrometheusRule without the alert overrides of the HyperConverged CR
+	baseRule *promv1.PrometheusRule
 }
 
 // newAlertRuleReconciler creates new AlertRuleReconciler instance and returns a pointer to it.
@@ -36,10 +40,23 @@ func newAlertRuleReconciler(namespace string, owner metav1.OwnerReference) (*Ale
 	}
 
 	return &AlertRuleReconciler{
-		theRule: rule,
+		theRule:  rule,
+		baseRule: rule.DeepCopy(),
 	}, nil
 }
 
+// UpdateFromHyperConverged applies the alerting configuration and the alert overrides of the HyperConverged CR to the
+// PrometheusRule
+func (r *AlertRuleReconciler) UpdateFromHyperConverged(hc *hcov1.HyperConverged) error {
+	rule := r.baseRule.DeepCopy()
+	if err := alertoverrides.Apply(rule, alertoverrides.GetConfig(hc)); err != nil {
+		return err
+	}
+
+	r.theRule = rule
+	return nil
+}
+
 func (r *AlertRuleReconciler) Kind() string {
 	return promv1.PrometheusRuleKind
 }
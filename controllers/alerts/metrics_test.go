@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -18,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
@@ -361,6 +363,100 @@ var _ = Describe("alert tests", func() {
 			Expect(metrics.GetOverwrittenModificationsCount(monitoringv1.PrometheusRuleKind, ruleName)).To(BeEquivalentTo(currentMetric))
 		})
 
+		It("should apply the alert overrides of the HyperConverged CR", func() {
+			existRule, err := rules.BuildPrometheusRule(commontestutils.Namespace, deploymentRef)
+			Expect(err).ToNot(HaveOccurred())
+
+			hco := commontestutils.NewHco()
+			hco.Spec.Observability = &hcov1.ObservabilityConfig{
+				AlertOverrides: []hcov1.AlertOverride{
+					{
+						Alert:         "HCOOperatorConditionsUnhealthy",
+						MatchSeverity: hcov1.AlertSeverityWarning,
+						For:           &metav1.Duration{Duration: 30 * time.Minute},
+						Labels:        map[string]string{"team": "sre"},
+					},
+				},
+			}
+			req = commontestutils.NewReq(hco)
+
+			cl := commontestutils.InitClient([]client.Object{ns, existRule})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).To(Succeed())
+
+			found := 0
+			for _, group := range pr.Spec.Groups {
+				for _, rule := range group.Rules {
+					if rule.Alert != "HCOOperatorConditionsUnhealthy" {
+						Expect(rule.Labels).ToNot(HaveKey("team"))
+						continue
+					}
+
+					found++
+					if rule.Labels["severity"] == "warning" {
+						Expect(rule.For).To(HaveValue(Equal(monitoringv1.Duration("30m"))))
+						Expect(rule.Labels).To(HaveKeyWithValue("team", "sre"))
+					} else {
+						Expect(rule.Labels).ToNot(HaveKey("team"))
+					}
+				}
+			}
+			Expect(found).To(Equal(2))
+			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
+
+			By("restoring the alert when the override is removed")
+			hco.Spec.Observability = nil
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).To(Succeed())
+			Expect(pr.Spec).To(Equal(existRule.Spec))
+		})
+
+		It("should apply the alerting configuration of the HyperConverged CR to the existing PrometheusRule", func() {
+			existRule, err := rules.BuildPrometheusRule(commontestutils.Namespace, deploymentRef)
+			Expect(err).ToNot(HaveOccurred())
+
+			hco := commontestutils.NewHco()
+			hco.Spec.Observability = &hcov1.ObservabilityConfig{
+				Alerting: &hcov1.AlertingConfig{
+					RunbookBaseURL:   "https://runbooks.example.com/kubevirt/",
+					AdditionalLabels: map[string]string{"team": "virt"},
+					SeverityMapping: []hcov1.AlertSeverityMapping{
+						{From: hcov1.AlertSeverityCritical, To: hcov1.AlertSeverityWarning},
+					},
+				},
+			}
+			req = commontestutils.NewReq(hco)
+
+			cl := commontestutils.InitClient([]client.Object{ns, existRule})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).To(Succeed())
+
+			for _, group := range pr.Spec.Groups {
+				for _, rule := range group.Rules {
+					if rule.Alert == "" {
+						continue
+					}
+
+					Expect(rule.Labels).To(HaveKeyWithValue("team", "virt"))
+					Expect(rule.Labels).ToNot(HaveKeyWithValue("severity", "critical"))
+					Expect(rule.Annotations).To(HaveKeyWithValue("runbook_url", "https://runbooks.example.com/kubevirt/"+rule.Alert))
+				}
+			}
+			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
+
+			By("restoring the alerts when the alerting configuration is removed")
+			hco.Spec.Observability = nil
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).To(Succeed())
+			Expect(pr.Spec).To(Equal(existRule.Spec))
+		})
+
 		It("should use the default runbook URL template when no ENV Variable is set", func() {
 			promRule, err := rules.BuildPrometheusRule(commontestutils.Namespace, deploymentRef)
 			Expect(err).ToNot(HaveOccurred())
@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/ownresources"
@@ -27,6 +28,12 @@ type MetricReconciler interface {
 	UpdateExistingResource(context.Context, client.Client, client.Object, logr.Logger) (client.Object, bool, error)
 }
 
+// HyperConvergedDependent is implemented by the MetricReconcilers whose resource depends on the HyperConverged CR.
+// UpdateFromHyperConverged is called before each reconciliation of the resource.
+type HyperConvergedDependent interface {
+	UpdateFromHyperConverged(hc *hcov1.HyperConverged) error
+}
+
 type MonitoringReconciler struct {
 	reconcilers        []MetricReconciler
 	scheme             *runtime.Scheme
@@ -101,6 +108,13 @@ func (r *MonitoringReconciler) Reconcile(req *common.HcoRequest, firstLoop bool)
 	objects := make([]client.Object, 0, len(r.reconcilers))
 
 	for _, rc := range r.reconcilers {
+		if dependent, ok := rc.(HyperConvergedDependent); ok {
+			if err := dependent.UpdateFromHyperConverged(req.Instance); err != nil {
+				req.Logger.Error(err, fmt.Sprintf("failed to build the %s", rc.Kind()))
+				return err
+			}
+		}
+
 		obj, err := r.reconcileOneResource(req, rc, firstLoop)
 		if err != nil {
 			return err
@@ -2,8 +2,10 @@ package common
 
 import (
 	"context"
+	"sync"
 
 	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
@@ -22,6 +24,15 @@ type HcoRequest struct {
 	StatusDirty                bool                  // is something was changed in the CR's Status
 	HCOTriggered               bool                  // if the request got triggered by a direct modification on HCO CR
 	Upgradeable                bool                  // if all the operands are upgradeable
+
+	lock             sync.Mutex        // serializes the modifications of the HyperConverged CR by concurrent operands
+	parent           *HcoRequest       // the request that an operand request was created from
+	conditionUpdates []conditionUpdate // the condition modifications of an operand request, by their order
+}
+
+type conditionUpdate struct {
+	condition metav1.Condition
+	ifUnset   bool
 }
 
 func NewHcoRequest(ctx context.Context, request reconcile.Request, log logr.Logger, upgradeMode, hcoTriggered bool) *HcoRequest {
@@ -43,3 +54,73 @@ func (req *HcoRequest) SetUpgradeMode(upgradeMode bool) {
 	req.UpgradeMode = upgradeMode
 	req.ComponentUpgradeInProgress = upgradeMode
 }
+
+// NewOperandRequest returns a request for the reconciliation of a single operand, that may run concurrently with the
+// reconciliation of other operands. The operand request shares the HyperConverged CR with req, but it has its own
+// in-memory conditions and flags, so the modifications of all the operands can be merged into req by MergeOperandRequest,
+// in a deterministic order.
+func (req *HcoRequest) NewOperandRequest() *HcoRequest {
+	return &HcoRequest{
+		Request:                    req.Request,
+		Logger:                     req.Logger,
+		Conditions:                 NewHcoConditions(),
+		Ctx:                        req.Ctx,
+		Instance:                   req.Instance,
+		UpgradeMode:                req.UpgradeMode,
+		ComponentUpgradeInProgress: req.ComponentUpgradeInProgress,
+		HCOTriggered:               req.HCOTriggered,
+		Upgradeable:                true,
+		parent:                     req,
+	}
+}
+
+// MergeOperandRequest applies the modifications of an operand request on req
+func (req *HcoRequest) MergeOperandRequest(operandReq *HcoRequest) {
+	for _, update := range operandReq.conditionUpdates {
+		if update.ifUnset {
+			req.Conditions.SetStatusConditionIfUnset(update.condition)
+		} else {
+			req.Conditions.SetStatusCondition(update.condition)
+		}
+	}
+
+	req.Dirty = req.Dirty || operandReq.Dirty
+	req.StatusDirty = req.StatusDirty || operandReq.StatusDirty
+	req.Upgradeable = req.Upgradeable && operandReq.Upgradeable
+}
+
+// SetStatusCondition sets an in-memory condition. Operand handlers must use it, rather than modifying req.Conditions
+// directly, so the modification is merged into the parent request.
+func (req *HcoRequest) SetStatusCondition(condition metav1.Condition) {
+	req.Conditions.SetStatusCondition(condition)
+	if req.parent != nil {
+		req.conditionUpdates = append(req.conditionUpdates, conditionUpdate{condition: condition})
+	}
+}
+
+// SetStatusConditionIfUnset sets an in-memory condition, if it is not already set. Operand handlers must use it, rather
+// than modifying req.Conditions directly, so the modification is merged into the parent request.
+func (req *HcoRequest) SetStatusConditionIfUnset(condition metav1.Condition) {
+	req.Conditions.SetStatusConditionIfUnset(condition)
+	if req.parent != nil {
+		req.conditionUpdates = append(req.conditionUpdates, conditionUpdate{condition: condition, ifUnset: true})
+	}
+}
+
+// Lock must be held by an operand handler while it modifies the HyperConverged CR, because operands may be reconciled
+// concurrently.
+func (req *HcoRequest) Lock() {
+	if req.parent != nil {
+		req.parent.Lock()
+		return
+	}
+	req.lock.Lock()
+}
+
+func (req *HcoRequest) Unlock() {
+	if req.parent != nil {
+		req.parent.Unlock()
+		return
+	}
+	req.lock.Unlock()
+}
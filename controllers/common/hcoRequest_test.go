@@ -5,6 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -63,4 +64,30 @@ var _ = Describe("Test HcoRequest", func() {
 		Expect(req.UpgradeMode).To(BeFalse())
 		Expect(req.ComponentUpgradeInProgress).To(BeFalse())
 	})
+
+	It("should merge the operand requests in order", func() {
+		req := NewHcoRequest(context.TODO(), reconcile.Request{}, logf.Log, false, true)
+
+		first := req.NewOperandRequest()
+		second := req.NewOperandRequest()
+
+		second.SetStatusCondition(metav1.Condition{Type: "Available", Status: metav1.ConditionFalse, Reason: "SecondNotAvailable"})
+		second.SetStatusConditionIfUnset(metav1.Condition{Type: "Upgradeable", Status: metav1.ConditionFalse, Reason: "SecondProgressing"})
+		second.StatusDirty = true
+
+		first.SetStatusCondition(metav1.Condition{Type: "Available", Status: metav1.ConditionFalse, Reason: "FirstNotAvailable"})
+		first.SetStatusConditionIfUnset(metav1.Condition{Type: "Upgradeable", Status: metav1.ConditionFalse, Reason: "FirstProgressing"})
+		first.Upgradeable = false
+
+		Expect(req.Conditions).To(BeEmpty())
+
+		req.MergeOperandRequest(first)
+		req.MergeOperandRequest(second)
+
+		Expect(req.Conditions["Available"].Reason).To(Equal("SecondNotAvailable"))
+		Expect(req.Conditions["Upgradeable"].Reason).To(Equal("FirstProgressing"))
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(req.Dirty).To(BeFalse())
+		Expect(req.Upgradeable).To(BeFalse())
+	})
 })
@@ -7,6 +7,7 @@ var (
 	origIsControlPlaneMultiNode       = nodeinfo.IsControlPlaneMultiNode
 	origIsControlPlaneNodeExists      = nodeinfo.IsControlPlaneNodeExists
 	origIsInfraHighlyAvailable        = nodeinfo.IsInfrastructureHighlyAvailable
+	origIsInfraMultiZone              = nodeinfo.IsInfrastructureMultiZone
 	origGetControlPlaneArchitectures  = nodeinfo.GetControlPlaneArchitectures
 	origGetWorkloadsArchitectures     = nodeinfo.GetWorkloadsArchitectures
 	origGetDefaultArchitecture        = nodeinfo.GetDefaultArchitecture
@@ -17,6 +18,7 @@ func ResetNodeInfoMocks() {
 	nodeinfo.IsControlPlaneMultiNode = origIsControlPlaneMultiNode
 	nodeinfo.IsControlPlaneNodeExists = origIsControlPlaneNodeExists
 	nodeinfo.IsInfrastructureHighlyAvailable = origIsInfraHighlyAvailable
+	nodeinfo.IsInfrastructureMultiZone = origIsInfraMultiZone
 	nodeinfo.GetControlPlaneArchitectures = origGetControlPlaneArchitectures
 	nodeinfo.GetWorkloadsArchitectures = origGetWorkloadsArchitectures
 	nodeinfo.GetDefaultArchitecture = origGetDefaultArchitecture
@@ -36,6 +36,7 @@ import (
 
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
@@ -170,6 +171,7 @@ func GetScheme() *runtime.Scheme {
 		for _, f := range []func(*runtime.Scheme) error{
 			api.AddToScheme,
 			kubevirtcorev1.AddToScheme,
+			migrationsv1alpha1.AddToScheme,
 			cdiv1beta1.AddToScheme,
 			networkaddonsv1.AddToScheme,
 			sspv1beta3.AddToScheme,
@@ -64,6 +64,10 @@ func (*aaqHooks) CheckComponentVersion(cr runtime.Object) bool {
 	return operands.CheckComponentVersion(hcoutil.AaqVersionEnvV, found.Status.ObservedVersion)
 }
 
+func (*aaqHooks) GetObservedVersion(cr runtime.Object) string {
+	return cr.(*aaqv1alpha1.AAQ).Status.ObservedVersion
+}
+
 func (h *aaqHooks) Reset() {
 	h.Lock()
 	defer h.Unlock()
@@ -115,14 +119,13 @@ func NewAAQ(hc *hcov1.HyperConverged) (*aaqv1alpha1.AAQ, error) {
 		TLSSecurityProfile: openshift2AAQSecProfile(tlssecprofile.GetTLSSecurityProfile(hc.Spec.Security.TLSSecurityProfile)),
 	}
 
-	if np := hc.Spec.Deployment.NodePlacements; np != nil {
-		if np.Infra != nil {
-			np.Infra.DeepCopyInto(&spec.Infra)
-		}
+	infra, workload := operands.GetNodePlacements(hc, hcov1.NodePlacementComponentAAQInfra, hcov1.NodePlacementComponentAAQWorkload)
+	if infra != nil {
+		spec.Infra = *infra
+	}
 
-		if np.Workload != nil {
-			np.Workload.DeepCopyInto(&spec.Workloads)
-		}
+	if workload != nil {
+		spec.Workloads = *workload
 	}
 
 	spec.Configuration.VmiCalculatorConfiguration.ConfigName = aaqv1alpha1.DedicatedVirtualResources
@@ -1,25 +1,105 @@
 package aie
 
 import (
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
-func NewAIEWebhookConfigMapHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
-	cm := newAIEWebhookConfigMap()
-	return operands.NewConditionalHandler(
-		operands.NewEditableCmHandler(Client, Scheme, cm),
-		shouldDeployAIE,
-		func(hc *hcov1.HyperConverged) client.Object {
-			return newAIEWebhookConfigMapWithNameOnly()
-		},
-	)
+const aieConfigKey = "config.yaml"
+
+// NewAIEWebhookConfigMapHandler returns the handler of the AIE launcher ConfigMap. The apiReader is used to read the
+// cluster-wide pull secret, that is not in the cache, to resolve the digests of the rule images.
+func NewAIEWebhookConfigMapHandler(Client client.Client, apiReader client.Reader, Scheme *runtime.Scheme) operands.Operand {
+	return newAIEWebhookConfigMapHandler(Client, Scheme, newRegistryDigestResolver(apiReader))
+}
+
+func newAIEWebhookConfigMapHandler(Client client.Client, Scheme *runtime.Scheme, resolver imageDigestResolver) *aieConfigMapHandler {
+	getCRWithName := func(hc *hcov1.HyperConverged) client.Object {
+		return newAIEWebhookConfigMapWithNameOnly()
+	}
+
+	return &aieConfigMapHandler{
+		editable: operands.NewConditionalHandler(
+			operands.NewEditableCmHandler(Client, Scheme, newAIEWebhookConfigMap()),
+			shouldDeployAIE,
+			getCRWithName,
+		),
+		rendered: operands.NewConditionalHandler(
+			operands.NewDynamicCmHandler(Client, Scheme, newRenderedAIEWebhookConfigMap),
+			shouldDeployAIE,
+			getCRWithName,
+		),
+		client:   Client,
+		resolver: resolver,
+	}
+}
+
+// aieConfigMapHandler manages the AIE launcher ConfigMap. If the rules are set in the HyperConverged CR, the ConfigMap
+// is rendered from them, and any modification is reverted. Otherwise, the ConfigMap is created with no rules, and the
+// user edits are kept.
+//
+// The handler also reports the state of the typed rules in the HyperConverged status.
+type aieConfigMapHandler struct {
+	editable *operands.ConditionalHandler
+	rendered *operands.ConditionalHandler
+	client   client.Client
+	resolver imageDigestResolver
+}
+
+func (h *aieConfigMapHandler) Ensure(req *common.HcoRequest) *operands.EnsureResult {
+	if getAIEConfig(req.Instance) == nil {
+		result := h.editable.Ensure(req)
+		if result.Err == nil {
+			setAIERulesStatus(req, nil)
+		}
+		return result
+	}
+
+	result := h.rendered.Ensure(req)
+	if result.Err != nil {
+		return result
+	}
+
+	if !shouldDeployAIE(req.Instance) {
+		setAIERulesStatus(req, nil)
+		return result
+	}
+
+	ruleStatuses, err := h.getRulesStatus(req)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	setAIERulesStatus(req, ruleStatuses)
+
+	return result
+}
+
+func (h *aieConfigMapHandler) Reset() {
+	h.editable.Reset()
+	h.rendered.Reset()
+}
+
+func (h *aieConfigMapHandler) GetFullCr(hc *hcov1.HyperConverged) (client.Object, error) {
+	if getAIEConfig(hc) == nil {
+		return h.editable.GetFullCr(hc)
+	}
+	return h.rendered.GetFullCr(hc)
+}
+
+func getAIEConfig(hc *hcov1.HyperConverged) *hcov1.AIEConfig {
+	return hc.Spec.Virtualization.AIE
 }
 
 func newAIEWebhookConfigMapWithNameOnly() *corev1.ConfigMap {
@@ -35,7 +115,70 @@ func newAIEWebhookConfigMapWithNameOnly() *corev1.ConfigMap {
 func newAIEWebhookConfigMap() *corev1.ConfigMap {
 	cm := newAIEWebhookConfigMapWithNameOnly()
 	cm.Data = map[string]string{
-		"config.yaml": "rules:\n",
+		aieConfigKey: "rules:\n",
 	}
 	return cm
 }
+
+// the format of the config.yaml key of the AIE launcher ConfigMap, as expected by the AIE webhook
+type launcherConfig struct {
+	Rules []launcherRule `json:"rules"`
+}
+
+type launcherRule struct {
+	Name         string                `json:"name"`
+	Image        string                `json:"image"`
+	Selector     launcherSelector      `json:"selector"`
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+}
+
+type launcherSelector struct {
+	DeviceNames []string              `json:"deviceNames,omitempty"`
+	VMLabels    *metav1.LabelSelector `json:"vmLabels,omitempty"`
+}
+
+func newRenderedAIEWebhookConfigMap(hc *hcov1.HyperConverged) (*corev1.ConfigMap, error) {
+	data, err := renderLauncherConfig(getAIEConfig(hc))
+	if err != nil {
+		return nil, err
+	}
+
+	cm := newAIEWebhookConfigMapWithNameOnly()
+	cm.Data = map[string]string{
+		aieConfigKey: data,
+	}
+	return cm, nil
+}
+
+func renderLauncherConfig(aieConfig *hcov1.AIEConfig) (string, error) {
+	cfg := launcherConfig{
+		Rules: make([]launcherRule, 0, len(aieConfig.Rules)),
+	}
+
+	for _, rule := range aieConfig.Rules {
+		lr := launcherRule{
+			Name:  rule.Name,
+			Image: rule.Image,
+			Selector: launcherSelector{
+				DeviceNames: rule.DeviceNames,
+			},
+		}
+
+		if len(rule.VMLabels) > 0 {
+			lr.Selector.VMLabels = &metav1.LabelSelector{MatchLabels: rule.VMLabels}
+		}
+
+		if len(rule.NodeSelector) > 0 {
+			lr.NodeSelector = &metav1.LabelSelector{MatchLabels: rule.NodeSelector}
+		}
+
+		cfg.Rules = append(cfg.Rules, lr)
+	}
+
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("failed to render the AIE launcher rules; %w", err)
+	}
+
+	return string(data), nil
+}
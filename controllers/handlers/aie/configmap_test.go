@@ -2,11 +2,14 @@ package aie
 
 import (
 	"context"
+	"errors"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
@@ -39,7 +42,7 @@ var _ = Describe("AIE Webhook ConfigMap", func() {
 		It("should not create if deploy-aie-webhook annotation is absent", func() {
 			cl = commontestutils.InitClient([]client.Object{hco})
 
-			handler := NewAIEWebhookConfigMapHandler(cl, commontestutils.GetScheme())
+			handler := NewAIEWebhookConfigMapHandler(cl, cl, commontestutils.GetScheme())
 			res := handler.Ensure(req)
 
 			Expect(res.Err).ToNot(HaveOccurred())
@@ -54,7 +57,7 @@ var _ = Describe("AIE Webhook ConfigMap", func() {
 			hco.Annotations[DeployAIEAnnotation] = "true"
 			cl = commontestutils.InitClient([]client.Object{hco})
 
-			handler := NewAIEWebhookConfigMapHandler(cl, commontestutils.GetScheme())
+			handler := NewAIEWebhookConfigMapHandler(cl, cl, commontestutils.GetScheme())
 			res := handler.Ensure(req)
 
 			Expect(res.Err).ToNot(HaveOccurred())
@@ -71,7 +74,7 @@ var _ = Describe("AIE Webhook ConfigMap", func() {
 			cm := newAIEWebhookConfigMap()
 			cl = commontestutils.InitClient([]client.Object{hco, cm})
 
-			handler := NewAIEWebhookConfigMapHandler(cl, commontestutils.GetScheme())
+			handler := NewAIEWebhookConfigMapHandler(cl, cl, commontestutils.GetScheme())
 			res := handler.Ensure(req)
 
 			Expect(res.Err).ToNot(HaveOccurred())
@@ -82,4 +85,243 @@ var _ = Describe("AIE Webhook ConfigMap", func() {
 			Expect(foundCMs.Items).To(BeEmpty())
 		})
 	})
+
+	Context("typed rules", func() {
+		var resolver *fakeDigestResolver
+
+		BeforeEach(func() {
+			hco.Annotations[DeployAIEAnnotation] = "true"
+			hco.Spec.Virtualization.AIE = &hcov1.AIEConfig{
+				Rules: []hcov1.AIELauncherRule{
+					{
+						Name:         "gpu-optimized-launcher",
+						Image:        "quay.io/my-org/virt-launcher-gpu:latest",
+						DeviceNames:  []string{"nvidia.com/GV100GL_Tesla_V100", "nvidia.com/TU104GL_Tesla_T4"},
+						NodeSelector: map[string]string{"nvidia.com/gpu.present": "true"},
+					},
+					{
+						Name:     "labeled-vms",
+						Image:    "quay.io/my-org/virt-launcher-custom:latest",
+						VMLabels: map[string]string{"my-org.io/use-custom-launcher": "true"},
+					},
+				},
+			}
+
+			resolver = &fakeDigestResolver{
+				digests: map[string]string{
+					"quay.io/my-org/virt-launcher-gpu:latest": "sha256:1234",
+				},
+			}
+		})
+
+		getConfig := func() launcherConfig {
+			GinkgoHelper()
+
+			cm := &corev1.ConfigMap{}
+			Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(newAIEWebhookConfigMapWithNameOnly()), cm)).To(Succeed())
+
+			cfg := launcherConfig{}
+			Expect(yaml.Unmarshal([]byte(cm.Data[aieConfigKey]), &cfg)).To(Succeed())
+			return cfg
+		}
+
+		It("should render the ConfigMap from the rules", func() {
+			cl = commontestutils.InitClient([]client.Object{hco})
+
+			handler := newAIEWebhookConfigMapHandler(cl, commontestutils.GetScheme(), resolver)
+			res := handler.Ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			cfg := getConfig()
+			Expect(cfg.Rules).To(HaveLen(2))
+
+			Expect(cfg.Rules[0].Name).To(Equal("gpu-optimized-launcher"))
+			Expect(cfg.Rules[0].Image).To(Equal("quay.io/my-org/virt-launcher-gpu:latest"))
+			Expect(cfg.Rules[0].Selector.DeviceNames).To(Equal([]string{"nvidia.com/GV100GL_Tesla_V100", "nvidia.com/TU104GL_Tesla_T4"}))
+			Expect(cfg.Rules[0].Selector.VMLabels).To(BeNil())
+			Expect(cfg.Rules[0].NodeSelector).To(Equal(&metav1.LabelSelector{MatchLabels: map[string]string{"nvidia.com/gpu.present": "true"}}))
+
+			Expect(cfg.Rules[1].Name).To(Equal("labeled-vms"))
+			Expect(cfg.Rules[1].Selector.DeviceNames).To(BeEmpty())
+			Expect(cfg.Rules[1].Selector.VMLabels).To(Equal(&metav1.LabelSelector{MatchLabels: map[string]string{"my-org.io/use-custom-launcher": "true"}}))
+			Expect(cfg.Rules[1].NodeSelector).To(BeNil())
+		})
+
+		It("should revert modifications of the rendered ConfigMap", func() {
+			cm := newAIEWebhookConfigMap()
+			cm.Data[aieConfigKey] = "rules:\n- name: user-rule\n  image: quay.io/my-org/other:latest\n"
+			cl = commontestutils.InitClient([]client.Object{hco, cm})
+
+			handler := newAIEWebhookConfigMapHandler(cl, commontestutils.GetScheme(), resolver)
+			res := handler.Ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			cfg := getConfig()
+			Expect(cfg.Rules).To(HaveLen(2))
+			Expect(cfg.Rules[0].Name).To(Equal("gpu-optimized-launcher"))
+			Expect(cfg.Rules[1].Name).To(Equal("labeled-vms"))
+		})
+
+		It("should keep the user edits, if the rules are not set", func() {
+			hco.Spec.Virtualization.AIE = nil
+
+			cm := newAIEWebhookConfigMap()
+			userConfig := "rules:\n- name: user-rule\n  image: quay.io/my-org/other:latest\n"
+			cm.Data[aieConfigKey] = userConfig
+			cl = commontestutils.InitClient([]client.Object{hco, cm})
+
+			handler := newAIEWebhookConfigMapHandler(cl, commontestutils.GetScheme(), resolver)
+			res := handler.Ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			foundCM := &corev1.ConfigMap{}
+			Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(cm), foundCM)).To(Succeed())
+			Expect(foundCM.Data).To(HaveKeyWithValue(aieConfigKey, userConfig))
+			Expect(req.Instance.Status.AIERules).To(BeEmpty())
+		})
+
+		It("should render an empty list of rules", func() {
+			hco.Spec.Virtualization.AIE.Rules = nil
+			cl = commontestutils.InitClient([]client.Object{hco})
+
+			handler := newAIEWebhookConfigMapHandler(cl, commontestutils.GetScheme(), resolver)
+			res := handler.Ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(getConfig().Rules).To(BeEmpty())
+			Expect(req.Instance.Status.AIERules).To(BeEmpty())
+		})
+
+		It("should delete the ConfigMap and clear the status, if the annotation is removed", func() {
+			delete(hco.Annotations, DeployAIEAnnotation)
+			hco.Status.AIERules = []hcov1.AIERuleStatus{{Name: "gpu-optimized-launcher"}}
+			cm := newAIEWebhookConfigMap()
+			cl = commontestutils.InitClient([]client.Object{hco, cm})
+
+			handler := newAIEWebhookConfigMapHandler(cl, commontestutils.GetScheme(), resolver)
+			res := handler.Ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Deleted).To(BeTrue())
+			Expect(req.Instance.Status.AIERules).To(BeEmpty())
+			Expect(req.StatusDirty).To(BeTrue())
+		})
+
+		It("should report the status of the rules", func() {
+			hco.Spec.Virtualization.PermittedHostDevices = &hcov1.PermittedHostDevices{
+				PciHostDevices: []hcov1.PciHostDevice{
+					{PCIDeviceSelector: "10DE:1DB4", ResourceName: "nvidia.com/GV100GL_Tesla_V100"},
+					{PCIDeviceSelector: "10DE:1EB8", ResourceName: "nvidia.com/TU104GL_Tesla_T4", Disabled: true},
+					{PCIDeviceSelector: "8086:6F54", ResourceName: "intel.com/qat"},
+				},
+				MediatedDevices: []hcov1.MediatedHostDevice{
+					{MDEVNameSelector: "GRID T4-1Q", ResourceName: "nvidia.com/TU104GL_Tesla_T4"},
+				},
+			}
+
+			gpuNode := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "gpu-node",
+					Labels: map[string]string{"nvidia.com/gpu.present": "true"},
+				},
+			}
+			otherNode := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "other-node",
+				},
+			}
+			cl = commontestutils.InitClient([]client.Object{hco, gpuNode, otherNode})
+
+			handler := newAIEWebhookConfigMapHandler(cl, commontestutils.GetScheme(), resolver)
+			res := handler.Ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.AIERules).To(Equal([]hcov1.AIERuleStatus{
+				{
+					Name:                         "gpu-optimized-launcher",
+					ImageDigest:                  "sha256:1234",
+					MatchingPermittedHostDevices: 2,
+					MatchingNodes:                new(int32(1)),
+				},
+				{
+					Name:                         "labeled-vms",
+					ImageError:                   "image not found",
+					MatchingPermittedHostDevices: 0,
+				},
+			}))
+		})
+
+		It("should report neither a digest nor an error, while the digest is being resolved", func() {
+			hco.Spec.Virtualization.AIE.Rules = hco.Spec.Virtualization.AIE.Rules[:1]
+			resolver.pending = true
+			cl = commontestutils.InitClient([]client.Object{hco})
+
+			handler := newAIEWebhookConfigMapHandler(cl, commontestutils.GetScheme(), resolver)
+			res := handler.Ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(req.Instance.Status.AIERules).To(Equal([]hcov1.AIERuleStatus{
+				{
+					Name:                         "gpu-optimized-launcher",
+					MatchingPermittedHostDevices: 0,
+					MatchingNodes:                new(int32(0)),
+				},
+			}))
+		})
+
+		It("should not update the status, if it was not changed", func() {
+			hco.Spec.Virtualization.AIE.Rules = hco.Spec.Virtualization.AIE.Rules[1:]
+			hco.Status.AIERules = []hcov1.AIERuleStatus{
+				{
+					Name:       "labeled-vms",
+					ImageError: "image not found",
+				},
+			}
+			cl = commontestutils.InitClient([]client.Object{hco})
+
+			handler := newAIEWebhookConfigMapHandler(cl, commontestutils.GetScheme(), resolver)
+			res := handler.Ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(req.StatusDirty).To(BeFalse())
+		})
+	})
+
+	Context("getImageDigest", func() {
+		It("should return the digest, if the image is referenced by its digest", func() {
+			const digest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+			Expect(getImageDigest("quay.io/my-org/virt-launcher-gpu@" + digest)).To(Equal(digest))
+		})
+
+		It("should not return a digest, if the image is referenced by a tag", func() {
+			Expect(getImageDigest("quay.io/my-org/virt-launcher-gpu:latest")).To(BeEmpty())
+		})
+
+		It("should fail for an invalid image reference", func() {
+			_, err := getImageDigest("quay.io/My-Org/virt-launcher-gpu:latest")
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
+
+type fakeDigestResolver struct {
+	digests map[string]string
+	pending bool
+}
+
+func (r *fakeDigestResolver) GetDigest(_ context.Context, image string) (string, bool, error) {
+	if r.pending {
+		return "", true, nil
+	}
+	if digest, ok := r.digests[image]; ok {
+		return digest, false, nil
+	}
+	return "", false, errors.New("image not found")
+}
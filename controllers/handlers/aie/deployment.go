@@ -16,6 +16,7 @@ import (
 
 	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/tlssecprofile"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -37,6 +38,10 @@ func NewAIEWebhookDeploymentHandler(cli client.Client, Scheme *runtime.Scheme) o
 	)
 }
 
+func NewAIEWebhookPDBHandler(cli client.Client, Scheme *runtime.Scheme) operands.Operand {
+	return operands.NewDeploymentPDBHandler(cli, Scheme, newAIEWebhookDeployment, shouldDeployAIE)
+}
+
 func newAIEWebhookDeploymentWithNameOnly() *appsv1.Deployment {
 	return &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
@@ -73,9 +78,14 @@ func newAIEWebhookDeployment(hc *hcov1.HyperConverged) *appsv1.Deployment {
 		args = append(args, "--tls-cipher-suites="+strings.Join(ianaCiphers, ","))
 	}
 
+	var replicas int32 = 1
+	if nodeinfo.IsInfrastructureHighlyAvailable() {
+		replicas = 2
+	}
+
 	dep := newAIEWebhookDeploymentWithNameOnly()
 	dep.Spec = appsv1.DeploymentSpec{
-		Replicas: new(int32(1)),
+		Replicas: &replicas,
 		Selector: &metav1.LabelSelector{
 			MatchLabels: selectorLabels,
 		},
@@ -173,5 +183,8 @@ func newAIEWebhookDeployment(hc *hcov1.HyperConverged) *appsv1.Deployment {
 		},
 	}
 
+	operands.ApplyDeploymentConfig(dep, operands.GetComponentsConfig(hc).AIEWebhook)
+	operands.SetTopologySpreadConstraints(hc, dep)
+
 	return dep
 }
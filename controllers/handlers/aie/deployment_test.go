@@ -16,6 +16,7 @@ import (
 	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/tlssecprofile"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -128,6 +129,24 @@ var _ = Describe("AIE Webhook Deployment", func() {
 			Expect(deployment.Spec.Template.Spec.Volumes[0].Secret.SecretName).To(Equal(aieWebhookTLSSecretName))
 		})
 
+		DescribeTable("should set the number of replicas according to the infrastructure high availability",
+			func(highlyAvailable bool, expectedReplicas int32) {
+				origFunc := nodeinfo.IsInfrastructureHighlyAvailable
+				DeferCleanup(func() {
+					nodeinfo.IsInfrastructureHighlyAvailable = origFunc
+				})
+
+				nodeinfo.IsInfrastructureHighlyAvailable = func() bool {
+					return highlyAvailable
+				}
+
+				deployment := newAIEWebhookDeployment(hco)
+				Expect(deployment.Spec.Replicas).To(HaveValue(Equal(expectedReplicas)))
+			},
+			Entry("highly available infrastructure", true, int32(2)),
+			Entry("SNO", false, int32(1)),
+		)
+
 		It("should not add ciphers for TLS 1.3", func() {
 			origFunc := tlssecprofile.GetCipherSuitesAndMinTLSVersion
 			tlssecprofile.GetCipherSuitesAndMinTLSVersion = func(fromHC *openshiftconfigv1.TLSSecurityProfile) ([]string, openshiftconfigv1.TLSProtocolVersion) {
@@ -0,0 +1,234 @@
+package aie
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/containers/image/v5/types"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	digestLookupTimeout = 10 * time.Second
+	// the digest of a tag may change, so a resolved digest is refreshed after a while
+	digestCacheTTL = time.Hour
+	// don't query the registry too often, if it fails
+	digestErrorCacheTTL = 5 * time.Minute
+
+	// the cluster-wide pull secret, on OpenShift
+	globalPullSecretNamespace = "openshift-config"
+	globalPullSecretName      = "pull-secret"
+)
+
+var (
+	digestLogger = logf.Log.WithName("aie-digest-resolver")
+
+	// digestResolvedEvents is notified when the digest of an image was resolved in the background
+	digestResolvedEvents = make(chan event.GenericEvent, 1)
+)
+
+// DigestResolvedEvents returns the channel that is notified when the digest of a rule image was resolved in the
+// background, to trigger a reconciliation that reports the digest in the HyperConverged status
+func DigestResolvedEvents() <-chan event.GenericEvent {
+	return digestResolvedEvents
+}
+
+// imageDigestResolver resolves the digests of the rule images
+type imageDigestResolver interface {
+	// GetDigest returns the digest of the image, or the reason why it could not be resolved. It never blocks on the
+	// image registry; if the digest is not known yet, GetDigest returns pending=true, and the digest is resolved in the
+	// background.
+	GetDigest(ctx context.Context, image string) (digest string, pending bool, err error)
+}
+
+type digestCacheEntry struct {
+	digest    string
+	err       error
+	resolved  bool
+	resolving bool
+	expires   time.Time
+}
+
+// registryDigestResolver resolves the image digests from the image registry in the background, and caches the
+// results. The registry is queried with the credentials from the cluster-wide pull secret, if it exists.
+type registryDigestResolver struct {
+	reader client.Reader
+
+	lock  sync.Mutex
+	cache map[string]*digestCacheEntry
+
+	// replaceable for testing
+	resolve func(ctx context.Context, image string, sys *types.SystemContext) (string, error)
+	notify  func()
+}
+
+func newRegistryDigestResolver(reader client.Reader) *registryDigestResolver {
+	return &registryDigestResolver{
+		reader:  reader,
+		cache:   make(map[string]*digestCacheEntry),
+		resolve: resolveImageDigest,
+		notify:  notifyDigestResolved,
+	}
+}
+
+func (r *registryDigestResolver) GetDigest(_ context.Context, image string) (string, bool, error) {
+	// no need to query the registry, if the image is referenced by its digest
+	digest, err := getImageDigest(image)
+	if err != nil || digest != "" {
+		return digest, false, err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	entry, ok := r.cache[image]
+	if !ok {
+		entry = &digestCacheEntry{}
+		r.cache[image] = entry
+	}
+
+	// an expired result is still reported, until it is refreshed
+	if !entry.resolving && time.Now().After(entry.expires) {
+		entry.resolving = true
+		go r.resolveInBackground(image)
+	}
+
+	return entry.digest, !entry.resolved, entry.err
+}
+
+func (r *registryDigestResolver) resolveInBackground(image string) {
+	ctx, cancel := context.WithTimeout(context.Background(), digestLookupTimeout)
+	defer cancel()
+
+	digest, err := r.resolveWithPullSecret(ctx, image)
+	if err != nil {
+		digestLogger.Info("failed to resolve the digest of the AIE rule image", "image", image, "error", err.Error())
+	}
+
+	ttl := digestCacheTTL
+	if err != nil {
+		ttl = digestErrorCacheTTL
+	}
+
+	r.lock.Lock()
+	r.cache[image] = &digestCacheEntry{
+		digest:   digest,
+		err:      err,
+		resolved: true,
+		expires:  time.Now().Add(ttl),
+	}
+	r.lock.Unlock()
+
+	r.notify()
+}
+
+func (r *registryDigestResolver) resolveWithPullSecret(ctx context.Context, image string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", err
+	}
+
+	auth, err := r.getRegistryAuth(ctx, reference.Domain(named))
+	if err != nil {
+		return "", err
+	}
+
+	var sys *types.SystemContext
+	if auth != nil {
+		sys = &types.SystemContext{DockerAuthConfig: auth}
+	}
+
+	return r.resolve(ctx, image, sys)
+}
+
+// dockerConfigJSON is the format of the .dockerconfigjson key of a pull secret
+type dockerConfigJSON struct {
+	Auths map[string]struct {
+		Auth string `json:"auth,omitempty"`
+	} `json:"auths"`
+}
+
+// getRegistryAuth returns the credentials of the registry from the cluster-wide pull secret. It returns nil, if the
+// pull secret does not exist, or if it has no credentials for the registry.
+func (r *registryDigestResolver) getRegistryAuth(ctx context.Context, registry string) (*types.DockerAuthConfig, error) {
+	secret := &corev1.Secret{}
+	err := r.reader.Get(ctx, client.ObjectKey{Namespace: globalPullSecretNamespace, Name: globalPullSecretName}, secret)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read the %s/%s pull secret; %w", globalPullSecretNamespace, globalPullSecretName, err)
+	}
+
+	cfg := dockerConfigJSON{}
+	if err = json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse the %s/%s pull secret; %w", globalPullSecretNamespace, globalPullSecretName, err)
+	}
+
+	for key, entry := range cfg.Auths {
+		if normalizeRegistry(key) != registry {
+			continue
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the %s credentials in the %s/%s pull secret; %w", registry, globalPullSecretNamespace, globalPullSecretName, err)
+		}
+
+		user, password, found := strings.Cut(string(decoded), ":")
+		if !found {
+			return nil, errors.New("wrong format of the " + registry + " credentials in the " + globalPullSecretNamespace + "/" + globalPullSecretName + " pull secret")
+		}
+
+		return &types.DockerAuthConfig{Username: user, Password: password}, nil
+	}
+
+	return nil, nil
+}
+
+// normalizeRegistry returns the registry host of a pull secret key, that may be a URL; e.g. https://index.docker.io/v1/
+func normalizeRegistry(key string) string {
+	key = strings.TrimPrefix(key, "https://")
+	key = strings.TrimPrefix(key, "http://")
+	key, _, _ = strings.Cut(key, "/")
+
+	if key == "index.docker.io" || key == "registry-1.docker.io" {
+		return "docker.io"
+	}
+
+	return key
+}
+
+func resolveImageDigest(ctx context.Context, image string, sys *types.SystemContext) (string, error) {
+	imgRef, err := docker.ParseReference("//" + image)
+	if err != nil {
+		return "", err
+	}
+
+	digest, err := docker.GetDigest(ctx, sys, imgRef)
+	if err != nil {
+		return "", err
+	}
+
+	return digest.String(), nil
+}
+
+// notifyDigestResolved triggers a reconciliation. If the channel is full, a reconciliation is already pending.
+func notifyDigestResolved() {
+	select {
+	case digestResolvedEvents <- event.GenericEvent{}:
+	default:
+	}
+}
@@ -0,0 +1,184 @@
+package aie
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/containers/image/v5/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("registryDigestResolver", func() {
+	const image = "quay.io/my-org/virt-launcher-gpu:latest"
+
+	var (
+		resolver  *registryDigestResolver
+		resolved  chan struct{}
+		calls     atomic.Int32
+		lastSys   atomic.Pointer[types.SystemContext]
+		resolveFn func(ctx context.Context, image string, sys *types.SystemContext) (string, error)
+	)
+
+	newResolver := func(objects ...client.Object) {
+		cl := commontestutils.InitClient(objects)
+		resolver = newRegistryDigestResolver(cl)
+		resolver.resolve = func(ctx context.Context, image string, sys *types.SystemContext) (string, error) {
+			calls.Add(1)
+			lastSys.Store(sys)
+			return resolveFn(ctx, image, sys)
+		}
+		resolver.notify = func() {
+			resolved <- struct{}{}
+		}
+	}
+
+	BeforeEach(func() {
+		resolved = make(chan struct{}, 10)
+		calls.Store(0)
+		lastSys.Store(nil)
+		resolveFn = func(_ context.Context, _ string, _ *types.SystemContext) (string, error) {
+			return "sha256:1234", nil
+		}
+	})
+
+	It("should not query the registry, if the image is referenced by its digest", func() {
+		const digest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+		newResolver()
+
+		Expect(resolver.GetDigest(context.Background(), "quay.io/my-org/virt-launcher-gpu@"+digest)).To(Equal(digest))
+		Consistently(resolved).WithTimeout(100 * time.Millisecond).ShouldNot(Receive())
+		Expect(calls.Load()).To(BeZero())
+	})
+
+	It("should fail for an invalid image reference, without querying the registry", func() {
+		newResolver()
+
+		_, pending, err := resolver.GetDigest(context.Background(), "quay.io/My-Org/virt-launcher-gpu:latest")
+		Expect(err).To(HaveOccurred())
+		Expect(pending).To(BeFalse())
+		Expect(calls.Load()).To(BeZero())
+	})
+
+	It("should resolve the digest in the background, and then report it from the cache", func() {
+		block := make(chan struct{})
+		resolveFn = func(_ context.Context, _ string, _ *types.SystemContext) (string, error) {
+			<-block
+			return "sha256:1234", nil
+		}
+		newResolver()
+
+		digest, pending, err := resolver.GetDigest(context.Background(), image)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeTrue())
+		Expect(digest).To(BeEmpty())
+
+		// a second call, while resolving, must not query the registry again
+		_, pending, _ = resolver.GetDigest(context.Background(), image)
+		Expect(pending).To(BeTrue())
+
+		close(block)
+		Eventually(resolved).Should(Receive())
+
+		digest, pending, err = resolver.GetDigest(context.Background(), image)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeFalse())
+		Expect(digest).To(Equal("sha256:1234"))
+		Expect(calls.Load()).To(Equal(int32(1)))
+	})
+
+	It("should cache and report the resolution error", func() {
+		resolveFn = func(_ context.Context, _ string, _ *types.SystemContext) (string, error) {
+			return "", errors.New("unauthorized")
+		}
+		newResolver()
+
+		_, pending, _ := resolver.GetDigest(context.Background(), image)
+		Expect(pending).To(BeTrue())
+		Eventually(resolved).Should(Receive())
+
+		digest, pending, err := resolver.GetDigest(context.Background(), image)
+		Expect(err).To(MatchError("unauthorized"))
+		Expect(pending).To(BeFalse())
+		Expect(digest).To(BeEmpty())
+		Expect(calls.Load()).To(Equal(int32(1)))
+	})
+
+	It("should keep reporting an expired digest, while refreshing it", func() {
+		newResolver()
+		resolver.cache[image] = &digestCacheEntry{
+			digest:   "sha256:old",
+			resolved: true,
+			expires:  time.Now().Add(-time.Minute),
+		}
+
+		digest, pending, err := resolver.GetDigest(context.Background(), image)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeFalse())
+		Expect(digest).To(Equal("sha256:old"))
+
+		Eventually(resolved).Should(Receive())
+		Expect(resolver.GetDigest(context.Background(), image)).To(Equal("sha256:1234"))
+	})
+
+	It("should query the registry anonymously, if there is no pull secret", func() {
+		newResolver()
+
+		_, _, _ = resolver.GetDigest(context.Background(), image)
+		Eventually(resolved).Should(Receive())
+
+		Expect(calls.Load()).To(Equal(int32(1)))
+		Expect(lastSys.Load()).To(BeNil())
+	})
+
+	It("should use the registry credentials from the cluster-wide pull secret", func() {
+		pullSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      globalPullSecretName,
+				Namespace: globalPullSecretNamespace,
+			},
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths": {` +
+					`"https://quay.io/v1/": {"auth": "` + base64.StdEncoding.EncodeToString([]byte("user:password")) + `"},` +
+					`"registry.example.com": {"auth": "` + base64.StdEncoding.EncodeToString([]byte("other:other")) + `"}}}`),
+			},
+		}
+		newResolver(pullSecret)
+
+		_, _, _ = resolver.GetDigest(context.Background(), image)
+		Eventually(resolved).Should(Receive())
+
+		Expect(lastSys.Load()).ToNot(BeNil())
+		Expect(lastSys.Load().DockerAuthConfig).To(Equal(&types.DockerAuthConfig{Username: "user", Password: "password"}))
+	})
+
+	It("should report a malformed pull secret", func() {
+		pullSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      globalPullSecretName,
+				Namespace: globalPullSecretNamespace,
+			},
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`not a json`),
+			},
+		}
+		newResolver(pullSecret)
+
+		_, _, _ = resolver.GetDigest(context.Background(), image)
+		Eventually(resolved).Should(Receive())
+
+		_, _, err := resolver.GetDigest(context.Background(), image)
+		Expect(err).To(MatchError(ContainSubstring("failed to parse the openshift-config/pull-secret pull secret")))
+		Expect(calls.Load()).To(BeZero())
+	})
+})
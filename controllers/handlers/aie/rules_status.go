@@ -0,0 +1,104 @@
+package aie
+
+import (
+	"slices"
+
+	"github.com/containers/image/v5/docker/reference"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// getImageDigest returns the digest of the image, if the image is referenced by its digest. For an image that is
+// referenced by a tag, it returns an empty string.
+func getImageDigest(image string) (string, error) {
+	imgRef, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", err
+	}
+
+	if canonical, ok := imgRef.(reference.Canonical); ok {
+		return canonical.Digest().String(), nil
+	}
+
+	return "", nil
+}
+
+func (h *aieConfigMapHandler) getRulesStatus(req *common.HcoRequest) ([]hcov1.AIERuleStatus, error) {
+	rules := getAIEConfig(req.Instance).Rules
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	statuses := make([]hcov1.AIERuleStatus, 0, len(rules))
+	for _, rule := range rules {
+		status := hcov1.AIERuleStatus{
+			Name:                         rule.Name,
+			MatchingPermittedHostDevices: countMatchingPermittedHostDevices(req.Instance.Spec.Virtualization.PermittedHostDevices, rule.DeviceNames),
+		}
+
+		// while the digest is being resolved, neither the digest nor an error is reported
+		digest, _, err := h.resolver.GetDigest(req.Ctx, rule.Image)
+		if err != nil {
+			status.ImageError = err.Error()
+		} else {
+			status.ImageDigest = digest
+		}
+
+		if len(rule.NodeSelector) > 0 {
+			nodes := &corev1.NodeList{}
+			if err = h.client.List(req.Ctx, nodes, client.MatchingLabels(rule.NodeSelector)); err != nil {
+				return nil, err
+			}
+			status.MatchingNodes = new(int32(len(nodes.Items)))
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// countMatchingPermittedHostDevices returns the number of the enabled permitted host devices, with one of the
+// deviceNames as their resource name
+func countMatchingPermittedHostDevices(devices *hcov1.PermittedHostDevices, deviceNames []string) int32 {
+	if devices == nil || len(deviceNames) == 0 {
+		return 0
+	}
+
+	var count int32
+	for _, dev := range devices.PciHostDevices {
+		if !dev.Disabled && slices.Contains(deviceNames, dev.ResourceName) {
+			count++
+		}
+	}
+
+	for _, dev := range devices.USBHostDevices {
+		if !dev.Disabled && slices.Contains(deviceNames, dev.ResourceName) {
+			count++
+		}
+	}
+
+	for _, dev := range devices.MediatedDevices {
+		if !dev.Disabled && slices.Contains(deviceNames, dev.ResourceName) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func setAIERulesStatus(req *common.HcoRequest, ruleStatuses []hcov1.AIERuleStatus) {
+	req.Lock()
+	defer req.Unlock()
+
+	if equality.Semantic.DeepEqual(req.Instance.Status.AIERules, ruleStatuses) {
+		return
+	}
+
+	req.Instance.Status.AIERules = ruleStatuses
+	req.StatusDirty = true
+}
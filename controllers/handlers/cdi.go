@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"errors"
+	"maps"
 	"reflect"
 	"slices"
 	"sync"
@@ -70,6 +71,9 @@ func (*cdiHooks) CheckComponentVersion(cr runtime.Object) bool {
 	found := cr.(*cdiv1beta1.CDI)
 	return operands.CheckComponentVersion(util.CdiVersionEnvV, found.Status.ObservedVersion)
 }
+func (*cdiHooks) GetObservedVersion(cr runtime.Object) string {
+	return cr.(*cdiv1beta1.CDI).Status.ObservedVersion
+}
 func (h *cdiHooks) Reset() {
 	h.Lock()
 	defer h.Unlock()
@@ -104,10 +108,30 @@ func (*cdiHooks) UpdateCR(req *common.HcoRequest, Client client.Client, exists r
 	return false, false, nil
 }
 
+// cdiFeatureGatesOfHcoFeatureGates maps the HCO feature gates to the CDI feature gates that HCO enables in the CDI CR,
+// when the HCO feature gate is enabled. Currently, there is no such HCO feature gate.
+var cdiFeatureGatesOfHcoFeatureGates = map[string][]string{}
+
+// GetCDIFeatureGatesOf returns the CDI feature gates that HCO enables when the HCO feature gate is enabled
+func GetCDIFeatureGatesOf(fgName string) []string {
+	return slices.Clone(cdiFeatureGatesOfHcoFeatureGates[fgName])
+}
+
 func getDefaultFeatureGates() []string {
 	return []string{honorWaitForFirstConsumerGate, dataVolumeClaimAdoptionGate}
 }
 
+func getCDIFeatureGates(hc *hcov1.HyperConverged) []string {
+	fgs := getDefaultFeatureGates()
+	for _, fgName := range slices.Sorted(maps.Keys(cdiFeatureGatesOfHcoFeatureGates)) {
+		if hc.Spec.FeatureGates.IsEnabled(fgName) {
+			fgs = append(fgs, cdiFeatureGatesOfHcoFeatureGates[fgName]...)
+		}
+	}
+
+	return fgs
+}
+
 func NewCDI(hc *hcov1.HyperConverged) (*cdiv1beta1.CDI, error) {
 	uninstallStrategy := cdiv1beta1.CDIUninstallStrategyBlockUninstallIfWorkloadsExist
 	if hc.Spec.Deployment.UninstallStrategy == hcov1.HyperConvergedUninstallStrategyRemoveWorkloads {
@@ -117,7 +141,7 @@ func NewCDI(hc *hcov1.HyperConverged) (*cdiv1beta1.CDI, error) {
 	spec := cdiv1beta1.CDISpec{
 		UninstallStrategy: &uninstallStrategy,
 		Config: &cdiv1beta1.CDIConfigSpec{
-			FeatureGates:       getDefaultFeatureGates(),
+			FeatureGates:       getCDIFeatureGates(hc),
 			TLSSecurityProfile: openshift2CdiSecProfile(tlssecprofile.GetTLSSecurityProfile(hc.Spec.Security.TLSSecurityProfile)),
 		},
 		CertConfig: &cdiv1beta1.CDICertConfig{
@@ -134,7 +158,7 @@ func NewCDI(hc *hcov1.HyperConverged) (*cdiv1beta1.CDI, error) {
 
 	hcoStorage2CDISpec(hc.Spec.Storage, &spec)
 
-	hcoNodePlacementToCDI(hc.Spec.Deployment.NodePlacements, &spec)
+	hcoNodePlacementToCDI(hc, &spec)
 
 	if lv := hc.Spec.Deployment.LogVerbosityConfig; lv != nil {
 		spec.Config.LogVerbosity = lv.CDI
@@ -147,18 +171,21 @@ func NewCDI(hc *hcov1.HyperConverged) (*cdiv1beta1.CDI, error) {
 		return nil, err
 	}
 
+	if err := operands.ApplyOverrides(hc, hcov1.OperandOverrideTargetCDI, cdi); err != nil {
+		return nil, err
+	}
+
 	return reformatobj.ReformatObj(cdi)
 }
 
-func hcoNodePlacementToCDI(np *hcov1.NodePlacements, spec *cdiv1beta1.CDISpec) {
-	if np != nil {
-		if np.Infra != nil {
-			np.Infra.DeepCopyInto(&spec.Infra.NodePlacement)
-		}
+func hcoNodePlacementToCDI(hc *hcov1.HyperConverged, spec *cdiv1beta1.CDISpec) {
+	infra, workload := operands.GetNodePlacements(hc, hcov1.NodePlacementComponentCDIInfra, hcov1.NodePlacementComponentCDIWorkload)
+	if infra != nil {
+		spec.Infra.NodePlacement = *infra
+	}
 
-		if np.Workload != nil {
-			np.Workload.DeepCopyInto(&spec.Workloads)
-		}
+	if workload != nil {
+		spec.Workloads = *workload
 	}
 }
 
@@ -18,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
 
 	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
@@ -220,6 +221,32 @@ var _ = Describe("CDI Operand", func() {
 				Expect(req.Conditions).To(BeEmpty())
 			})
 
+			It("should merge the CDI component node placement on top of the infra and workload node placements", func() {
+				commontestutils.SetNodePlacement(hco)
+				hco.Spec.Deployment.NodePlacements.Components = []hcov1.ComponentNodePlacement{
+					{
+						Component: hcov1.NodePlacementComponentCDIInfra,
+						NodePlacement: sdkapi.NodePlacement{
+							NodeSelector: map[string]string{"pool": "cdi"},
+						},
+					},
+					{
+						Component: hcov1.NodePlacementComponentKubeVirtWorkload,
+						NodePlacement: sdkapi.NodePlacement{
+							NodeSelector: map[string]string{"pool": "kubevirt"},
+						},
+					},
+				}
+
+				cdi, err := NewCDI(hco)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(cdi.Spec.Infra.NodeSelector).To(Equal(map[string]string{"pool": "cdi"}))
+				Expect(cdi.Spec.Infra.Affinity).To(Equal(hco.Spec.Deployment.NodePlacements.Infra.Affinity))
+				Expect(cdi.Spec.Infra.Tolerations).To(Equal(hco.Spec.Deployment.NodePlacements.Infra.Tolerations))
+				Expect(cdi.Spec.Workloads).To(Equal(*hco.Spec.Deployment.NodePlacements.Workload))
+			})
+
 			It("should remove node placement if missing in HCO CR", func() {
 
 				hcoNodePlacement := commontestutils.NewHco()
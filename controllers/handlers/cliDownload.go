@@ -9,6 +9,7 @@ import (
 	consolev1 "github.com/openshift/api/console/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -237,3 +238,136 @@ func hasRouteRightFields(found *routev1.Route, required *routev1.Route) bool {
 		reflect.DeepEqual(found.Spec.To, required.Spec.To) &&
 		found.Spec.Host == required.Spec.Host
 }
+
+// **** Handler for Ingress ****
+
+// NewCliDownloadsIngressHandler returns a handler for the Ingress of the CLI downloads. The Ingress replaces the Route
+// on Kubernetes clusters that are not OpenShift, and it is only deployed if spec.deployment.standalone.cliDownloads is
+// set, with the Ingress exposure.
+func NewCliDownloadsIngressHandler(Client client.Client, Scheme *runtime.Scheme) *operands.ConditionalHandler {
+	return operands.NewConditionalHandler(
+		operands.NewGenericOperand(Client, Scheme, "Ingress", &cliDownloadsIngressHooks{}, true),
+		shouldDeployCliDownloadsIngress,
+		func(_ *hcov1.HyperConverged) client.Object {
+			return NewCliDownloadsIngressWithNameOnly()
+		},
+	)
+}
+
+func shouldDeployCliDownloadsIngress(hc *hcov1.HyperConverged) bool {
+	cfg := getCliDownloadsConfig(hc)
+	return cfg != nil && cfg.Exposure != hcov1.CLIDownloadsExposureNone
+}
+
+func getCliDownloadsConfig(hc *hcov1.HyperConverged) *hcov1.CLIDownloadsConfig {
+	if hc.Spec.Deployment.Standalone == nil {
+		return nil
+	}
+
+	return hc.Spec.Deployment.Standalone.CLIDownloads
+}
+
+type cliDownloadsIngressHooks struct{}
+
+func (cliDownloadsIngressHooks) GetFullCr(hc *hcov1.HyperConverged) (client.Object, error) {
+	return NewCliDownloadsIngress(hc), nil
+}
+
+func (cliDownloadsIngressHooks) GetEmptyCr() client.Object {
+	return &networkingv1.Ingress{}
+}
+
+func (cliDownloadsIngressHooks) UpdateCR(req *common.HcoRequest, Client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
+	ingress, ok1 := required.(*networkingv1.Ingress)
+	found, ok2 := exists.(*networkingv1.Ingress)
+	if !ok1 || !ok2 {
+		return false, false, errors.New("can't convert to Ingress")
+	}
+	if !hasIngressRightFields(found, ingress) {
+		if req.HCOTriggered {
+			req.Logger.Info("Updating existing Ingress Spec to new opinionated values")
+		} else {
+			req.Logger.Info("Reconciling an externally updated Ingress Spec to its opinionated values")
+		}
+		util.MergeLabels(&ingress.ObjectMeta, &found.ObjectMeta)
+		if ingress.Spec.IngressClassName == nil {
+			// keep the class that was set by the cluster, if any
+			ingress.Spec.IngressClassName = found.Spec.IngressClassName
+		}
+		ingress.Spec.DeepCopyInto(&found.Spec)
+		err := Client.Update(req.Ctx, found)
+		if err != nil {
+			return false, false, err
+		}
+		return true, !req.HCOTriggered, nil
+	}
+	return false, false, nil
+}
+
+func NewCliDownloadsIngressWithNameOnly() *networkingv1.Ingress {
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      downloadhost.CLIDownloadsServiceName,
+			Namespace: util.GetOperatorNamespaceFromEnv(),
+			Labels:    operands.GetLabels(util.AppComponentCompute),
+		},
+	}
+}
+
+func NewCliDownloadsIngress(hc *hcov1.HyperConverged) *networkingv1.Ingress {
+	host := string(downloadhost.Get().CurrentHost)
+
+	ingress := NewCliDownloadsIngressWithNameOnly()
+	ingress.Spec = networkingv1.IngressSpec{
+		Rules: []networkingv1.IngressRule{
+			{
+				Host: host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{
+							{
+								Path:     "/",
+								PathType: new(networkingv1.PathTypePrefix),
+								Backend: networkingv1.IngressBackend{
+									Service: &networkingv1.IngressServiceBackend{
+										Name: downloadhost.CLIDownloadsServiceName,
+										Port: networkingv1.ServiceBackendPort{
+											Number: util.CliDownloadsServerPort,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if cfg := getCliDownloadsConfig(hc); cfg != nil {
+		if cfg.IngressClassName != nil {
+			ingress.Spec.IngressClassName = new(*cfg.IngressClassName)
+		}
+
+		if cfg.TLSSecretName != "" {
+			ingress.Spec.TLS = []networkingv1.IngressTLS{
+				{
+					Hosts:      []string{host},
+					SecretName: cfg.TLSSecretName,
+				},
+			}
+		}
+	}
+
+	return ingress
+}
+
+// The default IngressClass of the cluster is set on the Ingress by an admission plugin, if HCO does not set the
+// class. We don't want to override it.
+func hasIngressRightFields(found *networkingv1.Ingress, required *networkingv1.Ingress) bool {
+	return util.CompareLabels(required, found) &&
+		(required.Spec.IngressClassName == nil || reflect.DeepEqual(found.Spec.IngressClassName, required.Spec.IngressClassName)) &&
+		reflect.DeepEqual(found.Spec.Rules, required.Spec.Rules) &&
+		reflect.DeepEqual(found.Spec.TLS, required.Spec.TLS) &&
+		reflect.DeepEqual(found.Spec.DefaultBackend, required.Spec.DefaultBackend)
+}
@@ -10,6 +10,8 @@ import (
 	consolev1 "github.com/openshift/api/console/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/reference"
@@ -365,3 +367,136 @@ var _ = Describe("Cli Downloads Route", func() {
 
 	})
 })
+
+var _ = Describe("Cli Downloads Ingress", func() {
+	Context("Cli Downloads Ingress", func() {
+
+		var hco *hcov1.HyperConverged
+		var req *common.HcoRequest
+
+		BeforeEach(func() {
+			hco = commontestutils.NewHco()
+			hco.Spec.Deployment.Standalone = &hcov1.StandaloneConfig{
+				CLIDownloads: &hcov1.CLIDownloadsConfig{
+					Host:     "virtctl.example.com",
+					Exposure: hcov1.CLIDownloadsExposureIngress,
+				},
+			}
+			req = commontestutils.NewReq(hco)
+
+			origHost := downloadhost.Get()
+			DeferCleanup(func() {
+				downloadhost.Set(origHost)
+			})
+			downloadhost.Set(downloadhost.CLIDownloadHost{
+				DefaultHost: "virtctl.example.com",
+				CurrentHost: "virtctl.example.com",
+			})
+		})
+
+		getIngress := func(cl client.Client) (*networkingv1.Ingress, error) {
+			ingress := &networkingv1.Ingress{}
+			err := cl.Get(context.TODO(), client.ObjectKeyFromObject(NewCliDownloadsIngressWithNameOnly()), ingress)
+			return ingress, err
+		}
+
+		It("should create if not present", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := NewCliDownloadsIngressHandler(cl, commontestutils.GetScheme())
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			foundResource, err := getIngress(cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundResource.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, commontestutils.Name))
+			Expect(foundResource.Spec.IngressClassName).To(BeNil())
+			Expect(foundResource.Spec.TLS).To(BeEmpty())
+			Expect(foundResource.Spec.Rules).To(HaveLen(1))
+			Expect(foundResource.Spec.Rules[0].Host).To(Equal("virtctl.example.com"))
+			Expect(foundResource.Spec.Rules[0].HTTP.Paths).To(HaveLen(1))
+			backend := foundResource.Spec.Rules[0].HTTP.Paths[0].Backend.Service
+			Expect(backend.Name).To(Equal(downloadhost.CLIDownloadsServiceName))
+			Expect(backend.Port.Number).To(Equal(hcoutil.CliDownloadsServerPort))
+		})
+
+		It("should use the ingress class and the TLS secret", func() {
+			hco.Spec.Deployment.Standalone.CLIDownloads.IngressClassName = new("nginx")
+			hco.Spec.Deployment.Standalone.CLIDownloads.TLSSecretName = "virtctl-tls"
+
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := NewCliDownloadsIngressHandler(cl, commontestutils.GetScheme())
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			foundResource, err := getIngress(cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundResource.Spec.IngressClassName).To(HaveValue(Equal("nginx")))
+			Expect(foundResource.Spec.TLS).To(Equal([]networkingv1.IngressTLS{
+				{
+					Hosts:      []string{"virtctl.example.com"},
+					SecretName: "virtctl-tls",
+				},
+			}))
+		})
+
+		It("should not override the default ingress class that was set by the cluster", func() {
+			existing := NewCliDownloadsIngress(hco)
+			existing.Spec.IngressClassName = new("default-class")
+
+			cl := commontestutils.InitClient([]client.Object{hco, existing})
+			handler := NewCliDownloadsIngressHandler(cl, commontestutils.GetScheme())
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+
+			foundResource, err := getIngress(cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundResource.Spec.IngressClassName).To(HaveValue(Equal("default-class")))
+		})
+
+		It("should reconcile the host, if modified", func() {
+			existing := NewCliDownloadsIngress(hco)
+			existing.Spec.Rules[0].Host = "wrong.example.com"
+			existing.Spec.IngressClassName = new("default-class")
+
+			cl := commontestutils.InitClient([]client.Object{hco, existing})
+			handler := NewCliDownloadsIngressHandler(cl, commontestutils.GetScheme())
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			foundResource, err := getIngress(cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundResource.Spec.Rules[0].Host).To(Equal("virtctl.example.com"))
+			Expect(foundResource.Spec.IngressClassName).To(HaveValue(Equal("default-class")))
+		})
+
+		DescribeTable("should remove the Ingress", func(modify func()) {
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := NewCliDownloadsIngressHandler(cl, commontestutils.GetScheme())
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			modify()
+
+			res = handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Deleted).To(BeTrue())
+
+			_, err := getIngress(cl)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		},
+			Entry("if the exposure is None", func() {
+				hco.Spec.Deployment.Standalone.CLIDownloads.Exposure = hcov1.CLIDownloadsExposureNone
+			}),
+			Entry("if the cliDownloads field is not set", func() {
+				hco.Spec.Deployment.Standalone.CLIDownloads = nil
+			}),
+			Entry("if the standalone field is not set", func() {
+				hco.Spec.Deployment.Standalone = nil
+			}),
+		)
+	})
+})
@@ -57,6 +57,14 @@ var _ = Describe("CSV Operand", func() {
 		})
 	})
 
+	Context("UninstallStrategy is BlockUninstallAndReportWorkloads", func() {
+		It("should set console.openshift.io/disable-operand-delete to true", func() {
+			hco.Spec.Deployment.UninstallStrategy = hcov1.HyperConvergedUninstallStrategyBlockUninstallAndReportWorkloads
+			foundResource := ensure(req, hco)
+			Expect(foundResource.Annotations).To(HaveKeyWithValue(hcoutil.DisableOperandDeletionAnnotation, "true"))
+		})
+	})
+
 	Context("UninstallStrategy is RemoveWorkloads", func() {
 		It("should set console.openshift.io/disable-operand-delete to false", func() {
 			hco.Spec.Deployment.UninstallStrategy = hcov1.HyperConvergedUninstallStrategyRemoveWorkloads
@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// DefaultGoldenImagesNamespace is the namespace of the golden images that HCO deploys directly, if
+// spec.workloadSources.commonBootImageNamespace is not set. This is the SSP default namespace on Kubernetes.
+const DefaultGoldenImagesNamespace = "kubevirt-os-images"
+
+// **** Handler for the golden images DataImportCrons ****
+
+// NewGoldenImagesHandler returns a handler for the DataImportCrons of the golden images, on Kubernetes clusters that
+// are not OpenShift, where SSP is not deployed. The DataImportCrons are only deployed if
+// spec.deployment.standalone.deployGoldenImages is true; otherwise, the ones that were deployed by HCO are removed.
+func NewGoldenImagesHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
+	return &goldenImagesHandler{
+		client: Client,
+		scheme: Scheme,
+	}
+}
+
+type goldenImagesHandler struct {
+	client client.Client
+	scheme *runtime.Scheme
+}
+
+func (h *goldenImagesHandler) Ensure(req *common.HcoRequest) *operands.EnsureResult {
+	res := operands.NewEnsureResult(&cdiv1beta1.DataImportCron{})
+
+	if !shouldDeployGoldenImages(req.Instance) {
+		deleted, err := h.removeDataImportCrons(req.Ctx, req, nil)
+		if err != nil {
+			return res.Error(err)
+		}
+
+		if deleted {
+			res.SetDeleted()
+		}
+
+		return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+	}
+
+	dicts, dictStatuses, err := NewGoldenImagesDataImportCrons(req.Instance)
+	if err != nil {
+		return res.Error(err)
+	}
+
+	required := make(map[client.ObjectKey]bool, len(dicts))
+	for _, dic := range dicts {
+		required[client.ObjectKeyFromObject(dic)] = true
+
+		created, updated, err := h.ensureDataImportCron(req, dic)
+		if err != nil {
+			return res.Error(err)
+		}
+
+		if created {
+			res.SetCreated().SetName(dic.Name)
+		} else if updated && !res.Created {
+			res.SetUpdated().SetName(dic.Name)
+		}
+	}
+
+	deleted, err := h.removeDataImportCrons(req.Ctx, req, required)
+	if err != nil {
+		return res.Error(err)
+	}
+
+	if deleted && !res.Created && !res.Updated {
+		res.SetDeleted()
+	}
+
+	updateDICTsInHCStatus(req, dictStatuses)
+
+	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+}
+
+func (*goldenImagesHandler) Reset() { /* no cache */ }
+
+// EnsureDeleted removes the golden images DataImportCrons on uninstall. They can't be owned by the HyperConverged CR,
+// because they are in another namespace.
+func (h *goldenImagesHandler) EnsureDeleted(ctx context.Context, req *common.HcoRequest) error {
+	_, err := h.removeDataImportCrons(ctx, req, nil)
+	return err
+}
+
+func (h *goldenImagesHandler) ensureDataImportCron(req *common.HcoRequest, dic *cdiv1beta1.DataImportCron) (bool, bool, error) {
+	found := &cdiv1beta1.DataImportCron{}
+	err := h.client.Get(req.Ctx, client.ObjectKeyFromObject(dic), found)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, false, err
+		}
+
+		req.Logger.Info("Creating DataImportCron", "name", dic.Name, "namespace", dic.Namespace)
+		if err = h.client.Create(req.Ctx, dic); err != nil {
+			return false, false, err
+		}
+
+		return true, false, nil
+	}
+
+	keepDefaultedFields(dic, found)
+
+	if reflect.DeepEqual(dic.Spec, found.Spec) &&
+		util.CompareLabels(dic, found) &&
+		hasAnnotations(found, dic.Annotations) {
+		return false, false, nil
+	}
+
+	req.Logger.Info("Updating existing DataImportCron's Spec to new opinionated values", "name", dic.Name, "namespace", dic.Namespace)
+	util.MergeLabels(&dic.ObjectMeta, &found.ObjectMeta)
+	if found.Annotations == nil {
+		found.Annotations = make(map[string]string)
+	}
+	maps.Copy(found.Annotations, dic.Annotations)
+	dic.Spec.DeepCopyInto(&found.Spec)
+
+	if err = h.client.Update(req.Ctx, found); err != nil {
+		return false, false, err
+	}
+
+	return false, true, nil
+}
+
+// removeDataImportCrons removes the DataImportCrons that were deployed by HCO, and are not in the required set
+func (h *goldenImagesHandler) removeDataImportCrons(ctx context.Context, req *common.HcoRequest, required map[client.ObjectKey]bool) (bool, error) {
+	dicList := &cdiv1beta1.DataImportCronList{}
+	if err := h.client.List(ctx, dicList, client.MatchingLabels{
+		util.AppLabel:          util.HyperConvergedName,
+		util.AppLabelComponent: string(util.AppComponentStorage),
+	}); err != nil {
+		return false, err
+	}
+
+	deleted := false
+	var errs []error
+	for i := range dicList.Items {
+		dic := &dicList.Items[i]
+		if required[client.ObjectKeyFromObject(dic)] {
+			continue
+		}
+
+		req.Logger.Info("Removing DataImportCron", "name", dic.Name, "namespace", dic.Namespace)
+		if err := h.client.Delete(ctx, dic); err != nil {
+			if !apierrors.IsNotFound(err) {
+				errs = append(errs, err)
+			}
+			continue
+		}
+
+		deleted = true
+	}
+
+	return deleted, errors.Join(errs...)
+}
+
+func shouldDeployGoldenImages(hc *hcov1.HyperConverged) bool {
+	return hc.Spec.Deployment.Standalone != nil && ptr.Deref(hc.Spec.Deployment.Standalone.DeployGoldenImages, false)
+}
+
+// NewGoldenImagesDataImportCrons returns the DataImportCrons of the golden images; the same ones that SSP would deploy
+// from the DataImportCronTemplates.
+func NewGoldenImagesDataImportCrons(hc *hcov1.HyperConverged) ([]*cdiv1beta1.DataImportCron, []hcov1.DataImportCronTemplateStatus, error) {
+	goldenimages.ApplyDataImportSchedule(hc)
+
+	dictStatuses, err := goldenimages.GetDataImportCronTemplates(hc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defaultNamespace := ptr.Deref(hc.Spec.WorkloadSources.CommonBootImageNamespace, "")
+	if defaultNamespace == "" {
+		defaultNamespace = DefaultGoldenImagesNamespace
+	}
+
+	sspDicts := goldenimages.HCODictSliceToSSP(hc, dictStatuses)
+	dicts := make([]*cdiv1beta1.DataImportCron, 0, len(sspDicts))
+	for _, dict := range sspDicts {
+		namespace := dict.Namespace
+		if namespace == "" {
+			namespace = defaultNamespace
+		}
+
+		labels := maps.Clone(dict.Labels)
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		maps.Copy(labels, operands.GetLabels(util.AppComponentStorage))
+
+		dicts = append(dicts, &cdiv1beta1.DataImportCron{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        dict.Name,
+				Namespace:   namespace,
+				Labels:      labels,
+				Annotations: maps.Clone(dict.Annotations),
+			},
+			Spec: *dict.Spec.DeepCopy(),
+		})
+	}
+
+	return dicts, dictStatuses, nil
+}
+
+// keepDefaultedFields copies the optional fields that are defaulted by CDI, if they are not set in the required
+// DataImportCron, to avoid endless updates.
+func keepDefaultedFields(required, found *cdiv1beta1.DataImportCron) {
+	if required.Spec.GarbageCollect == nil {
+		required.Spec.GarbageCollect = found.Spec.GarbageCollect
+	}
+
+	if required.Spec.ImportsToKeep == nil {
+		required.Spec.ImportsToKeep = found.Spec.ImportsToKeep
+	}
+
+	if required.Spec.RetentionPolicy == nil {
+		required.Spec.RetentionPolicy = found.Spec.RetentionPolicy
+	}
+}
+
+func hasAnnotations(obj metav1.Object, annotations map[string]string) bool {
+	found := obj.GetAnnotations()
+	for key, value := range annotations {
+		if foundValue, ok := found[key]; !ok || foundValue != value {
+			return false
+		}
+	}
+
+	return true
+}
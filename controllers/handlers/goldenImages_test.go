@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Golden images DataImportCrons", func() {
+	var (
+		hco   *hcov1.HyperConverged
+		req   *common.HcoRequest
+		dicts []hcov1.DataImportCronTemplateStatus
+	)
+
+	listDataImportCrons := func(cl client.Client) []cdiv1beta1.DataImportCron {
+		dicList := &cdiv1beta1.DataImportCronList{}
+		Expect(cl.List(context.Background(), dicList)).To(Succeed())
+		return dicList.Items
+	}
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		hco.Spec.Deployment.Standalone = &hcov1.StandaloneConfig{
+			DeployGoldenImages: new(true),
+		}
+		req = commontestutils.NewReq(hco)
+
+		customDICT := makeDICT(2)
+		customDICT.Namespace = "custom-namespace"
+		customDICT.Status.CommonTemplate = false
+		dicts = []hcov1.DataImportCronTemplateStatus{makeDICT(1), customDICT}
+
+		origFunc := goldenimages.GetDataImportCronTemplates
+		goldenimages.GetDataImportCronTemplates = func(_ *hcov1.HyperConverged) ([]hcov1.DataImportCronTemplateStatus, error) {
+			return dicts, nil
+		}
+		DeferCleanup(func() {
+			goldenimages.GetDataImportCronTemplates = origFunc
+		})
+	})
+
+	It("should create the DataImportCrons, and update the HyperConverged status", func() {
+		cl := commontestutils.InitClient([]client.Object{hco})
+		handler := NewGoldenImagesHandler(cl, commontestutils.GetScheme())
+
+		res := handler.Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Created).To(BeTrue())
+
+		found := listDataImportCrons(cl)
+		Expect(found).To(HaveLen(2))
+		for _, dic := range found {
+			Expect(dic.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hcoutil.HyperConvergedName))
+			Expect(dic.Labels).To(HaveKeyWithValue(hcoutil.AppLabelComponent, string(hcoutil.AppComponentStorage)))
+			Expect(dic.Annotations).To(HaveKeyWithValue(goldenimages.CDIImmediateBindAnnotation, "true"))
+			Expect(dic.Spec.ManagedDataSource).To(Equal(dic.Name))
+		}
+
+		Expect(found).To(ContainElement(HaveField("ObjectMeta", And(
+			HaveField("Name", "image1"),
+			HaveField("Namespace", DefaultGoldenImagesNamespace),
+		))))
+		Expect(found).To(ContainElement(HaveField("ObjectMeta", And(
+			HaveField("Name", "image2"),
+			HaveField("Namespace", "custom-namespace"),
+		))))
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.DataImportCronTemplates).To(Equal(dicts))
+	})
+
+	It("should use the common boot image namespace", func() {
+		hco.Spec.WorkloadSources.CommonBootImageNamespace = new("golden-images")
+
+		required, _, err := NewGoldenImagesDataImportCrons(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(required).To(HaveLen(2))
+		Expect(required[0].Namespace).To(Equal("golden-images"))
+		Expect(required[1].Namespace).To(Equal("custom-namespace"))
+	})
+
+	It("should reconcile a modified DataImportCron, and keep the fields that were defaulted by CDI", func() {
+		required, _, err := NewGoldenImagesDataImportCrons(hco)
+		Expect(err).ToNot(HaveOccurred())
+
+		existing := required[0]
+		existing.Spec.Schedule = "wrong"
+		existing.Spec.ImportsToKeep = new(int32(3))
+
+		cl := commontestutils.InitClient([]client.Object{hco, existing, required[1]})
+		handler := NewGoldenImagesHandler(cl, commontestutils.GetScheme())
+
+		res := handler.Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Updated).To(BeTrue())
+
+		found := &cdiv1beta1.DataImportCron{}
+		Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(existing), found)).To(Succeed())
+		Expect(found.Spec.Schedule).To(Equal(dicts[0].Spec.Schedule))
+		Expect(found.Spec.ImportsToKeep).To(HaveValue(Equal(int32(3))))
+
+		res = handler.Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Updated).To(BeFalse())
+	})
+
+	It("should remove the DataImportCrons that are not required anymore, but not the ones that were not deployed by HCO", func() {
+		cl := commontestutils.InitClient([]client.Object{hco})
+		handler := NewGoldenImagesHandler(cl, commontestutils.GetScheme())
+
+		res := handler.Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+
+		userDIC := &cdiv1beta1.DataImportCron{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "user-dic",
+				Namespace: DefaultGoldenImagesNamespace,
+			},
+		}
+		Expect(cl.Create(context.Background(), userDIC)).To(Succeed())
+
+		dicts = dicts[:1]
+
+		res = handler.Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Deleted).To(BeTrue())
+
+		found := listDataImportCrons(cl)
+		Expect(found).To(HaveLen(2))
+		Expect(found).To(ContainElement(HaveField("ObjectMeta.Name", "image1")))
+		Expect(found).To(ContainElement(HaveField("ObjectMeta.Name", "user-dic")))
+	})
+
+	It("should remove all the DataImportCrons if the golden images are not deployed", func() {
+		cl := commontestutils.InitClient([]client.Object{hco})
+		handler := NewGoldenImagesHandler(cl, commontestutils.GetScheme())
+
+		res := handler.Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(listDataImportCrons(cl)).To(HaveLen(2))
+
+		hco.Spec.Deployment.Standalone.DeployGoldenImages = new(false)
+
+		res = handler.Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Deleted).To(BeTrue())
+		Expect(listDataImportCrons(cl)).To(BeEmpty())
+	})
+
+	It("should remove all the DataImportCrons on uninstall", func() {
+		cl := commontestutils.InitClient([]client.Object{hco})
+		handler := NewGoldenImagesHandler(cl, commontestutils.GetScheme())
+
+		res := handler.Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(listDataImportCrons(cl)).To(HaveLen(2))
+
+		deleter, ok := handler.(operands.MultiObjectDeleter)
+		Expect(ok).To(BeTrue())
+		Expect(deleter.EnsureDeleted(context.Background(), req)).To(Succeed())
+		Expect(listDataImportCrons(cl)).To(BeEmpty())
+	})
+})
@@ -10,6 +10,7 @@ import (
 	log "github.com/go-logr/logr"
 	imagev1 "github.com/openshift/api/image/v1"
 	objectreferencesv1 "github.com/openshift/custom-resource-status/objectreferences/v1"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -67,10 +68,9 @@ func (iso imageStreamOperand) Ensure(req *common.HcoRequest) *operands.EnsureRes
 			return res.Error(err)
 		}
 
-		if err = objectreferencesv1.RemoveObjectReference(&req.Instance.Status.RelatedObjects, *objectRef); err != nil {
+		if err = removeImageStreamRelatedObject(req, objectRef); err != nil {
 			return res.Error(err)
 		}
-		req.StatusDirty = true
 	}
 
 	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
@@ -104,9 +104,20 @@ func (iso imageStreamOperand) deleteImageStream(req *common.HcoRequest) *operand
 		return operands.NewEnsureResult(req.Instance).Error(err)
 	}
 
-	if err = objectreferencesv1.RemoveObjectReference(&req.Instance.Status.RelatedObjects, *objectRef); err != nil {
+	if err = removeImageStreamRelatedObject(req, objectRef); err != nil {
 		return operands.NewEnsureResult(req.Instance).Error(err)
 	}
+
+	return nil
+}
+
+func removeImageStreamRelatedObject(req *common.HcoRequest, objectRef *corev1.ObjectReference) error {
+	req.Lock()
+	defer req.Unlock()
+
+	if err := objectreferencesv1.RemoveObjectReference(&req.Instance.Status.RelatedObjects, *objectRef); err != nil {
+		return err
+	}
 	req.StatusDirty = true
 
 	return nil
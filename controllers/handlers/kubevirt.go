@@ -237,6 +237,9 @@ func (*kubevirtHooks) CheckComponentVersion(cr runtime.Object) bool {
 	found := cr.(*kubevirtcorev1.KubeVirt)
 	return operands.CheckComponentVersion(hcoutil.KubevirtVersionEnvV, found.Status.ObservedKubeVirtVersion)
 }
+func (*kubevirtHooks) GetObservedVersion(cr runtime.Object) string {
+	return cr.(*kubevirtcorev1.KubeVirt).Status.ObservedKubeVirtVersion
+}
 func (h *kubevirtHooks) Reset() {
 	h.Lock()
 	defer h.Unlock()
@@ -287,11 +290,7 @@ func NewKubeVirt(hc *hcov1.HyperConverged, opts ...string) (*kubevirtcorev1.Kube
 		uninstallStrategy = kubevirtcorev1.KubeVirtUninstallStrategyRemoveWorkloads
 	}
 
-	var infra, workload *api.NodePlacement
-	if np := hc.Spec.Deployment.NodePlacements; np != nil {
-		infra = np.Infra
-		workload = np.Workload
-	}
+	infra, workload := operands.GetNodePlacements(hc, hcov1.NodePlacementComponentKubeVirtInfra, hcov1.NodePlacementComponentKubeVirtWorkload)
 	kvInfra := hcoConfig2KvConfig(infra, infraHighlyAvailable, controlPlaneMultiNode, controlPlaneNodeExists)
 	kvWorkloads := hcoConfig2KvConfig(workload, true, true, true)
 
@@ -316,6 +315,10 @@ func NewKubeVirt(hc *hcov1.HyperConverged, opts ...string) (*kubevirtcorev1.Kube
 		return nil, err
 	}
 
+	if err = operands.ApplyOverrides(hc, hcov1.OperandOverrideTargetKubeVirt, kv); err != nil {
+		return nil, err
+	}
+
 	return reformatobj.ReformatObj(kv)
 }
 
@@ -369,14 +372,174 @@ func getHcoAnnotationTuning(hc *hcov1.HyperConverged) (*kubevirtcorev1.Reloadabl
 	}, nil
 }
 
-func hcoTuning2Kv(hc *hcov1.HyperConverged) (*kubevirtcorev1.ReloadableComponentConfiguration, error) {
+// tuningProfiles are the rate limits of the predefined tuning policies
+var tuningProfiles = map[hcov1.HyperConvergedTuningPolicy]hcov1.TuningConfig{
+	hcov1.HyperConvergedSmallTuningPolicy: {
+		API:        &hcov1.RateLimit{QPS: 100, Burst: 200},
+		Controller: &hcov1.RateLimit{QPS: 100, Burst: 200},
+		Handler:    &hcov1.RateLimit{QPS: 5, Burst: 10},
+		Webhook:    &hcov1.RateLimit{QPS: 100, Burst: 200},
+	},
+	hcov1.HyperConvergedMediumTuningPolicy: {
+		API:        &hcov1.RateLimit{QPS: 200, Burst: 400},
+		Controller: &hcov1.RateLimit{QPS: 200, Burst: 400},
+		Handler:    &hcov1.RateLimit{QPS: 25, Burst: 50},
+		Webhook:    &hcov1.RateLimit{QPS: 200, Burst: 400},
+	},
+	hcov1.HyperConvergedLargeTuningPolicy: {
+		API:        &hcov1.RateLimit{QPS: 400, Burst: 800},
+		Controller: &hcov1.RateLimit{QPS: 400, Burst: 800},
+		Handler:    &hcov1.RateLimit{QPS: 50, Burst: 100},
+		Webhook:    &hcov1.RateLimit{QPS: 400, Burst: 800},
+	},
+}
+
+// kvTuning is the rate limiter configuration of each one of the KubeVirt components
+type kvTuning struct {
+	api        *kubevirtcorev1.ReloadableComponentConfiguration
+	controller *kubevirtcorev1.ReloadableComponentConfiguration
+	handler    *kubevirtcorev1.ReloadableComponentConfiguration
+	webhook    *kubevirtcorev1.ReloadableComponentConfiguration
+}
+
+func hcoTuning2Kv(hc *hcov1.HyperConverged) (*kvTuning, error) {
 	if hc.Spec.Virtualization.TuningPolicy == hcov1.HyperConvergedAnnotationTuningPolicy {
-		return getHcoAnnotationTuning(hc)
+		rateLimiter, err := getHcoAnnotationTuning(hc)
+		if err != nil {
+			return nil, err
+		}
+
+		return &kvTuning{
+			api:        rateLimiter,
+			controller: rateLimiter,
+			handler:    rateLimiter,
+			webhook:    rateLimiter,
+		}, nil
+	}
+
+	tuning, err := getTuningConfig(hc)
+	if err != nil || tuning == nil {
+		return &kvTuning{}, err
+	}
+
+	return &kvTuning{
+		api:        rateLimit2Kv(tuning.API),
+		controller: rateLimit2Kv(tuning.Controller),
+		handler:    rateLimit2Kv(tuning.Handler),
+		webhook:    rateLimit2Kv(tuning.Webhook),
+	}, nil
+}
+
+// getTuningConfig returns the rate limits of the typed tuning policies; i.e. the predefined profiles, and the custom
+// and auto policies. It returns nil for the annotation policy, if the tuning policy is not set, or if the auto policy
+// is set, but HCO did not recommend the rate limits yet.
+func getTuningConfig(hc *hcov1.HyperConverged) (*hcov1.TuningConfig, error) {
+	policy := hc.Spec.Virtualization.TuningPolicy
+	switch policy {
+	case hcov1.HyperConvergedCustomTuningPolicy:
+		if hc.Spec.Virtualization.Tuning == nil {
+			return nil, fmt.Errorf("the %s tuning policy is set, but spec.virtualization.tuning is not set", policy)
+		}
+		return hc.Spec.Virtualization.Tuning.DeepCopy(), nil
+
+	case hcov1.HyperConvergedAutoTuningPolicy:
+		if hc.Status.TuningRecommendation == nil {
+			return nil, nil
+		}
+		return hc.Status.TuningRecommendation.RateLimits.DeepCopy(), nil
+	}
+
+	if profile, ok := tuningProfiles[policy]; ok {
+		return profile.DeepCopy(), nil
 	}
 
 	return nil, nil
 }
 
+func rateLimit2Kv(rateLimit *hcov1.RateLimit) *kubevirtcorev1.ReloadableComponentConfiguration {
+	if rateLimit == nil {
+		return nil
+	}
+
+	return &kubevirtcorev1.ReloadableComponentConfiguration{
+		RestClient: &kubevirtcorev1.RESTClientConfiguration{
+			RateLimiter: &kubevirtcorev1.RateLimiter{
+				TokenBucketRateLimiter: &kubevirtcorev1.TokenBucketRateLimiter{
+					QPS:   float32(rateLimit.QPS),
+					Burst: int(rateLimit.Burst),
+				},
+			},
+		},
+	}
+}
+
+// GetTuningProfile returns the rate limits of a predefined tuning profile
+func GetTuningProfile(policy hcov1.HyperConvergedTuningPolicy) (hcov1.TuningConfig, bool) {
+	profile, ok := tuningProfiles[policy]
+	if !ok {
+		return hcov1.TuningConfig{}, false
+	}
+	return *profile.DeepCopy(), true
+}
+
+// GetEffectiveTuning returns the rate limits that HCO sets for the KubeVirt components, or nil if no tuning policy
+// is used
+func GetEffectiveTuning(hc *hcov1.HyperConverged) (*hcov1.EffectiveTuning, error) {
+	policy := hc.Spec.Virtualization.TuningPolicy
+
+	if policy == hcov1.HyperConvergedAnnotationTuningPolicy {
+		rateLimiter, err := getHcoAnnotationTuning(hc)
+		if err != nil {
+			return nil, err
+		}
+
+		// the annotation QPS may be fractional; it is reported as is
+		bucket := rateLimiter.RestClient.RateLimiter.TokenBucketRateLimiter
+		qps, err := resource.ParseQuantity(strconv.FormatFloat(float64(bucket.QPS), 'f', -1, 32))
+		if err != nil {
+			return nil, err
+		}
+		rateLimit := hcov1.EffectiveRateLimit{QPS: qps, Burst: int32(bucket.Burst)}
+
+		return &hcov1.EffectiveTuning{
+			Policy:     policy,
+			API:        new(rateLimit),
+			Controller: new(rateLimit),
+			Handler:    new(rateLimit),
+			Webhook:    new(rateLimit),
+		}, nil
+	}
+
+	tuning, err := getTuningConfig(hc)
+	if err != nil || tuning == nil {
+		return nil, err
+	}
+
+	return ToEffectiveTuning(policy, *tuning), nil
+}
+
+// ToEffectiveTuning converts a tuning config to the effective tuning, as reported in the HyperConverged status
+func ToEffectiveTuning(policy hcov1.HyperConvergedTuningPolicy, tuning hcov1.TuningConfig) *hcov1.EffectiveTuning {
+	return &hcov1.EffectiveTuning{
+		Policy:     policy,
+		API:        toEffectiveRateLimit(tuning.API),
+		Controller: toEffectiveRateLimit(tuning.Controller),
+		Handler:    toEffectiveRateLimit(tuning.Handler),
+		Webhook:    toEffectiveRateLimit(tuning.Webhook),
+	}
+}
+
+func toEffectiveRateLimit(rateLimit *hcov1.RateLimit) *hcov1.EffectiveRateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+
+	return &hcov1.EffectiveRateLimit{
+		QPS:   *resource.NewQuantity(int64(rateLimit.QPS), resource.DecimalSI),
+		Burst: rateLimit.Burst,
+	}
+}
+
 func hcWorkloadUpdateStrategyToKv(hcObject *hcov1.HyperConvergedWorkloadUpdateStrategy) kubevirtcorev1.KubeVirtWorkloadUpdateStrategy {
 	kvObject := kubevirtcorev1.KubeVirtWorkloadUpdateStrategy{}
 	if hcObject != nil {
@@ -411,7 +574,7 @@ func getKVConfig(hc *hcov1.HyperConverged) (*kubevirtcorev1.KubeVirtConfiguratio
 
 	obsoleteCPUs := getObsoleteCPUConfig(hc.Spec.Virtualization.ObsoleteCPUModels)
 
-	rateLimiter, err := hcoTuning2Kv(hc)
+	tuning, err := hcoTuning2Kv(hc)
 	if err != nil {
 		return nil, err
 	}
@@ -432,10 +595,10 @@ func getKVConfig(hc *hcov1.HyperConverged) (*kubevirtcorev1.KubeVirtConfiguratio
 		PersistentReservationConfiguration: toKvPersistentReservationConfiguration(hc),
 		ObsoleteCPUModels:                  obsoleteCPUs,
 		TLSConfiguration:                   hcTLSSecurityProfileToKv(tlssecprofile.GetTLSSecurityProfile(hc.Spec.Security.TLSSecurityProfile)),
-		APIConfiguration:                   rateLimiter,
-		WebhookConfiguration:               rateLimiter,
-		ControllerConfiguration:            rateLimiter,
-		HandlerConfiguration:               rateLimiter,
+		APIConfiguration:                   tuning.api,
+		WebhookConfiguration:               tuning.webhook,
+		ControllerConfiguration:            tuning.controller,
+		HandlerConfiguration:               tuning.handler,
 		SeccompConfiguration:               seccompConfig,
 		EvictionStrategy:                   hc.Spec.Virtualization.EvictionStrategy,
 		KSMConfiguration:                   hc.Spec.Virtualization.KSMConfiguration,
@@ -602,6 +765,12 @@ func getNetworkBindings(networkingCfg *hcov1.NetworkingConfig) map[string]kubevi
 	return networkBindings
 }
 
+// IsObsoleteCPUModel checks if a CPU model is obsolete, either by default, or by the HyperConverged configuration
+func IsObsoleteCPUModel(hc *hcov1.HyperConverged, cpuModel string) bool {
+	return slices.Contains(hardcodedObsoleteCPUModels, cpuModel) ||
+		slices.Contains(hc.Spec.Virtualization.ObsoleteCPUModels, cpuModel)
+}
+
 func getObsoleteCPUConfig(hcObsoleteCPUModels []string) map[string]bool {
 	obsoleteCPUModels := make(map[string]bool)
 	for _, cpu := range hardcodedObsoleteCPUModels {
@@ -931,31 +1100,50 @@ func hcoConfig2KvConfig(
 	return kvConfig
 }
 
-func getFeatureGateChecks(hc *hcov1.HyperConverged) []string {
-	featureGates := &hc.Spec.FeatureGates
-	fgs := make([]string, 0, 2)
+// kvFeatureGateMapping maps an HCO feature gate to the KubeVirt feature gates that HCO enables in the KubeVirt CR,
+// according to the state of the HCO feature gate
+type kvFeatureGateMapping struct {
+	hcoFeatureGate string
+	whenEnabled    []string
+	whenDisabled   []string
+}
 
-	if featureGates.IsEnabled("downwardMetrics") {
-		fgs = append(fgs, kvDownwardMetrics)
-	}
-	if featureGates.IsEnabled("alignCPUs") {
-		fgs = append(fgs, kvAlignCPUs)
-	}
+// kvFeatureGatesOfHcoFeatureGates is the single source of both the KubeVirt feature gates that HCO enables according
+// to the HCO feature gates, and of the mapping that is reported in the feature gates status. The KubeVirt feature
+// gates are added to the KubeVirt CR in the list order.
+var kvFeatureGatesOfHcoFeatureGates = []kvFeatureGateMapping{
+	{hcoFeatureGate: "downwardMetrics", whenEnabled: []string{kvDownwardMetrics}},
+	{hcoFeatureGate: "alignCPUs", whenEnabled: []string{kvAlignCPUs}},
+	{hcoFeatureGate: "objectGraph", whenEnabled: []string{kvObjectGraph}},
+	{hcoFeatureGate: "decentralizedLiveMigration", whenEnabled: []string{kvDecentralizedLiveMigration}},
+	// the original HotplugVolumes feature gate is the fallback, when the declarative volume hotplug is disabled
+	{hcoFeatureGate: "declarativeHotplugVolumes", whenEnabled: []string{kvDeclarativeHotplugVolumesGate}, whenDisabled: []string{kvHotplugVolumesGate}},
+	{hcoFeatureGate: "incrementalBackup", whenEnabled: []string{kvIncrementalBackup, kvUtilityVolumes}},
+	{hcoFeatureGate: "containerPathVolumes", whenEnabled: []string{kvContainerPathVolumes}},
+	{hcoFeatureGate: "template", whenEnabled: []string{kvTemplateFG}},
+}
 
-	if featureGates.IsEnabled("objectGraph") {
-		fgs = append(fgs, kvObjectGraph)
+// GetKvFeatureGatesOf returns the KubeVirt feature gates that HCO enables when the HCO feature gate is enabled, and
+// the ones it enables when the HCO feature gate is disabled
+func GetKvFeatureGatesOf(fgName string) (whenEnabled, whenDisabled []string) {
+	for _, mapping := range kvFeatureGatesOfHcoFeatureGates {
+		if mapping.hcoFeatureGate == fgName {
+			return slices.Clone(mapping.whenEnabled), slices.Clone(mapping.whenDisabled)
+		}
 	}
 
-	if featureGates.IsEnabled("decentralizedLiveMigration") {
-		fgs = append(fgs, kvDecentralizedLiveMigration)
-	}
+	return nil, nil
+}
 
-	// Add the appropriate volume hotplug featuregate based on DeclarativeHotplugVolumes setting
-	if featureGates.IsEnabled("declarativeHotplugVolumes") { // the default behavior
-		fgs = append(fgs, kvDeclarativeHotplugVolumesGate)
-	} else {
-		// Fallback behavior: use the original HotplugVolumes featuregate
-		fgs = append(fgs, kvHotplugVolumesGate)
+func getFeatureGateChecks(hc *hcov1.HyperConverged) []string {
+	fgs := make([]string, 0, 2)
+
+	for _, mapping := range kvFeatureGatesOfHcoFeatureGates {
+		if hc.Spec.FeatureGates.IsEnabled(mapping.hcoFeatureGate) {
+			fgs = append(fgs, mapping.whenEnabled...)
+		} else {
+			fgs = append(fgs, mapping.whenDisabled...)
+		}
 	}
 
 	if hc.Annotations[deployPasstNetworkBindingAnn] == "true" {
@@ -970,11 +1158,6 @@ func getFeatureGateChecks(hc *hcov1.HyperConverged) []string {
 		fgs = append(fgs, kvSecureExecution)
 	}
 
-	if featureGates.IsEnabled("incrementalBackup") {
-		fgs = append(fgs, kvIncrementalBackup)
-		fgs = append(fgs, kvUtilityVolumes)
-	}
-
 	if len(hc.Spec.Virtualization.Hypervisors) > 0 {
 		fgs = append(fgs, kvConfigurableHypervisor)
 	}
@@ -983,14 +1166,6 @@ func getFeatureGateChecks(hc *hcov1.HyperConverged) []string {
 		fgs = append(fgs, kvOptOutRoleAggregation)
 	}
 
-	if featureGates.IsEnabled("containerPathVolumes") {
-		fgs = append(fgs, kvContainerPathVolumes)
-	}
-
-	if featureGates.IsEnabled(kvTemplateFG) {
-		fgs = append(fgs, kvTemplateFG)
-	}
-
 	if common.ShouldDeployNetworkResourcesInjector(hc) &&
 		meta.IsStatusConditionTrue(hc.Status.Conditions, hcov1.ConditionNetworkResourcesInjectorReady) {
 		fgs = append(fgs, kvExternalNetResourceInjection)
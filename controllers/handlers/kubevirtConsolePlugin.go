@@ -84,6 +84,16 @@ func NewKvUIProxyDeploymentHandler(cli client.Client, Scheme *runtime.Scheme) op
 	return operands.NewDeploymentHandler(cli, Scheme, NewKvUIProxyDeployment)
 }
 
+// **** Kubevirt UI Plugin PodDisruptionBudget Handler ****
+func NewKvUIPluginPDBHandler(cli client.Client, Scheme *runtime.Scheme) operands.Operand {
+	return operands.NewDeploymentPDBHandler(cli, Scheme, NewKvUIPluginDeployment, nil)
+}
+
+// **** Kubevirt UI apiserver proxy PodDisruptionBudget Handler ****
+func NewKvUIProxyPDBHandler(cli client.Client, Scheme *runtime.Scheme) operands.Operand {
+	return operands.NewDeploymentPDBHandler(cli, Scheme, NewKvUIProxyDeployment, nil)
+}
+
 // **** Kubevirt UI Plugin ServiceAccount Handler ****
 func NewKvUIPluginSAHandler(cli client.Client, Scheme *runtime.Scheme) operands.Operand {
 	return operands.NewServiceAccountHandler(cli, Scheme, NewKvUIPluginSA)
@@ -170,6 +180,8 @@ func NewKvUIPluginDeployment(hc *hcov1.HyperConverged) *appsv1.Deployment {
 
 	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, nginxVolume)
 
+	operands.ApplyDeploymentConfig(deployment, operands.GetComponentsConfig(hc).ConsolePlugin)
+
 	return deployment
 }
 
@@ -198,6 +210,8 @@ func NewKvUIProxyDeployment(hc *hcov1.HyperConverged) *appsv1.Deployment {
 
 	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, args...)
 
+	operands.ApplyDeploymentConfig(deployment, operands.GetComponentsConfig(hc).ConsoleProxy)
+
 	return deployment
 }
 
@@ -282,25 +296,28 @@ func getKvUIDeployment(hc *hcov1.HyperConverged, deploymentName string, image st
 		},
 	}
 
-	if np := hc.Spec.Deployment.NodePlacements; np != nil && np.Infra != nil {
-		if np.Infra.NodeSelector != nil {
-			deployment.Spec.Template.Spec.NodeSelector = maps.Clone(np.Infra.NodeSelector)
+	if infra := operands.GetInfraNodePlacement(hc, hcov1.NodePlacementComponentConsolePlugin); infra != nil {
+		if infra.NodeSelector != nil {
+			deployment.Spec.Template.Spec.NodeSelector = maps.Clone(infra.NodeSelector)
 		} else {
 			deployment.Spec.Template.Spec.NodeSelector = nil
 		}
 
-		if np.Infra.Affinity != nil {
-			deployment.Spec.Template.Spec.Affinity = np.Infra.Affinity.DeepCopy()
+		if infra.Affinity != nil {
+			deployment.Spec.Template.Spec.Affinity = infra.Affinity.DeepCopy()
 		} else {
 			deployment.Spec.Template.Spec.Affinity = affinity
 		}
 
-		deployment.Spec.Template.Spec.Tolerations = slices.Clone(np.Infra.Tolerations)
+		deployment.Spec.Template.Spec.Tolerations = slices.Clone(infra.Tolerations)
 	} else {
 		deployment.Spec.Template.Spec.NodeSelector = nil
 		deployment.Spec.Template.Spec.Affinity = affinity
 		deployment.Spec.Template.Spec.Tolerations = nil
 	}
+
+	operands.SetTopologySpreadConstraints(hc, deployment)
+
 	return deployment
 }
 
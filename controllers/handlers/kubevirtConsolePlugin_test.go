@@ -16,6 +16,9 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -715,6 +718,28 @@ var _ = Describe("Kubevirt Console Plugin", func() {
 				Entry("proxy deployment", hcoutil.AppComponentUIProxy, NewKvUIProxyDeployment, NewKvUIProxyDeploymentHandler),
 			)
 
+			DescribeTable("should merge the console plugin component node placement on top of the infra node placement", func(deploymentManifestor func(*hcov1.HyperConverged) *appsv1.Deployment) {
+				commontestutils.SetNodeCustomPlacement(hco, commontestutils.NewOtherNodePlacement(), commontestutils.NewNodePlacement())
+				hco.Spec.Deployment.NodePlacements.Components = []hcov1.ComponentNodePlacement{
+					{
+						Component: hcov1.NodePlacementComponentConsolePlugin,
+						NodePlacement: sdkapi.NodePlacement{
+							NodeSelector: map[string]string{"pool": "console"},
+						},
+					},
+				}
+
+				deployment := deploymentManifestor(hco)
+
+				infra := hco.Spec.Deployment.NodePlacements.Infra
+				Expect(deployment.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"pool": "console"}))
+				Expect(deployment.Spec.Template.Spec.Affinity).To(Equal(infra.Affinity))
+				Expect(deployment.Spec.Template.Spec.Tolerations).To(Equal(infra.Tolerations))
+			},
+				Entry("plugin deployment", NewKvUIPluginDeployment),
+				Entry("proxy deployment", NewKvUIProxyDeployment),
+			)
+
 			DescribeTable("should overwrite node placement if directly set on Kubevirt Console Plugin Deployment", func(appComponent hcoutil.AppComponent,
 				deploymentManifestor func(*hcov1.HyperConverged) *appsv1.Deployment, handlerFunc func(cli client.Client, Scheme *runtime.Scheme) operands.Operand) {
 				commontestutils.SetNodeCustomPlacement(hco, commontestutils.NewOtherNodePlacement(), commontestutils.NewNodePlacement())
@@ -942,6 +967,119 @@ var _ = Describe("Kubevirt Console Plugin", func() {
 				Entry("plugin deployment", hcoutil.AppComponentUIPlugin, NewKvUIPluginDeployment, NewKvUIPluginDeploymentHandler),
 				Entry("proxy deployment", hcoutil.AppComponentUIProxy, NewKvUIProxyDeployment, NewKvUIProxyDeploymentHandler),
 			)
+
+			DescribeTable("use the replicas, the resources and the priority class from the HyperConverged CR", func(ctx context.Context,
+				deploymentManifestor func(converged *hcov1.HyperConverged) *appsv1.Deployment, handlerFunc func(cli client.Client, Scheme *runtime.Scheme) operands.Operand,
+				setConfig func(*hcov1.ComponentsConfig, *hcov1.ComponentDeploymentConfig)) {
+
+				commontestutils.SNONodeInfoMock()
+				DeferCleanup(func() {
+					commontestutils.ResetNodeInfoMocks()
+				})
+
+				existingResource := deploymentManifestor(hco)
+				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
+
+				resources := v1.ResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse("50m"),
+						v1.ResourceMemory: resource.MustParse("200Mi"),
+					},
+					Limits: v1.ResourceList{
+						v1.ResourceMemory: resource.MustParse("400Mi"),
+					},
+				}
+				hco.Spec.Deployment.Components = &hcov1.ComponentsConfig{}
+				setConfig(hco.Spec.Deployment.Components, &hcov1.ComponentDeploymentConfig{
+					ComponentPodConfig: hcov1.ComponentPodConfig{
+						Resources:         &resources,
+						PriorityClassName: "custom-priority-class",
+					},
+					Replicas: new(int32(3)),
+				})
+
+				res := handlerFunc(cl, commontestutils.GetScheme()).Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+				Expect(res.Updated).To(BeTrue())
+
+				foundResource := &appsv1.Deployment{}
+				Expect(
+					cl.Get(ctx,
+						types.NamespacedName{Name: existingResource.Name, Namespace: existingResource.Namespace},
+						foundResource),
+				).To(Succeed())
+
+				Expect(foundResource.Spec.Replicas).To(HaveValue(Equal(int32(3))))
+				Expect(foundResource.Spec.Template.Spec.PriorityClassName).To(Equal("custom-priority-class"))
+				Expect(foundResource.Spec.Template.Spec.Containers[0].Resources).To(Equal(resources))
+			},
+				Entry("plugin deployment", NewKvUIPluginDeployment, NewKvUIPluginDeploymentHandler,
+					func(components *hcov1.ComponentsConfig, cfg *hcov1.ComponentDeploymentConfig) {
+						components.ConsolePlugin = cfg
+					}),
+				Entry("proxy deployment", NewKvUIProxyDeployment, NewKvUIProxyDeploymentHandler,
+					func(components *hcov1.ComponentsConfig, cfg *hcov1.ComponentDeploymentConfig) {
+						components.ConsoleProxy = cfg
+					}),
+			)
+
+			DescribeTable("spread the pods across the zones and the nodes, and deploy a PodDisruptionBudget, if HighlyAvailable", func(ctx context.Context,
+				deploymentManifestor func(converged *hcov1.HyperConverged) *appsv1.Deployment, pdbHandlerFunc func(cli client.Client, Scheme *runtime.Scheme) operands.Operand) {
+
+				commontestutils.HighlyAvailableNodeInfoMocks()
+				nodeinfo.IsInfrastructureMultiZone = func() bool {
+					return true
+				}
+				DeferCleanup(func() {
+					commontestutils.ResetNodeInfoMocks()
+				})
+
+				deployment := deploymentManifestor(hco)
+				constraints := deployment.Spec.Template.Spec.TopologySpreadConstraints
+				Expect(constraints).To(HaveLen(2))
+				Expect(constraints[0].TopologyKey).To(Equal(v1.LabelTopologyZone))
+				Expect(constraints[1].TopologyKey).To(Equal(v1.LabelHostname))
+				for _, constraint := range constraints {
+					Expect(constraint.WhenUnsatisfiable).To(Equal(v1.ScheduleAnyway))
+					Expect(constraint.LabelSelector).To(Equal(deployment.Spec.Selector))
+				}
+
+				cl := commontestutils.InitClient([]client.Object{hco})
+				res := pdbHandlerFunc(cl, commontestutils.GetScheme()).Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+				Expect(res.Created).To(BeTrue())
+
+				pdb := &policyv1.PodDisruptionBudget{}
+				Expect(cl.Get(ctx, types.NamespacedName{Name: deployment.Name + "-pdb", Namespace: deployment.Namespace}, pdb)).To(Succeed())
+				Expect(pdb.Spec.Selector).To(Equal(deployment.Spec.Selector))
+			},
+				Entry("plugin deployment", NewKvUIPluginDeployment, NewKvUIPluginPDBHandler),
+				Entry("proxy deployment", NewKvUIProxyDeployment, NewKvUIProxyPDBHandler),
+			)
+
+			DescribeTable("don't spread the pods or deploy a PodDisruptionBudget on SNO", func(ctx context.Context,
+				deploymentManifestor func(converged *hcov1.HyperConverged) *appsv1.Deployment, pdbHandlerFunc func(cli client.Client, Scheme *runtime.Scheme) operands.Operand) {
+
+				commontestutils.SNONodeInfoMock()
+				DeferCleanup(func() {
+					commontestutils.ResetNodeInfoMocks()
+				})
+
+				deployment := deploymentManifestor(hco)
+				Expect(deployment.Spec.Template.Spec.TopologySpreadConstraints).To(BeEmpty())
+
+				cl := commontestutils.InitClient([]client.Object{hco})
+				res := pdbHandlerFunc(cl, commontestutils.GetScheme()).Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+				Expect(res.Created).To(BeFalse())
+
+				pdb := &policyv1.PodDisruptionBudget{}
+				err := cl.Get(ctx, types.NamespacedName{Name: deployment.Name + "-pdb", Namespace: deployment.Namespace}, pdb)
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			},
+				Entry("plugin deployment", NewKvUIPluginDeployment, NewKvUIPluginPDBHandler),
+				Entry("proxy deployment", NewKvUIProxyDeployment, NewKvUIProxyPDBHandler),
+			)
 		})
 
 		Context("TLS Security Profile", func() {
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"maps"
 	"os"
@@ -15,6 +16,7 @@ import (
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	corev1 "k8s.io/api/core/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -30,6 +32,8 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/aie"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregatedetails"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/kvfeaturegates"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
@@ -2391,6 +2395,36 @@ Version: 1.2.3`)
 				})
 			})
 
+			Context("Test GetKvFeatureGatesOf", func() {
+				It("should only map known HCO feature gates, once", func() {
+					seen := make(map[string]bool)
+					for _, mapping := range kvFeatureGatesOfHcoFeatureGates {
+						_, exists := featuregatedetails.GetFeatureGatePhase(mapping.hcoFeatureGate)
+						Expect(exists).To(BeTrue(), "unknown HCO feature gate %s", mapping.hcoFeatureGate)
+						Expect(seen).ToNot(HaveKey(mapping.hcoFeatureGate), "duplicated HCO feature gate %s", mapping.hcoFeatureGate)
+						seen[mapping.hcoFeatureGate] = true
+					}
+				})
+
+				It("should return the KubeVirt feature gates of an HCO feature gate", func() {
+					whenEnabled, whenDisabled := GetKvFeatureGatesOf("incrementalBackup")
+					Expect(whenEnabled).To(ConsistOf(kvIncrementalBackup, kvUtilityVolumes))
+					Expect(whenDisabled).To(BeEmpty())
+
+					whenEnabled, whenDisabled = GetKvFeatureGatesOf("declarativeHotplugVolumes")
+					Expect(whenEnabled).To(ConsistOf(kvDeclarativeHotplugVolumesGate))
+					Expect(whenDisabled).To(ConsistOf(kvHotplugVolumesGate))
+
+					whenEnabled, whenDisabled = GetKvFeatureGatesOf("deployKubeSecondaryDNS")
+					Expect(whenEnabled).To(BeEmpty())
+					Expect(whenDisabled).To(BeEmpty())
+
+					whenEnabled, whenDisabled = GetKvFeatureGatesOf("notExists")
+					Expect(whenEnabled).To(BeEmpty())
+					Expect(whenDisabled).To(BeEmpty())
+				})
+			})
+
 			Context("Test getKvFeatureGateList", func() {
 				DescribeTable("Should return featureGate slice",
 					func(isKVMEmulation bool, fgs *featuregates.HyperConvergedFeatureGates, expectedLength int, expectedFgs [][]string) {
@@ -3889,6 +3923,137 @@ Version: 1.2.3`)
 					Expect(kv.Spec.Configuration.HandlerConfiguration.RestClient.RateLimiter.TokenBucketRateLimiter.Burst).To(Equal(200))
 				})
 
+				It("Should report the annotation values as the effective tuning", func() {
+					hco.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedAnnotationTuningPolicy
+					hco.Annotations = map[string]string{common.TuningPolicyAnnotationName: `{"qps": 100, "burst": 200}`}
+
+					rateLimit := &hcov1.EffectiveRateLimit{QPS: resource.MustParse("100"), Burst: 200}
+					Expect(GetEffectiveTuning(hco)).To(Equal(&hcov1.EffectiveTuning{
+						Policy:     hcov1.HyperConvergedAnnotationTuningPolicy,
+						API:        rateLimit,
+						Controller: rateLimit,
+						Handler:    rateLimit,
+						Webhook:    rateLimit,
+					}))
+				})
+
+				It("Should report the exact fractional annotation QPS in the effective tuning", func() {
+					hco.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedAnnotationTuningPolicy
+					hco.Annotations = map[string]string{common.TuningPolicyAnnotationName: `{"qps": 0.5, "burst": 2}`}
+
+					kv, err := NewKubeVirt(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(kv.Spec.Configuration.APIConfiguration.RestClient.RateLimiter.TokenBucketRateLimiter.QPS).To(Equal(float32(0.5)))
+
+					effective, err := GetEffectiveTuning(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(effective.API.QPS.String()).To(Equal("500m"))
+					Expect(effective.API.QPS.AsApproximateFloat64()).To(Equal(0.5))
+					Expect(effective.API.Burst).To(Equal(int32(2)))
+					Expect(effective.Handler.QPS.String()).To(Equal("500m"))
+				})
+			})
+
+			Context("with tuning profiles", func() {
+				getRateLimiter := func(cfg *kubevirtcorev1.ReloadableComponentConfiguration) *kubevirtcorev1.TokenBucketRateLimiter {
+					GinkgoHelper()
+					Expect(cfg).ToNot(BeNil())
+					Expect(cfg.RestClient).ToNot(BeNil())
+					Expect(cfg.RestClient.RateLimiter).ToNot(BeNil())
+					return cfg.RestClient.RateLimiter.TokenBucketRateLimiter
+				}
+
+				DescribeTable("Should set the rate limits of the predefined profile", func(policy hcov1.HyperConvergedTuningPolicy, qps float32, burst int, handlerQPS float32, handlerBurst int) {
+					hco.Spec.Virtualization.TuningPolicy = policy
+
+					kv, err := NewKubeVirt(hco)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(getRateLimiter(kv.Spec.Configuration.APIConfiguration)).To(Equal(&kubevirtcorev1.TokenBucketRateLimiter{QPS: qps, Burst: burst}))
+					Expect(getRateLimiter(kv.Spec.Configuration.ControllerConfiguration)).To(Equal(&kubevirtcorev1.TokenBucketRateLimiter{QPS: qps, Burst: burst}))
+					Expect(getRateLimiter(kv.Spec.Configuration.WebhookConfiguration)).To(Equal(&kubevirtcorev1.TokenBucketRateLimiter{QPS: qps, Burst: burst}))
+					Expect(getRateLimiter(kv.Spec.Configuration.HandlerConfiguration)).To(Equal(&kubevirtcorev1.TokenBucketRateLimiter{QPS: handlerQPS, Burst: handlerBurst}))
+
+					effective, err := GetEffectiveTuning(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(effective.Policy).To(Equal(policy))
+					Expect(effective.API).To(Equal(&hcov1.EffectiveRateLimit{QPS: *resource.NewQuantity(int64(qps), resource.DecimalSI), Burst: int32(burst)}))
+					Expect(effective.Handler).To(Equal(&hcov1.EffectiveRateLimit{QPS: *resource.NewQuantity(int64(handlerQPS), resource.DecimalSI), Burst: int32(handlerBurst)}))
+				},
+					Entry("small", hcov1.HyperConvergedSmallTuningPolicy, float32(100), 200, float32(5), 10),
+					Entry("medium", hcov1.HyperConvergedMediumTuningPolicy, float32(200), 400, float32(25), 50),
+					Entry("large", hcov1.HyperConvergedLargeTuningPolicy, float32(400), 800, float32(50), 100),
+				)
+
+				It("Should set the per-component rate limits of the custom policy", func() {
+					hco.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedCustomTuningPolicy
+					hco.Spec.Virtualization.Tuning = &hcov1.TuningConfig{
+						API:     &hcov1.RateLimit{QPS: 150, Burst: 300},
+						Handler: &hcov1.RateLimit{QPS: 30, Burst: 60},
+					}
+
+					kv, err := NewKubeVirt(hco)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(getRateLimiter(kv.Spec.Configuration.APIConfiguration)).To(Equal(&kubevirtcorev1.TokenBucketRateLimiter{QPS: 150, Burst: 300}))
+					Expect(getRateLimiter(kv.Spec.Configuration.HandlerConfiguration)).To(Equal(&kubevirtcorev1.TokenBucketRateLimiter{QPS: 30, Burst: 60}))
+					Expect(kv.Spec.Configuration.ControllerConfiguration).To(BeNil())
+					Expect(kv.Spec.Configuration.WebhookConfiguration).To(BeNil())
+
+					Expect(GetEffectiveTuning(hco)).To(Equal(&hcov1.EffectiveTuning{
+						Policy:  hcov1.HyperConvergedCustomTuningPolicy,
+						API:     &hcov1.EffectiveRateLimit{QPS: *resource.NewQuantity(150, resource.DecimalSI), Burst: 300},
+						Handler: &hcov1.EffectiveRateLimit{QPS: *resource.NewQuantity(30, resource.DecimalSI), Burst: 60},
+					}))
+				})
+
+				It("Should return error if the custom policy is set without the tuning field", func() {
+					hco.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedCustomTuningPolicy
+
+					kv, err := NewKubeVirt(hco)
+					Expect(err).To(MatchError("the custom tuning policy is set, but spec.virtualization.tuning is not set"))
+					Expect(kv).To(BeNil())
+				})
+
+				It("Should set the recommended rate limits with the auto policy", func() {
+					hco.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedAutoTuningPolicy
+					hco.Status.TuningRecommendation = &hcov1.TuningRecommendation{
+						RateLimits: hcov1.TuningConfig{
+							API:        &hcov1.RateLimit{QPS: 200, Burst: 400},
+							Controller: &hcov1.RateLimit{QPS: 250, Burst: 500},
+						},
+					}
+
+					kv, err := NewKubeVirt(hco)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(getRateLimiter(kv.Spec.Configuration.APIConfiguration)).To(Equal(&kubevirtcorev1.TokenBucketRateLimiter{QPS: 200, Burst: 400}))
+					Expect(getRateLimiter(kv.Spec.Configuration.ControllerConfiguration)).To(Equal(&kubevirtcorev1.TokenBucketRateLimiter{QPS: 250, Burst: 500}))
+					Expect(kv.Spec.Configuration.HandlerConfiguration).To(BeNil())
+				})
+
+				It("Should use the KubeVirt defaults with the auto policy, until the rate limits are recommended", func() {
+					hco.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedAutoTuningPolicy
+
+					kv, err := NewKubeVirt(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(kv.Spec.Configuration.APIConfiguration).To(BeNil())
+					Expect(kv.Spec.Configuration.ControllerConfiguration).To(BeNil())
+
+					Expect(GetEffectiveTuning(hco)).To(BeNil())
+				})
+
+				It("Should ignore the tuning field if the policy is not custom", func() {
+					hco.Spec.Virtualization.Tuning = &hcov1.TuningConfig{
+						API: &hcov1.RateLimit{QPS: 150, Burst: 300},
+					}
+
+					kv, err := NewKubeVirt(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(kv.Spec.Configuration.APIConfiguration).To(BeNil())
+
+					Expect(GetEffectiveTuning(hco)).To(BeNil())
+				})
 			})
 		})
 
@@ -4098,6 +4263,191 @@ Version: 1.2.3`)
 			})
 		})
 
+		Context("paused operand", func() {
+			var modifiedKV *kubevirtcorev1.KubeVirt
+
+			BeforeEach(func() {
+				var err error
+				modifiedKV, err = NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+				modifiedKV.Spec.Configuration.MigrationConfiguration.AllowPostCopy = new(true)
+				modifiedKV.Spec.Configuration.MigrationConfiguration.ParallelMigrationsPerCluster = new(uint32(10))
+			})
+
+			It("should not modify the KubeVirt CR while paused", func() {
+				hco.Spec.Deployment.PausedOperands = []hcov1.OperandKind{hcov1.OperandKindKubeVirt}
+
+				cl := commontestutils.InitClient([]client.Object{hco, modifiedKV})
+				handler := NewKubevirtHandler(cl, commontestutils.GetScheme())
+
+				res := handler.Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+				Expect(res.Updated).To(BeFalse())
+				Expect(res.Resumed).To(BeFalse())
+				Expect(res.Component).ToNot(BeNil())
+				Expect(res.Component.Paused).To(BeTrue())
+				Expect(res.Component.Kind).To(Equal("KubeVirt"))
+
+				foundResource := &kubevirtcorev1.KubeVirt{}
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(modifiedKV), foundResource)).To(Succeed())
+				Expect(foundResource.Spec.Configuration.MigrationConfiguration.AllowPostCopy).To(HaveValue(BeTrue()))
+
+				// keep reporting the operand conditions
+				Expect(req.Conditions[hcov1.ConditionAvailable]).To(commontestutils.RepresentCondition(metav1.Condition{
+					Type:    hcov1.ConditionAvailable,
+					Status:  metav1.ConditionFalse,
+					Reason:  "KubeVirtConditions",
+					Message: "KubeVirt resource has no conditions",
+				}))
+			})
+
+			It("should create the KubeVirt CR if it is missing, even if paused", func() {
+				hco.Spec.Deployment.PausedOperands = []hcov1.OperandKind{hcov1.OperandKindKubeVirt}
+
+				cl := commontestutils.InitClient([]client.Object{hco})
+				handler := NewKubevirtHandler(cl, commontestutils.GetScheme())
+
+				res := handler.Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+				Expect(res.Created).To(BeTrue())
+				Expect(res.Component).ToNot(BeNil())
+				Expect(res.Component.Paused).To(BeTrue())
+			})
+
+			It("should ignore pausing of other operands", func() {
+				hco.Spec.Deployment.PausedOperands = []hcov1.OperandKind{hcov1.OperandKindCDI}
+
+				cl := commontestutils.InitClient([]client.Object{hco, modifiedKV})
+				handler := NewKubevirtHandler(cl, commontestutils.GetScheme())
+
+				res := handler.Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Resumed).To(BeFalse())
+				Expect(res.Drift).To(BeNil())
+				Expect(res.Component.Paused).To(BeFalse())
+			})
+
+			It("should overwrite the modifications and report them, when resumed", func() {
+				hco.Status.Components = []hcov1.ComponentStatus{
+					{Kind: "KubeVirt", Name: modifiedKV.Name, Paused: true},
+				}
+
+				cl := commontestutils.InitClient([]client.Object{hco, modifiedKV})
+				handler := NewKubevirtHandler(cl, commontestutils.GetScheme())
+
+				res := handler.Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Resumed).To(BeTrue())
+				Expect(res.Component.Paused).To(BeFalse())
+				Expect(res.Drift).ToNot(BeNil())
+				Expect(res.Drift.Changes).To(ContainElements(
+					operands.FieldDrift{Path: "/spec/configuration/migrations/allowPostCopy", Found: true, Required: false},
+					operands.FieldDrift{Path: "/spec/configuration/migrations/parallelMigrationsPerCluster", Found: json.Number("10"), Required: json.Number("5")},
+				))
+
+				foundResource := &kubevirtcorev1.KubeVirt{}
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(modifiedKV), foundResource)).To(Succeed())
+				Expect(foundResource.Spec.Configuration.MigrationConfiguration.AllowPostCopy).To(HaveValue(BeFalse()))
+			})
+		})
+
+		Context("spec.overrides", func() {
+			It("should create KV object with changes from a JSONPatch override", func() {
+				hco.Spec.Overrides = []hcov1.OperandOverride{
+					{
+						Target: hcov1.OperandOverrideTargetKubeVirt,
+						Type:   hcov1.OperandOverridePatchTypeJSONPatch,
+						JSONPatch: []hcov1.JSONPatchOperation{
+							{
+								Op:    "add",
+								Path:  "/spec/configuration/cpuRequest",
+								Value: &apiextensionsv1.JSON{Raw: []byte(`"12m"`)},
+							},
+						},
+					},
+				}
+
+				kv, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(kv.Spec.Configuration.CPURequest).ToNot(BeNil())
+				Expect(*kv.Spec.Configuration.CPURequest).To(Equal(resource.MustParse("12m")))
+			})
+
+			It("should create KV object with changes from a StrategicMerge override", func() {
+				hco.Spec.Overrides = []hcov1.OperandOverride{
+					{
+						Target:              hcov1.OperandOverrideTargetKubeVirt,
+						Type:                hcov1.OperandOverridePatchTypeStrategicMerge,
+						StrategicMergePatch: &apiextensionsv1.JSON{Raw: []byte(`{"spec": {"configuration": {"migrations": {"allowPostCopy": true}}}}`)},
+					},
+				}
+
+				kv, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(kv.Spec.Configuration.MigrationConfiguration.AllowPostCopy).To(HaveValue(BeTrue()))
+				// the rest of the migration configurations are kept
+				Expect(kv.Spec.Configuration.MigrationConfiguration.ParallelMigrationsPerCluster).To(HaveValue(Equal(uint32(5))))
+			})
+
+			It("should ignore overrides of other targets", func() {
+				hco.Spec.Overrides = []hcov1.OperandOverride{
+					{
+						Target: hcov1.OperandOverrideTargetCDI,
+						JSONPatch: []hcov1.JSONPatchOperation{
+							{Op: "add", Path: "/spec/notAField", Value: &apiextensionsv1.JSON{Raw: []byte(`true`)}},
+						},
+					},
+				}
+
+				_, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should fail to create KV object with an override that adds an unknown field", func() {
+				hco.Spec.Overrides = []hcov1.OperandOverride{
+					{
+						Target: hcov1.OperandOverrideTargetKubeVirt,
+						JSONPatch: []hcov1.JSONPatchOperation{
+							{Op: "add", Path: "/spec/configuration/notAField", Value: &apiextensionsv1.JSON{Raw: []byte(`true`)}},
+						},
+					},
+				}
+
+				_, err := NewKubeVirt(hco)
+				Expect(err).To(MatchError(And(
+					ContainSubstring("invalid spec.overrides[0] for KubeVirt"),
+					ContainSubstring(`unknown field "notAField"`),
+				)))
+			})
+
+			It("Ensure func should update KV object with changes from the override", func() {
+				existsKv, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+
+				hco.Spec.Overrides = []hcov1.OperandOverride{
+					{
+						Target: hcov1.OperandOverrideTargetKubeVirt,
+						JSONPatch: []hcov1.JSONPatchOperation{
+							{Op: "replace", Path: "/spec/configuration/migrations/allowPostCopy", Value: &apiextensionsv1.JSON{Raw: []byte(`true`)}},
+						},
+					},
+				}
+
+				cl := commontestutils.InitClient([]client.Object{hco, existsKv})
+
+				handler := NewKubevirtHandler(cl, commontestutils.GetScheme())
+				res := handler.Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+				Expect(res.Updated).To(BeTrue())
+
+				kv := &kubevirtcorev1.KubeVirt{}
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(existsKv), kv)).To(Succeed())
+				Expect(kv.Spec.Configuration.MigrationConfiguration.AllowPostCopy).To(HaveValue(BeTrue()))
+			})
+		})
+
 		Context("Cache", func() {
 			It("should create new cache if it empty", func() {
 				hook := &kubevirtHooks{}
@@ -55,6 +55,10 @@ func (*migrationHooks) CheckComponentVersion(cr runtime.Object) bool {
 	return operands.CheckComponentVersion(hcoutil.MigrationOperatorVersionEnvV, found.Status.ObservedVersion)
 }
 
+func (*migrationHooks) GetObservedVersion(cr runtime.Object) string {
+	return cr.(*migrationv1alpha1.MigController).Status.ObservedVersion
+}
+
 func (h *migrationHooks) Reset() {
 	h.Lock()
 	defer h.Unlock()
@@ -94,8 +98,8 @@ func NewMigController(hc *hcov1.HyperConverged) (*migrationv1alpha1.MigControlle
 		ImagePullPolicy: corev1.PullIfNotPresent,
 	}
 
-	if np := hc.Spec.Deployment.NodePlacements; np != nil && np.Infra != nil {
-		np.Infra.DeepCopyInto(&spec.Infra)
+	if infra := operands.GetInfraNodePlacement(hc, hcov1.NodePlacementComponentMigrationController); infra != nil {
+		spec.Infra = *infra
 	}
 
 	spec.TLSSecurityProfile = openshift2MigrationSecProfile(tlssecprofile.GetTLSSecurityProfile(hc.Spec.Security.TLSSecurityProfile))
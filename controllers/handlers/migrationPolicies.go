@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// migrationPolicyPrefix is the prefix of the names of the MigrationPolicies that HCO deploys, to avoid collisions with
+// the MigrationPolicies that are created by the cluster admin
+const migrationPolicyPrefix = "hco-"
+
+// **** Handler for the live migration profiles MigrationPolicies ****
+
+// NewMigrationPoliciesHandler returns a handler for the KubeVirt MigrationPolicies of the live migration profiles, in
+// spec.virtualization.migrationPolicies. The MigrationPolicies of profiles that were removed from the list, are removed.
+func NewMigrationPoliciesHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
+	return &migrationPoliciesHandler{
+		client: Client,
+		scheme: Scheme,
+	}
+}
+
+type migrationPoliciesHandler struct {
+	client client.Client
+	scheme *runtime.Scheme
+}
+
+func (h *migrationPoliciesHandler) Ensure(req *common.HcoRequest) *operands.EnsureResult {
+	res := operands.NewEnsureResult(&migrationsv1alpha1.MigrationPolicy{})
+
+	policies, err := NewMigrationPolicies(req.Instance)
+	if err != nil {
+		return res.Error(err)
+	}
+
+	required := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		required[policy.Name] = true
+
+		created, updated, err := h.ensureMigrationPolicy(req, policy)
+		if err != nil {
+			return res.Error(err)
+		}
+
+		if created {
+			res.SetCreated().SetName(policy.Name)
+		} else if updated && !res.Created {
+			res.SetUpdated().SetName(policy.Name)
+		}
+	}
+
+	deleted, err := h.removeMigrationPolicies(req.Ctx, req, required)
+	if err != nil {
+		return res.Error(err)
+	}
+
+	if deleted && !res.Created && !res.Updated {
+		res.SetDeleted()
+	}
+
+	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+}
+
+func (*migrationPoliciesHandler) Reset() { /* no cache */ }
+
+// EnsureDeleted removes the MigrationPolicies on uninstall. They can't be owned by the HyperConverged CR, because they
+// are cluster-scoped.
+func (h *migrationPoliciesHandler) EnsureDeleted(ctx context.Context, req *common.HcoRequest) error {
+	_, err := h.removeMigrationPolicies(ctx, req, nil)
+	return err
+}
+
+func (h *migrationPoliciesHandler) ensureMigrationPolicy(req *common.HcoRequest, policy *migrationsv1alpha1.MigrationPolicy) (bool, bool, error) {
+	found := &migrationsv1alpha1.MigrationPolicy{}
+	err := h.client.Get(req.Ctx, client.ObjectKeyFromObject(policy), found)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, false, err
+		}
+
+		req.Logger.Info("Creating MigrationPolicy", "name", policy.Name)
+		if err = h.client.Create(req.Ctx, policy); err != nil {
+			return false, false, err
+		}
+
+		return true, false, nil
+	}
+
+	if reflect.DeepEqual(policy.Spec, found.Spec) && util.CompareLabels(policy, found) {
+		return false, false, nil
+	}
+
+	req.Logger.Info("Updating existing MigrationPolicy's Spec to new opinionated values", "name", policy.Name)
+	util.MergeLabels(&policy.ObjectMeta, &found.ObjectMeta)
+	policy.Spec.DeepCopyInto(&found.Spec)
+
+	if err = h.client.Update(req.Ctx, found); err != nil {
+		return false, false, err
+	}
+
+	return false, true, nil
+}
+
+// removeMigrationPolicies removes the MigrationPolicies that were deployed by HCO, and are not in the required set
+func (h *migrationPoliciesHandler) removeMigrationPolicies(ctx context.Context, req *common.HcoRequest, required map[string]bool) (bool, error) {
+	policyList := &migrationsv1alpha1.MigrationPolicyList{}
+	if err := h.client.List(ctx, policyList, client.MatchingLabels{
+		util.AppLabel:          util.HyperConvergedName,
+		util.AppLabelComponent: string(util.AppComponentCompute),
+	}); err != nil {
+		if meta.IsNoMatchError(err) && len(required) == 0 {
+			// the MigrationPolicy CRD is deployed by KubeVirt; if it's not there, there is nothing to remove
+			return false, nil
+		}
+		return false, err
+	}
+
+	deleted := false
+	var errs []error
+	for i := range policyList.Items {
+		policy := &policyList.Items[i]
+		if required[policy.Name] {
+			continue
+		}
+
+		req.Logger.Info("Removing MigrationPolicy", "name", policy.Name)
+		if err := h.client.Delete(ctx, policy); err != nil {
+			if !apierrors.IsNotFound(err) {
+				errs = append(errs, err)
+			}
+			continue
+		}
+
+		deleted = true
+	}
+
+	return deleted, errors.Join(errs...)
+}
+
+// GetMigrationPolicyName returns the name of the MigrationPolicy of a live migration profile
+func GetMigrationPolicyName(profileName string) string {
+	return migrationPolicyPrefix + profileName
+}
+
+// NewMigrationPolicies returns the MigrationPolicies of the live migration profiles, in
+// spec.virtualization.migrationPolicies
+func NewMigrationPolicies(hc *hcov1.HyperConverged) ([]*migrationsv1alpha1.MigrationPolicy, error) {
+	profiles := hc.Spec.Virtualization.MigrationPolicies
+	policies := make([]*migrationsv1alpha1.MigrationPolicy, 0, len(profiles))
+
+	for _, profile := range profiles {
+		policy, err := newMigrationPolicy(profile)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+func newMigrationPolicy(profile hcov1.MigrationPolicyProfile) (*migrationsv1alpha1.MigrationPolicy, error) {
+	var bandwidthPerMigration *resource.Quantity
+	if profile.BandwidthPerMigration != nil {
+		bandwidth, err := resource.ParseQuantity(*profile.BandwidthPerMigration)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the bandwidthPerMigration field of the %q migration policy; %w", profile.Name, err)
+		}
+		bandwidthPerMigration = &bandwidth
+	}
+
+	return &migrationsv1alpha1.MigrationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   GetMigrationPolicyName(profile.Name),
+			Labels: operands.GetLabels(util.AppComponentCompute),
+		},
+		Spec: migrationsv1alpha1.MigrationPolicySpec{
+			Selectors: &migrationsv1alpha1.Selectors{
+				NamespaceSelector:              migrationsv1alpha1.LabelSelector(maps.Clone(profile.NamespaceSelector)),
+				VirtualMachineInstanceSelector: migrationsv1alpha1.LabelSelector(maps.Clone(profile.VMSelector)),
+			},
+			AllowAutoConverge:       profile.AllowAutoConverge,
+			BandwidthPerMigration:   bandwidthPerMigration,
+			CompletionTimeoutPerGiB: profile.CompletionTimeoutPerGiB,
+			AllowPostCopy:           profile.AllowPostCopy,
+			AllowWorkloadDisruption: profile.AllowWorkloadDisruption,
+		},
+	}, nil
+}
@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Live migration profiles MigrationPolicies", func() {
+	var (
+		hco *hcov1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	listMigrationPolicies := func(cl client.Client) []migrationsv1alpha1.MigrationPolicy {
+		policyList := &migrationsv1alpha1.MigrationPolicyList{}
+		Expect(cl.List(context.Background(), policyList)).To(Succeed())
+		return policyList.Items
+	}
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		hco.Spec.Virtualization.MigrationPolicies = []hcov1.MigrationPolicyProfile{
+			{
+				Name:              "latency-sensitive",
+				VMSelector:        map[string]string{"workload": "latency-sensitive"},
+				AllowAutoConverge: new(false),
+				AllowPostCopy:     new(true),
+			},
+			{
+				Name:                    "bulk",
+				NamespaceSelector:       map[string]string{"tier": "batch"},
+				BandwidthPerMigration:   new("64Mi"),
+				CompletionTimeoutPerGiB: new(int64(800)),
+				AllowAutoConverge:       new(true),
+			},
+		}
+		req = commontestutils.NewReq(hco)
+	})
+
+	It("should create the MigrationPolicies", func() {
+		cl := commontestutils.InitClient([]client.Object{hco})
+		handler := NewMigrationPoliciesHandler(cl, commontestutils.GetScheme())
+
+		res := handler.Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Created).To(BeTrue())
+
+		found := listMigrationPolicies(cl)
+		Expect(found).To(HaveLen(2))
+		for _, policy := range found {
+			Expect(policy.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hcoutil.HyperConvergedName))
+			Expect(policy.Labels).To(HaveKeyWithValue(hcoutil.AppLabelComponent, string(hcoutil.AppComponentCompute)))
+		}
+
+		Expect(found).To(ContainElement(And(
+			HaveField("Name", "hco-latency-sensitive"),
+			HaveField("Spec", migrationsv1alpha1.MigrationPolicySpec{
+				Selectors: &migrationsv1alpha1.Selectors{
+					VirtualMachineInstanceSelector: migrationsv1alpha1.LabelSelector{"workload": "latency-sensitive"},
+				},
+				AllowAutoConverge: new(false),
+				AllowPostCopy:     new(true),
+			}),
+		)))
+
+		bandwidth := resource.MustParse("64Mi")
+		Expect(found).To(ContainElement(And(
+			HaveField("Name", "hco-bulk"),
+			HaveField("Spec", migrationsv1alpha1.MigrationPolicySpec{
+				Selectors: &migrationsv1alpha1.Selectors{
+					NamespaceSelector: migrationsv1alpha1.LabelSelector{"tier": "batch"},
+				},
+				BandwidthPerMigration:   &bandwidth,
+				CompletionTimeoutPerGiB: new(int64(800)),
+				AllowAutoConverge:       new(true),
+			}),
+		)))
+	})
+
+	It("should reconcile a modified MigrationPolicy", func() {
+		required, err := NewMigrationPolicies(hco)
+		Expect(err).ToNot(HaveOccurred())
+
+		existing := required[0]
+		existing.Spec.AllowPostCopy = new(false)
+		existing.Labels = map[string]string{"user-label": "value"}
+
+		cl := commontestutils.InitClient([]client.Object{hco, existing, required[1]})
+		handler := NewMigrationPoliciesHandler(cl, commontestutils.GetScheme())
+
+		res := handler.Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Updated).To(BeTrue())
+
+		found := &migrationsv1alpha1.MigrationPolicy{}
+		Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(existing), found)).To(Succeed())
+		Expect(found.Spec.AllowPostCopy).To(HaveValue(BeTrue()))
+		Expect(found.Labels).To(HaveKeyWithValue("user-label", "value"))
+		Expect(found.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hcoutil.HyperConvergedName))
+
+		res = handler.Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Updated).To(BeFalse())
+	})
+
+	It("should remove the MigrationPolicies that are not required anymore, but not the ones that were not deployed by HCO", func() {
+		required, err := NewMigrationPolicies(hco)
+		Expect(err).ToNot(HaveOccurred())
+
+		userPolicy := &migrationsv1alpha1.MigrationPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "user-policy",
+			},
+		}
+
+		cl := commontestutils.InitClient([]client.Object{hco, required[0], required[1], userPolicy})
+		handler := NewMigrationPoliciesHandler(cl, commontestutils.GetScheme())
+
+		hco.Spec.Virtualization.MigrationPolicies = hco.Spec.Virtualization.MigrationPolicies[:1]
+
+		res := handler.Ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Deleted).To(BeTrue())
+
+		found := listMigrationPolicies(cl)
+		Expect(found).To(HaveLen(2))
+		Expect(found).To(ContainElement(HaveField("Name", "hco-latency-sensitive")))
+		Expect(found).To(ContainElement(HaveField("Name", "user-policy")))
+	})
+
+	It("should remove all the MigrationPolicies that were deployed by HCO on uninstall", func() {
+		required, err := NewMigrationPolicies(hco)
+		Expect(err).ToNot(HaveOccurred())
+
+		cl := commontestutils.InitClient([]client.Object{hco, required[0], required[1]})
+		handler := NewMigrationPoliciesHandler(cl, commontestutils.GetScheme())
+
+		deleter, ok := handler.(operands.MultiObjectDeleter)
+		Expect(ok).To(BeTrue())
+		Expect(deleter.EnsureDeleted(context.Background(), req)).To(Succeed())
+
+		Expect(listMigrationPolicies(cl)).To(BeEmpty())
+	})
+
+	It("should fail if the bandwidth is not valid", func() {
+		hco.Spec.Virtualization.MigrationPolicies[1].BandwidthPerMigration = new("wrong")
+
+		cl := commontestutils.InitClient([]client.Object{hco})
+		handler := NewMigrationPoliciesHandler(cl, commontestutils.GetScheme())
+
+		res := handler.Ensure(req)
+		Expect(res.Err).To(MatchError(ContainSubstring(`failed to parse the bandwidthPerMigration field of the "bulk" migration policy`)))
+	})
+})
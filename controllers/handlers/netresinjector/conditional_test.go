@@ -204,9 +204,25 @@ var _ = Describe("Network Resources Injector Conditional Handlers", func() {
 			Expect(cl.Get(context.Background(), client.ObjectKey{Name: deploymentName + "-pdb", Namespace: hco.Namespace}, foundPDB)).To(Succeed())
 		})
 
+		It("should delete PDB when the PodDisruptionBudgets are disabled", func() {
+			hco.Spec.Deployment.DeployNetworkResourcesInjector = new(true)
+			hco.Spec.Deployment.HighAvailability = &hcov1.HighAvailabilityConfig{
+				PodDisruptionBudget: &hcov1.PodDisruptionBudgetConfig{Enable: new(false)},
+			}
+			pdb := newPDB(hco)
+			pdb.Namespace = hco.Namespace
+			cl = commontestutils.InitClient([]client.Object{hco, pdb})
+
+			handler := NewPDBHandler(cl, commontestutils.GetScheme())
+			res := handler.Ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Deleted).To(BeTrue())
+		})
+
 		It("should delete PDB when disabled", func() {
 			hco.Spec.Deployment.DeployNetworkResourcesInjector = new(false)
-			pdb := newPDB()
+			pdb := newPDB(hco)
 			pdb.Namespace = hco.Namespace
 			cl = commontestutils.InitClient([]client.Object{hco, pdb})
 
@@ -98,6 +98,10 @@ func setNetResInjCondition(req *common.HcoRequest, status metav1.ConditionStatus
 		Message:            message,
 		ObservedGeneration: req.Instance.Generation,
 	}
+
+	req.Lock()
+	defer req.Unlock()
+
 	changed := meta.SetStatusCondition(&req.Instance.Status.Conditions, cond)
 	if changed {
 		req.StatusDirty = true
@@ -105,6 +109,9 @@ func setNetResInjCondition(req *common.HcoRequest, status metav1.ConditionStatus
 }
 
 func removeNetResInjCondition(req *common.HcoRequest) {
+	req.Lock()
+	defer req.Unlock()
+
 	changed := meta.RemoveStatusCondition(&req.Instance.Status.Conditions, hcov1.ConditionNetworkResourcesInjectorReady)
 	if changed {
 		req.StatusDirty = true
@@ -299,6 +306,9 @@ func newDeployment(hc *hcov1.HyperConverged) *appsv1.Deployment {
 		},
 	}
 
+	operands.ApplyDeploymentConfig(dep, operands.GetComponentsConfig(hc).NetworkResourcesInjector)
+	operands.SetTopologySpreadConstraints(hc, dep)
+
 	return dep
 }
 
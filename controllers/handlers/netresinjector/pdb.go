@@ -7,7 +7,6 @@ import (
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
@@ -16,22 +15,24 @@ import (
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
+// NewPDBHandler returns the handler of the network resources injector PodDisruptionBudget. The PodDisruptionBudget is
+// removed if the PodDisruptionBudgets are disabled in spec.deployment.highAvailability.
 func NewPDBHandler(cli client.Client, scheme *runtime.Scheme) operands.Operand {
 	return operands.NewConditionalHandler(
-		operands.NewGenericOperand(cli, scheme, "PodDisruptionBudget", &pdbHooks{pdb: newPDB()}, true),
-		shouldDeploy,
+		operands.NewGenericOperand(cli, scheme, "PodDisruptionBudget", &pdbHooks{}, true),
+		func(hc *hcov1.HyperConverged) bool {
+			return shouldDeploy(hc) && operands.IsPDBEnabled(hc)
+		},
 		func(hc *hcov1.HyperConverged) client.Object {
 			return NewPDBWithNameOnly()
 		},
 	)
 }
 
-type pdbHooks struct {
-	pdb *policyv1.PodDisruptionBudget
-}
+type pdbHooks struct{}
 
-func (h *pdbHooks) GetFullCr(_ *hcov1.HyperConverged) (client.Object, error) {
-	return h.pdb.DeepCopy(), nil
+func (*pdbHooks) GetFullCr(hc *hcov1.HyperConverged) (client.Object, error) {
+	return newPDB(hc), nil
 }
 
 func (*pdbHooks) GetEmptyCr() client.Object {
@@ -85,10 +86,10 @@ func NewPDBWithNameOnly() *policyv1.PodDisruptionBudget {
 	}
 }
 
-func newPDB() *policyv1.PodDisruptionBudget {
+func newPDB(hc *hcov1.HyperConverged) *policyv1.PodDisruptionBudget {
 	pdb := NewPDBWithNameOnly()
 	pdb.Spec = policyv1.PodDisruptionBudgetSpec{
-		MinAvailable: &intstr.IntOrString{Type: intstr.Int, IntVal: 1},
+		MinAvailable: new(operands.GetPDBMinAvailable(hc)),
 		Selector: &metav1.LabelSelector{
 			MatchLabels: map[string]string{
 				hcoutil.AppLabel:          hcoutil.HyperConvergedName,
@@ -30,7 +30,7 @@ var _ = Describe("Network Resources Injector PodDisruptionBudget", func() {
 
 	Context("newPDB", func() {
 		It("should have all default values", func() {
-			pdb := newPDB()
+			pdb := newPDB(hco)
 			Expect(pdb.Name).To(Equal(deploymentName + "-pdb"))
 			Expect(pdb.Namespace).To(Equal(hco.Namespace))
 			Expect(pdb.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hcoutil.HyperConvergedName))
@@ -42,6 +42,15 @@ var _ = Describe("Network Resources Injector PodDisruptionBudget", func() {
 			Expect(pdb.Spec.Selector.MatchLabels).To(HaveKeyWithValue(hcoutil.AppLabel, hcoutil.HyperConvergedName))
 			Expect(pdb.Spec.Selector.MatchLabels).To(HaveKeyWithValue(hcoutil.AppLabelComponent, string(hcoutil.AppComponentNetResInjector)))
 		})
+
+		It("should use the minAvailable from the HyperConverged CR", func() {
+			hco.Spec.Deployment.HighAvailability = &hcov1.HighAvailabilityConfig{
+				PodDisruptionBudget: &hcov1.PodDisruptionBudgetConfig{MinAvailable: new(intstr.FromString("50%"))},
+			}
+
+			pdb := newPDB(hco)
+			Expect(pdb.Spec.MinAvailable).To(HaveValue(Equal(intstr.FromString("50%"))))
+		})
 	})
 
 	Context("PDB handler", func() {
@@ -63,7 +72,7 @@ var _ = Describe("Network Resources Injector PodDisruptionBudget", func() {
 
 	Context("PDB update", func() {
 		It("should update PDB spec if not matched to requirements", func() {
-			pdb := newPDB()
+			pdb := newPDB(hco)
 			pdb.Spec.MinAvailable = &intstr.IntOrString{Type: intstr.Int, IntVal: 99}
 			cl = commontestutils.InitClient([]client.Object{hco, pdb})
 
@@ -79,7 +88,7 @@ var _ = Describe("Network Resources Injector PodDisruptionBudget", func() {
 		})
 
 		It("should not update PDB if spec already matches", func() {
-			pdb := newPDB()
+			pdb := newPDB(hco)
 			cl = commontestutils.InitClient([]client.Object{hco, pdb})
 
 			handler := NewPDBHandler(cl, commontestutils.GetScheme())
@@ -90,7 +99,7 @@ var _ = Describe("Network Resources Injector PodDisruptionBudget", func() {
 		})
 
 		It("should reconcile labels if they are missing while preserving user labels", func() {
-			pdb := newPDB()
+			pdb := newPDB(hco)
 			expectedLabels := maps.Clone(pdb.Labels)
 			delete(pdb.Labels, hcoutil.AppLabelComponent)
 			pdb.Labels["user-added-label"] = "user-value"
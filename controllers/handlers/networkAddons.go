@@ -60,6 +60,9 @@ func (h *cnaHooks) CheckComponentVersion(cr runtime.Object) bool {
 	found := cr.(*networkaddonsv1.NetworkAddonsConfig)
 	return operands.CheckComponentVersion(util.CnaoVersionEnvV, found.Status.ObservedVersion)
 }
+func (*cnaHooks) GetObservedVersion(cr runtime.Object) string {
+	return cr.(*networkaddonsv1.NetworkAddonsConfig).Status.ObservedVersion
+}
 func (h *cnaHooks) Reset() {
 	h.Lock()
 	defer h.Unlock()
@@ -120,6 +123,9 @@ func (*cnaHooks) updateSpec(req *common.HcoRequest, found *networkaddonsv1.Netwo
 // Otherwise - remain the value as it is.
 func setDeployOvsAnnotation(req *common.HcoRequest, found *networkaddonsv1.NetworkAddonsConfig) {
 	if req.UpgradeMode {
+		req.Lock()
+		defer req.Unlock()
+
 		_, exists := req.Instance.Annotations["deployOVS"]
 		if !exists {
 			if req.Instance.Annotations == nil {
@@ -166,14 +172,13 @@ func NewNetworkAddons(hc *hcov1.HyperConverged) (*networkaddonsv1.NetworkAddonsC
 
 	cnaoSpec.Ovs = hcoAnnotation2CnaoSpec(hc.Annotations)
 
-	if np := hc.Spec.Deployment.NodePlacements; np != nil {
-		cnaoInfra := hcoConfig2CnaoPlacement(np.Infra)
-		cnaoWorkloads := hcoConfig2CnaoPlacement(np.Workload)
-		if cnaoInfra != nil || cnaoWorkloads != nil {
-			cnaoSpec.PlacementConfiguration = &networkaddonsshared.PlacementConfiguration{
-				Infra:     cnaoInfra,
-				Workloads: cnaoWorkloads,
-			}
+	infra, workload := operands.GetNodePlacements(hc, hcov1.NodePlacementComponentNetworkAddonsInfra, hcov1.NodePlacementComponentNetworkAddonsWorkload)
+	cnaoInfra := hcoConfig2CnaoPlacement(infra)
+	cnaoWorkloads := hcoConfig2CnaoPlacement(workload)
+	if cnaoInfra != nil || cnaoWorkloads != nil {
+		cnaoSpec.PlacementConfiguration = &networkaddonsshared.PlacementConfiguration{
+			Infra:     cnaoInfra,
+			Workloads: cnaoWorkloads,
 		}
 	}
 
@@ -188,6 +193,10 @@ func NewNetworkAddons(hc *hcov1.HyperConverged) (*networkaddonsv1.NetworkAddonsC
 		return nil, err
 	}
 
+	if err = operands.ApplyOverrides(hc, hcov1.OperandOverrideTargetNetworkAddonsConfig, cna); err != nil {
+		return nil, err
+	}
+
 	return reformatobj.ReformatObj(cna)
 }
 
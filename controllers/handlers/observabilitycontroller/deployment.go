@@ -87,6 +87,8 @@ func newDeployment(hc *hcov1.HyperConverged) *appsv1.Deployment {
 
 	dep := NewDeploymentWithNameOnly()
 	dep.Spec = appsv1.DeploymentSpec{
+		// The controller always runs a single replica, so it has no PodDisruptionBudget: a PodDisruptionBudget for a
+		// single replica would block the node drain.
 		Replicas: new(int32(1)),
 		Selector: &metav1.LabelSelector{
 			MatchLabels: selectorLabels,
@@ -194,6 +196,9 @@ func newDeployment(hc *hcov1.HyperConverged) *appsv1.Deployment {
 		},
 	}
 
+	operands.ApplyPodConfig(&dep.Spec.Template.Spec, operands.GetComponentsConfig(hc).ObservabilityController)
+	operands.SetTopologySpreadConstraints(hc, dep)
+
 	return dep
 }
 
@@ -96,6 +96,10 @@ func (*sspHooks) CheckComponentVersion(cr runtime.Object) bool {
 	return operands.CheckComponentVersion(util.SspVersionEnvV, found.Status.ObservedVersion)
 }
 
+func (*sspHooks) GetObservedVersion(cr runtime.Object) string {
+	return cr.(*sspv1beta3.SSP).Status.ObservedVersion
+}
+
 func (h *sspHooks) Reset() {
 	h.Lock()
 	defer h.Unlock()
@@ -129,8 +133,15 @@ func (*sspHooks) UpdateCR(req *common.HcoRequest, client client.Client, exists r
 }
 
 func (h *sspHooks) updateDICTsInHCStatus(req *common.HcoRequest) {
-	if !reflect.DeepEqual(h.dictStatuses, req.Instance.Status.DataImportCronTemplates) {
-		req.Instance.Status.DataImportCronTemplates = h.dictStatuses
+	updateDICTsInHCStatus(req, h.dictStatuses)
+}
+
+func updateDICTsInHCStatus(req *common.HcoRequest, dictStatuses []hcov1.DataImportCronTemplateStatus) {
+	req.Lock()
+	defer req.Unlock()
+
+	if !reflect.DeepEqual(dictStatuses, req.Instance.Status.DataImportCronTemplates) {
+		req.Instance.Status.DataImportCronTemplates = dictStatuses
 		req.StatusDirty = true
 	}
 
@@ -196,8 +207,8 @@ func NewSSP(hc *hcov1.HyperConverged, useNodeInfoFromStatus bool) (*sspv1beta3.S
 		}
 	}
 
-	if np := hc.Spec.Deployment.NodePlacements; np != nil && np.Infra != nil {
-		spec.TemplateValidator.Placement = np.Infra.DeepCopy()
+	if infra := operands.GetInfraNodePlacement(hc, hcov1.NodePlacementComponentSSP); infra != nil {
+		spec.TemplateValidator.Placement = infra
 	}
 
 	ssp := NewSSPWithNameOnly()
@@ -207,6 +218,10 @@ func NewSSP(hc *hcov1.HyperConverged, useNodeInfoFromStatus bool) (*sspv1beta3.S
 		return nil, nil, err
 	}
 
+	if err = operands.ApplyOverrides(hc, hcov1.OperandOverrideTargetSSP, ssp); err != nil {
+		return nil, nil, err
+	}
+
 	ssp, err = reformatobj.ReformatObj(ssp)
 	if err != nil {
 		return nil, nil, err
@@ -53,6 +53,10 @@ func (*vmfrHooks) CheckComponentVersion(cr runtime.Object) bool {
 	found := cr.(*vmfr.FileRestoreOperator)
 	return operands.CheckComponentVersion(hcoutil.VMFileRestoreOperatorVersionEnvV, found.Status.ObservedVersion)
 }
+
+func (*vmfrHooks) GetObservedVersion(cr runtime.Object) string {
+	return cr.(*vmfr.FileRestoreOperator).Status.ObservedVersion
+}
 func (h *vmfrHooks) Reset() {
 	h.Lock()
 	defer h.Unlock()
@@ -3,6 +3,7 @@ package wasp_agent
 import (
 	"maps"
 	"os"
+	"strconv"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -22,7 +23,7 @@ import (
 
 const (
 	clusterRoleName             = "wasp-cluster"
-	verbosity                   = "1"
+	defaultVerbosity            = 1
 	AppComponentWaspAgent       = "wasp-agent"
 	waspAgentServiceAccountName = "wasp"
 	waspAgentSCCName            = "wasp"
@@ -39,13 +40,16 @@ var (
 )
 
 func NewWaspAgentDaemonSetHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
-	return operands.NewConditionalHandler(
-		operands.NewDaemonSetHandler(Client, Scheme, newWaspAgentDaemonSet),
-		shouldDeployWaspAgent,
-		func(hc *hcov1.HyperConverged) client.Object {
-			return NewWaspAgentWithNameOnly()
-		},
-	)
+	return &waspAgentDaemonSetHandler{
+		inner: operands.NewConditionalHandler(
+			operands.NewDaemonSetHandler(Client, Scheme, newWaspAgentDaemonSet),
+			shouldDeployWaspAgent,
+			func(hc *hcov1.HyperConverged) client.Object {
+				return NewWaspAgentWithNameOnly()
+			},
+		),
+		client: Client,
+	}
 }
 
 func NewWaspAgentWithNameOnly() *appsv1.DaemonSet {
@@ -91,7 +95,7 @@ func newWaspAgentDaemonSet(hc *hcov1.HyperConverged) *appsv1.DaemonSet {
 			},
 		},
 	}
-	container.Env = createDaemonSetEnvVar()
+	container.Env = createDaemonSetEnvVar(getSwapConfig(hc))
 
 	spec := appsv1.DaemonSetSpec{
 		Selector: &metav1.LabelSelector{
@@ -145,32 +149,46 @@ func newWaspAgentDaemonSet(hc *hcov1.HyperConverged) *appsv1.DaemonSet {
 	ds := NewWaspAgentWithNameOnly()
 	ds.Spec = spec
 
-	if np := hc.Spec.Deployment.NodePlacements; np != nil && np.Infra != nil {
-		if np.Infra.NodeSelector != nil {
-			ds.Spec.Template.Spec.NodeSelector = maps.Clone(np.Infra.NodeSelector)
+	if infra := operands.GetInfraNodePlacement(hc, hcov1.NodePlacementComponentWaspAgent); infra != nil {
+		if infra.NodeSelector != nil {
+			ds.Spec.Template.Spec.NodeSelector = maps.Clone(infra.NodeSelector)
 		}
 
-		if np.Infra.Affinity != nil {
-			ds.Spec.Template.Spec.Affinity = np.Infra.Affinity.DeepCopy()
+		if infra.Affinity != nil {
+			ds.Spec.Template.Spec.Affinity = infra.Affinity.DeepCopy()
 		}
 
-		if np.Infra.Tolerations != nil {
-			ds.Spec.Template.Spec.Tolerations = make([]corev1.Toleration, len(np.Infra.Tolerations))
-			copy(ds.Spec.Template.Spec.Tolerations, np.Infra.Tolerations)
+		if infra.Tolerations != nil {
+			ds.Spec.Template.Spec.Tolerations = make([]corev1.Toleration, len(infra.Tolerations))
+			copy(ds.Spec.Template.Spec.Tolerations, infra.Tolerations)
 		}
 	} else {
 		affinity := getPodAntiAffinity(ds.Labels[hcoutil.AppLabelComponent], nodeinfo.IsInfrastructureHighlyAvailable())
 		ds.Spec.Template.Spec.Affinity = affinity
 	}
 
+	if swap := getSwapConfig(hc); swap != nil && len(swap.NodeSelector) > 0 {
+		if ds.Spec.Template.Spec.NodeSelector == nil {
+			ds.Spec.Template.Spec.NodeSelector = make(map[string]string, len(swap.NodeSelector))
+		}
+		maps.Copy(ds.Spec.Template.Spec.NodeSelector, swap.NodeSelector)
+	}
+
+	operands.ApplyPodConfig(&ds.Spec.Template.Spec, operands.GetComponentsConfig(hc).WaspAgent)
+
 	return ds
 }
 
-func createDaemonSetEnvVar() []corev1.EnvVar {
-	return []corev1.EnvVar{
+func createDaemonSetEnvVar(swap *hcov1.SwapConfig) []corev1.EnvVar {
+	verbosity := int32(defaultVerbosity)
+	if swap != nil && swap.Verbosity != nil {
+		verbosity = *swap.Verbosity
+	}
+
+	envVars := []corev1.EnvVar{
 		{
 			Name:  "VERBOSITY",
-			Value: verbosity,
+			Value: strconv.Itoa(int(verbosity)),
 		},
 		{
 			Name: "NODE_NAME",
@@ -182,23 +200,82 @@ func createDaemonSetEnvVar() []corev1.EnvVar {
 			},
 		},
 	}
+
+	if swap == nil {
+		return envVars
+	}
+
+	// wasp-agent uses its own defaults for the missing thresholds
+	if swap.SwapUtilizationThresholdPercentage != nil {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  "SWAP_UTILIZATION_THRESHOLD_FACTOR",
+			Value: strconv.FormatFloat(float64(*swap.SwapUtilizationThresholdPercentage)/100, 'f', -1, 64),
+		})
+	}
+
+	if eviction := swap.Eviction; eviction != nil {
+		if eviction.MaxAverageSwapInPagesPerSecond != nil {
+			envVars = append(envVars, corev1.EnvVar{
+				Name:  "MAX_AVERAGE_SWAP_IN_PAGES_PER_SECOND",
+				Value: strconv.FormatInt(*eviction.MaxAverageSwapInPagesPerSecond, 10),
+			})
+		}
+
+		if eviction.MaxAverageSwapOutPagesPerSecond != nil {
+			envVars = append(envVars, corev1.EnvVar{
+				Name:  "MAX_AVERAGE_SWAP_OUT_PAGES_PER_SECOND",
+				Value: strconv.FormatInt(*eviction.MaxAverageSwapOutPagesPerSecond, 10),
+			})
+		}
+
+		if eviction.AverageWindowSizeSeconds != nil {
+			envVars = append(envVars, corev1.EnvVar{
+				Name:  "AVERAGE_WINDOW_SIZE_SECONDS",
+				Value: strconv.Itoa(int(*eviction.AverageWindowSizeSeconds)),
+			})
+		}
+	}
+
+	return envVars
 }
 
-func shouldDeployWaspAgent(hc *hcov1.HyperConverged) bool {
-	val := strings.TrimSpace(hc.Annotations[AutopilotSwapAnnotation])
+func getSwapConfig(hc *hcov1.HyperConverged) *hcov1.SwapConfig {
+	if hc.Spec.Virtualization.HigherWorkloadDensity == nil {
+		return nil
+	}
+	return hc.Spec.Virtualization.HigherWorkloadDensity.Swap
+}
+
+// IsSwapManagedByAutopilot returns true if swap is opted into the platform autopilot, by the
+// platform.kubevirt.io/autopilot annotation
+func IsSwapManagedByAutopilot(annotations map[string]string) bool {
+	val := strings.TrimSpace(annotations[AutopilotSwapAnnotation])
 	if val == AutopilotFullOptInAnnotationValue {
-		return false
+		return true
 	}
 	for name := range strings.SplitSeq(val, ",") {
 		if strings.TrimSpace(name) == AutopilotSwapAnnotationValue {
-			return false
+			return true
 		}
 	}
+	return false
+}
 
+func shouldDeployWaspAgent(hc *hcov1.HyperConverged) bool {
 	if hc.Spec.Virtualization.HigherWorkloadDensity == nil {
 		return false
 	}
 
+	if swap := getSwapConfig(hc); swap != nil && swap.Enabled != nil {
+		if !*swap.Enabled {
+			return false
+		}
+	} else if IsSwapManagedByAutopilot(hc.Annotations) {
+		// the mutating webhook converts the annotation to the swap.enabled field; the annotation is still checked
+		// here, for HyperConverged CRs that were not modified since.
+		return false
+	}
+
 	overcommitPercentage := hc.Spec.Virtualization.HigherWorkloadDensity.MemoryOvercommitPercentage
 	return overcommitPercentage > NoOverCommitPercentage
 }
@@ -3,15 +3,20 @@ package wasp_agent
 import (
 	"context"
 	"maps"
+	"slices"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
+
 	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
@@ -203,6 +208,228 @@ var _ = Describe("Wasp Agent DaemonSet", func() {
 		})
 
 	})
+	Context("swap configuration", func() {
+		BeforeEach(func() {
+			hco.Spec.Virtualization.HigherWorkloadDensity = &hcov1.HigherWorkloadDensityConfiguration{
+				MemoryOvercommitPercentage: 150,
+			}
+		})
+
+		It("should not create DaemonSet when swap is disabled", func() {
+			hco.Spec.Virtualization.HigherWorkloadDensity.Swap = &hcov1.SwapConfig{Enabled: new(false)}
+			ds = commontestutils.InitClient([]client.Object{hco})
+
+			handler := NewWaspAgentDaemonSetHandler(ds, commontestutils.GetScheme())
+			res := handler.Ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeFalse())
+
+			foundDs := &appsv1.DaemonSetList{}
+			Expect(ds.List(context.Background(), foundDs)).To(Succeed())
+			Expect(foundDs.Items).To(BeEmpty())
+		})
+
+		It("should create DaemonSet when swap is explicitly enabled, even if the autopilot swap annotation is set", func() {
+			hco.Spec.Virtualization.HigherWorkloadDensity.Swap = &hcov1.SwapConfig{Enabled: new(true)}
+			hco.Annotations[AutopilotSwapAnnotation] = AutopilotSwapAnnotationValue
+			ds = commontestutils.InitClient([]client.Object{hco})
+
+			handler := NewWaspAgentDaemonSetHandler(ds, commontestutils.GetScheme())
+			res := handler.Ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+		})
+
+		It("should not create DaemonSet when swap is enabled, but memory is not overcommitted", func() {
+			hco.Spec.Virtualization.HigherWorkloadDensity.MemoryOvercommitPercentage = 100
+			hco.Spec.Virtualization.HigherWorkloadDensity.Swap = &hcov1.SwapConfig{Enabled: new(true)}
+			ds = commontestutils.InitClient([]client.Object{hco})
+
+			handler := NewWaspAgentDaemonSetHandler(ds, commontestutils.GetScheme())
+			res := handler.Ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeFalse())
+		})
+
+		It("should use the default environment variables, if the swap configuration is not set", func() {
+			daemonSet := newWaspAgentDaemonSet(hco)
+
+			env := daemonSet.Spec.Template.Spec.Containers[0].Env
+			Expect(env).To(HaveLen(2))
+			Expect(env).To(ContainElement(corev1.EnvVar{Name: "VERBOSITY", Value: "1"}))
+			Expect(daemonSet.Spec.Template.Spec.NodeSelector).To(BeEmpty())
+		})
+
+		It("should set the environment variables and the node selector from the swap configuration", func() {
+			hco.Spec.Virtualization.HigherWorkloadDensity.Swap = &hcov1.SwapConfig{
+				NodeSelector:                       map[string]string{"swap": "enabled"},
+				Verbosity:                          new(int32(5)),
+				SwapUtilizationThresholdPercentage: new(int32(80)),
+				Eviction: &hcov1.SwapEvictionConfig{
+					MaxAverageSwapInPagesPerSecond:  new(int64(1000)),
+					MaxAverageSwapOutPagesPerSecond: new(int64(2000)),
+					AverageWindowSizeSeconds:        new(int32(30)),
+				},
+			}
+			hco.Spec.Deployment.NodePlacements = &hcov1.NodePlacements{
+				Infra: &sdkapi.NodePlacement{
+					NodeSelector: map[string]string{"infra": "true"},
+				},
+			}
+
+			daemonSet := newWaspAgentDaemonSet(hco)
+
+			env := daemonSet.Spec.Template.Spec.Containers[0].Env
+			Expect(env).To(ContainElements(
+				corev1.EnvVar{Name: "VERBOSITY", Value: "5"},
+				corev1.EnvVar{Name: "SWAP_UTILIZATION_THRESHOLD_FACTOR", Value: "0.8"},
+				corev1.EnvVar{Name: "MAX_AVERAGE_SWAP_IN_PAGES_PER_SECOND", Value: "1000"},
+				corev1.EnvVar{Name: "MAX_AVERAGE_SWAP_OUT_PAGES_PER_SECOND", Value: "2000"},
+				corev1.EnvVar{Name: "AVERAGE_WINDOW_SIZE_SECONDS", Value: "30"},
+			))
+			Expect(daemonSet.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{
+				"infra": "true",
+				"swap":  "enabled",
+			}))
+		})
+	})
+
+	Context("swap status", func() {
+		var (
+			readyNode *corev1.Node
+		)
+
+		BeforeEach(func() {
+			hco.Spec.Virtualization.HigherWorkloadDensity = &hcov1.HigherWorkloadDensityConfiguration{
+				MemoryOvercommitPercentage: 150,
+			}
+
+			readyNode = newNode("node01", corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue})
+		})
+
+		It("should report the swap readiness of the nodes", func() {
+			notReadyNode := newNode("node02", corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Message: "kubelet stopped posting node status"})
+			pressureNode := newNode("node03",
+				corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				corev1.NodeCondition{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionTrue},
+			)
+			agentNotReadyNode := newNode("node04", corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue})
+
+			cli := commontestutils.InitClient([]client.Object{
+				hco, readyNode, notReadyNode, pressureNode, agentNotReadyNode,
+				newWaspAgentPod("wasp-agent-d", "node04", false),
+				newWaspAgentPod("wasp-agent-c", "node03", true),
+				newWaspAgentPod("wasp-agent-b", "node02", true),
+				newWaspAgentPod("wasp-agent-a", "node01", true),
+				newWaspAgentPod("wasp-agent-pending", "", false),
+			})
+
+			handler := NewWaspAgentDaemonSetHandler(cli, commontestutils.GetScheme())
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.SwapNodes).To(HaveLen(4))
+
+			Expect(req.Instance.Status.SwapNodes[0].NodeName).To(Equal("node01"))
+			Expect(req.Instance.Status.SwapNodes[0].Ready).To(BeTrue())
+			Expect(req.Instance.Status.SwapNodes[0].Reason).To(Equal("Ready"))
+
+			Expect(req.Instance.Status.SwapNodes[1].NodeName).To(Equal("node02"))
+			Expect(req.Instance.Status.SwapNodes[1].Ready).To(BeFalse())
+			Expect(req.Instance.Status.SwapNodes[1].Reason).To(Equal("NodeNotReady"))
+			Expect(req.Instance.Status.SwapNodes[1].Message).To(ContainSubstring("kubelet stopped posting node status"))
+
+			Expect(req.Instance.Status.SwapNodes[2].NodeName).To(Equal("node03"))
+			Expect(req.Instance.Status.SwapNodes[2].Ready).To(BeFalse())
+			Expect(req.Instance.Status.SwapNodes[2].Reason).To(Equal("MemoryPressure"))
+
+			Expect(req.Instance.Status.SwapNodes[3].NodeName).To(Equal("node04"))
+			Expect(req.Instance.Status.SwapNodes[3].Ready).To(BeFalse())
+			Expect(req.Instance.Status.SwapNodes[3].Reason).To(Equal("AgentNotReady"))
+		})
+
+		It("should not update the status, if it was not changed", func() {
+			nodeStatuses := []hcov1.NodeSwapStatus{{NodeName: "node01", Ready: true, Reason: "Ready"}}
+			hco.Status.SwapNodes = nodeStatuses
+
+			setSwapNodesStatus(req, slices.Clone(nodeStatuses))
+			Expect(req.StatusDirty).To(BeFalse())
+
+			setSwapNodesStatus(req, nil)
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.SwapNodes).To(BeEmpty())
+		})
+
+		It("should clear the status when wasp-agent is not deployed", func() {
+			hco.Spec.Virtualization.HigherWorkloadDensity.MemoryOvercommitPercentage = 100
+			hco.Status.SwapNodes = []hcov1.NodeSwapStatus{{NodeName: "node01", Ready: true, Reason: "Ready"}}
+			cli := commontestutils.InitClient([]client.Object{hco, readyNode, newWaspAgentPod("wasp-agent-a", "node01", true)})
+
+			handler := NewWaspAgentDaemonSetHandler(cli, commontestutils.GetScheme())
+			Expect(handler.Ensure(req).Err).ToNot(HaveOccurred())
+
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.SwapNodes).To(BeEmpty())
+		})
+	})
+
+	Context("swap node changes", func() {
+		DescribeTable("SwapNodeConditionsChanged", func(oldConds, newConds []corev1.NodeCondition, expected bool) {
+			Expect(SwapNodeConditionsChanged(newNode("node01", oldConds...), newNode("node01", newConds...))).To(Equal(expected))
+		},
+			Entry("no change",
+				[]corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				[]corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				false,
+			),
+			Entry("only the heartbeat was changed",
+				[]corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue, LastHeartbeatTime: metav1.NewTime(time.Now().Add(-time.Minute))}},
+				[]corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue, LastHeartbeatTime: metav1.Now()}},
+				false,
+			),
+			Entry("an unrelated condition was changed",
+				[]corev1.NodeCondition{{Type: corev1.NodeDiskPressure, Status: corev1.ConditionFalse}},
+				[]corev1.NodeCondition{{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue}},
+				false,
+			),
+			Entry("the node entered memory pressure",
+				[]corev1.NodeCondition{{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse}},
+				[]corev1.NodeCondition{{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionTrue}},
+				true,
+			),
+			Entry("the memory pressure condition was added",
+				[]corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				[]corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}, {Type: corev1.NodeMemoryPressure, Status: corev1.ConditionTrue}},
+				true,
+			),
+			Entry("the node became not ready",
+				[]corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				[]corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionFalse}},
+				true,
+			),
+			Entry("the not-ready message was changed",
+				[]corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Message: "first"}},
+				[]corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Message: "second"}},
+				true,
+			),
+		)
+
+		It("should check if wasp-agent runs on the node", func(ctx context.Context) {
+			cli := commontestutils.InitClient([]client.Object{
+				newWaspAgentPod("wasp-agent-a", "node01", true),
+				newWaspAgentPod("wasp-agent-b", "node02", false),
+			})
+
+			Expect(IsWaspAgentNode(ctx, cli, "node01")).To(BeTrue())
+			Expect(IsWaspAgentNode(ctx, cli, "node02")).To(BeTrue())
+			Expect(IsWaspAgentNode(ctx, cli, "node03")).To(BeFalse())
+		})
+	})
+
 	Context("Wasp agent DaemonSet update", func() {
 		It("should update DaemonSet fields if not matched to the requirements", func() {
 			hco.Spec.Virtualization.HigherWorkloadDensity = &hcov1.HigherWorkloadDensityConfiguration{
@@ -239,6 +466,40 @@ var _ = Describe("Wasp Agent DaemonSet", func() {
 				To(Equal(originalDs.Spec.Template.Spec.Volumes))
 		})
 
+		It("should update the resources and the priority class from the HyperConverged CR", func() {
+			hco.Spec.Virtualization.HigherWorkloadDensity = &hcov1.HigherWorkloadDensityConfiguration{
+				MemoryOvercommitPercentage: 150,
+			}
+			existingDs := newWaspAgentDaemonSet(hco)
+			ds = commontestutils.InitClient([]client.Object{hco, existingDs})
+
+			resources := corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("100Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("200Mi"),
+				},
+			}
+			hco.Spec.Deployment.Components = &hcov1.ComponentsConfig{
+				WaspAgent: &hcov1.ComponentPodConfig{
+					Resources:         &resources,
+					PriorityClassName: "custom-priority-class",
+				},
+			}
+			handler := NewWaspAgentDaemonSetHandler(ds, commontestutils.GetScheme())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			reconciledDs := &appsv1.DaemonSet{}
+			Expect(ds.Get(context.Background(), client.ObjectKey{Name: res.Name, Namespace: hco.Namespace}, reconciledDs)).To(Succeed())
+
+			Expect(reconciledDs.Spec.Template.Spec.Containers[0].Resources).To(Equal(resources))
+			Expect(reconciledDs.Spec.Template.Spec.PriorityClassName).To(Equal("custom-priority-class"))
+		})
+
 		It("should reconcile labels if they are missing while preserving user labels", func() {
 			hco.Spec.Virtualization.HigherWorkloadDensity = &hcov1.HigherWorkloadDensityConfiguration{
 				MemoryOvercommitPercentage: 150,
@@ -267,3 +528,40 @@ var _ = Describe("Wasp Agent DaemonSet", func() {
 	})
 
 })
+
+func newNode(name string, conditions ...corev1.NodeCondition) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: corev1.NodeStatus{
+			Conditions: conditions,
+		},
+	}
+}
+
+func newWaspAgentPod(name, nodeName string, ready bool) *corev1.Pod {
+	readyStatus := corev1.ConditionFalse
+	phase := corev1.PodPending
+	if ready {
+		readyStatus = corev1.ConditionTrue
+		phase = corev1.PodRunning
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: commontestutils.Namespace,
+			Labels:    map[string]string{"name": AppComponentWaspAgent},
+		},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: readyStatus},
+			},
+		},
+	}
+}
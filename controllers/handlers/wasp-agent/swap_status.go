@@ -0,0 +1,193 @@
+package wasp_agent
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+)
+
+const (
+	swapReadyReason          = "Ready"
+	swapAgentNotReadyReason  = "AgentNotReady"
+	swapNodeNotFoundReason   = "NodeNotFound"
+	swapNodeNotReadyReason   = "NodeNotReady"
+	swapMemoryPressureReason = "MemoryPressure"
+)
+
+// waspAgentDaemonSetHandler deploys the wasp-agent DaemonSet, and reports the swap readiness of the nodes that run
+// wasp-agent, in the HyperConverged status
+type waspAgentDaemonSetHandler struct {
+	inner  *operands.ConditionalHandler
+	client client.Client
+}
+
+func (h *waspAgentDaemonSetHandler) Ensure(req *common.HcoRequest) *operands.EnsureResult {
+	result := h.inner.Ensure(req)
+	if result.Err != nil {
+		return result
+	}
+
+	if !shouldDeployWaspAgent(req.Instance) {
+		setSwapNodesStatus(req, nil)
+		return result
+	}
+
+	nodeStatuses, err := h.getSwapNodesStatus(req)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	setSwapNodesStatus(req, nodeStatuses)
+
+	return result
+}
+
+func (h *waspAgentDaemonSetHandler) Reset() {
+	h.inner.Reset()
+}
+
+func (h *waspAgentDaemonSetHandler) GetFullCr(hc *hcov1.HyperConverged) (client.Object, error) {
+	return h.inner.GetFullCr(hc)
+}
+
+func (h *waspAgentDaemonSetHandler) getSwapNodesStatus(req *common.HcoRequest) ([]hcov1.NodeSwapStatus, error) {
+	ds := NewWaspAgentWithNameOnly()
+
+	pods := &corev1.PodList{}
+	if err := h.client.List(req.Ctx, pods, client.InNamespace(ds.Namespace), client.MatchingLabels{"name": AppComponentWaspAgent}); err != nil {
+		return nil, err
+	}
+
+	var nodeStatuses []hcov1.NodeSwapStatus
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName == "" || pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		node := &corev1.Node{}
+		err := h.client.Get(req.Ctx, client.ObjectKey{Name: pod.Spec.NodeName}, node)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		nodeStatuses = append(nodeStatuses, getNodeSwapStatus(pod, node, err == nil))
+	}
+
+	slices.SortFunc(nodeStatuses, func(a, b hcov1.NodeSwapStatus) int {
+		return cmp.Compare(a.NodeName, b.NodeName)
+	})
+
+	return nodeStatuses, nil
+}
+
+func getNodeSwapStatus(pod *corev1.Pod, node *corev1.Node, nodeFound bool) hcov1.NodeSwapStatus {
+	status := hcov1.NodeSwapStatus{
+		NodeName: pod.Spec.NodeName,
+	}
+
+	if !isPodReady(pod) {
+		status.Reason = swapAgentNotReadyReason
+		status.Message = fmt.Sprintf("the %s pod is not ready; phase: %s", pod.Name, pod.Status.Phase)
+		return status
+	}
+
+	if !nodeFound {
+		status.Reason = swapNodeNotFoundReason
+		status.Message = "the node does not exist"
+		return status
+	}
+
+	if cond := getNodeCondition(node, corev1.NodeReady); cond == nil || cond.Status != corev1.ConditionTrue {
+		status.Reason = swapNodeNotReadyReason
+		status.Message = "the node is not ready"
+		if cond != nil && cond.Message != "" {
+			status.Message += "; " + cond.Message
+		}
+		return status
+	}
+
+	if cond := getNodeCondition(node, corev1.NodeMemoryPressure); cond != nil && cond.Status == corev1.ConditionTrue {
+		status.Reason = swapMemoryPressureReason
+		status.Message = "the node has memory pressure"
+		return status
+	}
+
+	status.Ready = true
+	status.Reason = swapReadyReason
+	status.Message = "wasp-agent is ready, and swap is available on the node"
+
+	return status
+}
+
+// swapNodeConditionTypes are the node conditions that affect the swap status of the node
+var swapNodeConditionTypes = []corev1.NodeConditionType{corev1.NodeReady, corev1.NodeMemoryPressure}
+
+// SwapNodeConditionsChanged returns true if a node condition that affects the swap status of the node was changed
+func SwapNodeConditionsChanged(oldNode, newNode *corev1.Node) bool {
+	for _, condType := range swapNodeConditionTypes {
+		oldCond, newCond := getNodeCondition(oldNode, condType), getNodeCondition(newNode, condType)
+		if (oldCond == nil) != (newCond == nil) {
+			return true
+		}
+
+		if oldCond != nil && (oldCond.Status != newCond.Status || oldCond.Message != newCond.Message) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsWaspAgentNode returns true if a wasp-agent pod runs on the node
+func IsWaspAgentNode(ctx context.Context, cli client.Reader, nodeName string) (bool, error) {
+	pods := &corev1.PodList{}
+	if err := cli.List(ctx, pods, client.InNamespace(NewWaspAgentWithNameOnly().Namespace), client.MatchingLabels{"name": AppComponentWaspAgent}); err != nil {
+		return false, err
+	}
+
+	return slices.ContainsFunc(pods.Items, func(pod corev1.Pod) bool {
+		return pod.Spec.NodeName == nodeName
+	}), nil
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func getNodeCondition(node *corev1.Node, condType corev1.NodeConditionType) *corev1.NodeCondition {
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type == condType {
+			return &node.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func setSwapNodesStatus(req *common.HcoRequest, nodeStatuses []hcov1.NodeSwapStatus) {
+	req.Lock()
+	defer req.Unlock()
+
+	if equality.Semantic.DeepEqual(req.Instance.Status.SwapNodes, nodeStatuses) {
+		return
+	}
+
+	req.Instance.Status.SwapNodes = nodeStatuses
+	req.StatusDirty = true
+}
@@ -0,0 +1,100 @@
+package hyperconverged
+
+import (
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	hcofg "github.com/kubevirt/hyperconverged-cluster-operator/api/v1/featuregates"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregatedetails"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregatepolicy"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregates"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+// enforceFeatureGatesPolicy removes the feature gates that the feature gates policy marks for removal, and reports
+// the enabled feature gates that the policy denies. The webhook only denies adding or enabling such feature gates, so
+// they may still be enabled; e.g. when a feature gate became deprecated in an upgrade.
+func (r *ReconcileHyperConverged) enforceFeatureGatesPolicy(req *common.HcoRequest) {
+	metrics.ResetFeatureGatePolicyViolations()
+
+	var toRemove []featuregatepolicy.Result
+	for _, res := range featuregatepolicy.Evaluate(req.Instance.Spec.FeatureGatesPolicy, req.Instance.Spec.FeatureGates) {
+		switch res.Action {
+		case hcov1.FeatureGatePolicyRemove:
+			toRemove = append(toRemove, res)
+		case hcov1.FeatureGatePolicyDeny:
+			req.Logger.Info("a feature gate that is not allowed by the feature gates policy is enabled", "featureGate", res.Name, "phase", res.Phase.String())
+			metrics.SetFeatureGatePolicyViolation(res.Name, res.Phase.String())
+		}
+	}
+
+	if len(toRemove) == 0 {
+		return
+	}
+
+	req.Instance.Spec.FeatureGates = slices.DeleteFunc(req.Instance.Spec.FeatureGates, func(fg hcofg.FeatureGate) bool {
+		return slices.ContainsFunc(toRemove, func(res featuregatepolicy.Result) bool {
+			return res.Name == fg.Name
+		})
+	})
+	req.Dirty = true
+
+	for _, res := range toRemove {
+		req.Logger.Info("removing a feature gate, according to the feature gates policy", "featureGate", res.Name, "phase", res.Phase.String())
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "FeatureGateRemoved",
+			fmt.Sprintf("Removed the %s feature gate (%s), according to spec.featureGatesPolicy", res.Name, res.Phase))
+	}
+}
+
+// updateFeatureGatesStatus publishes the effective state of the feature gates in the HyperConverged status
+func updateFeatureGatesStatus(req *common.HcoRequest) {
+	fgStatuses := getFeatureGatesStatus(req.Instance)
+
+	if !equality.Semantic.DeepEqual(fgStatuses, req.Instance.Status.FeatureGates) {
+		req.Instance.Status.FeatureGates = fgStatuses
+		req.StatusDirty = true
+	}
+}
+
+func getFeatureGatesStatus(hc *hcov1.HyperConverged) []hcov1.FeatureGateStatus {
+	knownFGs := featuregatedetails.ListFeatureGates()
+	fgStatuses := make([]hcov1.FeatureGateStatus, 0, len(knownFGs))
+
+	for _, fg := range knownFGs {
+		fgStatuses = append(fgStatuses, getFeatureGateStatus(hc, fg.Name, fg.Phase))
+	}
+
+	// report the unknown feature gates as well, to make it clear that they are ignored
+	for _, fg := range hc.Spec.FeatureGates {
+		if _, exists := featuregatedetails.GetFeatureGatePhase(fg.Name); !exists {
+			fgStatuses = append(fgStatuses, getFeatureGateStatus(hc, fg.Name, featuregates.PhaseUnknown))
+		}
+	}
+
+	return fgStatuses
+}
+
+func getFeatureGateStatus(hc *hcov1.HyperConverged, fgName string, phase featuregates.Phase) hcov1.FeatureGateStatus {
+	state := hcofg.Disabled
+	if hc.Spec.FeatureGates.IsEnabled(fgName) {
+		state = hcofg.Enabled
+	}
+
+	kvFGsWhenEnabled, kvFGsWhenDisabled := handlers.GetKvFeatureGatesOf(fgName)
+
+	return hcov1.FeatureGateStatus{
+		Name:                             fgName,
+		Phase:                            phase.String(),
+		State:                            state,
+		Defaulted:                        hc.Spec.FeatureGates.Index(fgName) < 0,
+		KubeVirtFeatureGates:             kvFGsWhenEnabled,
+		KubeVirtFeatureGatesWhenDisabled: kvFGsWhenDisabled,
+		CDIFeatureGates:                  handlers.GetCDIFeatureGatesOf(fgName),
+	}
+}
@@ -0,0 +1,195 @@
+package hyperconverged
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	hcofg "github.com/kubevirt/hyperconverged-cluster-operator/api/v1/featuregates"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregatedetails"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+var _ = Describe("test the feature gates status", func() {
+	findFG := func(fgs []hcov1.FeatureGateStatus, name string) *hcov1.FeatureGateStatus {
+		GinkgoHelper()
+		for i := range fgs {
+			if fgs[i].Name == name {
+				return &fgs[i]
+			}
+		}
+		Fail("feature gate " + name + " was not found in the status")
+		return nil
+	}
+
+	It("should report all the known feature gates", func() {
+		hco := commontestutils.NewHco()
+		req := commontestutils.NewReq(hco)
+
+		updateFeatureGatesStatus(req)
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.FeatureGates).To(HaveLen(len(featuregatedetails.ListFeatureGates())))
+
+		for _, fg := range hco.Status.FeatureGates {
+			Expect(fg.Defaulted).To(BeTrue())
+		}
+	})
+
+	It("should report the default state, according to the phase", func() {
+		hco := commontestutils.NewHco()
+		req := commontestutils.NewReq(hco)
+
+		updateFeatureGatesStatus(req)
+
+		Expect(*findFG(hco.Status.FeatureGates, "declarativeHotplugVolumes")).To(gstruct.MatchAllFields(gstruct.Fields{
+			"Name":                             Equal("declarativeHotplugVolumes"),
+			"Phase":                            Equal("beta"),
+			"State":                            Equal(hcofg.Enabled),
+			"Defaulted":                        BeTrue(),
+			"KubeVirtFeatureGates":             ConsistOf("DeclarativeHotplugVolumes"),
+			"KubeVirtFeatureGatesWhenDisabled": ConsistOf("HotplugVolumes"),
+			"CDIFeatureGates":                  BeEmpty(),
+		}))
+
+		Expect(*findFG(hco.Status.FeatureGates, "alignCPUs")).To(gstruct.MatchAllFields(gstruct.Fields{
+			"Name":                             Equal("alignCPUs"),
+			"Phase":                            Equal("alpha"),
+			"State":                            Equal(hcofg.Disabled),
+			"Defaulted":                        BeTrue(),
+			"KubeVirtFeatureGates":             ConsistOf("AlignCPUs"),
+			"KubeVirtFeatureGatesWhenDisabled": BeEmpty(),
+			"CDIFeatureGates":                  BeEmpty(),
+		}))
+
+		Expect(*findFG(hco.Status.FeatureGates, "persistentReservation")).To(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+			"Phase":                Equal("deprecated"),
+			"State":                Equal(hcofg.Disabled),
+			"KubeVirtFeatureGates": BeEmpty(),
+		}))
+	})
+
+	It("should report the explicitly set feature gates", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.FeatureGates = hcofg.HyperConvergedFeatureGates{
+			{Name: "incrementalBackup", State: new(hcofg.Enabled)},
+			{Name: "declarativeHotplugVolumes", State: new(hcofg.Disabled)},
+		}
+		req := commontestutils.NewReq(hco)
+
+		updateFeatureGatesStatus(req)
+
+		incrementalBackup := findFG(hco.Status.FeatureGates, "incrementalBackup")
+		Expect(incrementalBackup.State).To(Equal(hcofg.Enabled))
+		Expect(incrementalBackup.Defaulted).To(BeFalse())
+		Expect(incrementalBackup.KubeVirtFeatureGates).To(ConsistOf("IncrementalBackup", "UtilityVolumes"))
+
+		declarativeHotplugVolumes := findFG(hco.Status.FeatureGates, "declarativeHotplugVolumes")
+		Expect(declarativeHotplugVolumes.State).To(Equal(hcofg.Disabled))
+		Expect(declarativeHotplugVolumes.Defaulted).To(BeFalse())
+		Expect(declarativeHotplugVolumes.KubeVirtFeatureGatesWhenDisabled).To(ConsistOf("HotplugVolumes"))
+	})
+
+	It("should report the unknown feature gates", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.FeatureGates = hcofg.HyperConvergedFeatureGates{
+			{Name: "notExistingFeatureGate", State: new(hcofg.Enabled)},
+		}
+		req := commontestutils.NewReq(hco)
+
+		updateFeatureGatesStatus(req)
+
+		Expect(hco.Status.FeatureGates).To(HaveLen(len(featuregatedetails.ListFeatureGates()) + 1))
+		Expect(hco.Status.FeatureGates[len(hco.Status.FeatureGates)-1]).To(Equal(hcov1.FeatureGateStatus{
+			Name:  "notExistingFeatureGate",
+			Phase: "unknown",
+			State: hcofg.Disabled,
+		}))
+	})
+
+	It("should not modify the status if nothing was changed", func() {
+		hco := commontestutils.NewHco()
+		hco.Status.FeatureGates = getFeatureGatesStatus(hco)
+		req := commontestutils.NewReq(hco)
+
+		updateFeatureGatesStatus(req)
+		Expect(req.StatusDirty).To(BeFalse())
+	})
+})
+
+var _ = Describe("test the feature gates policy", func() {
+	It("should do nothing for the default policy", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.FeatureGates = hcofg.HyperConvergedFeatureGates{
+			{Name: "alignCPUs"},
+			{Name: "persistentReservation"},
+		}
+		req := commontestutils.NewReq(hco)
+		eventEmitter := commontestutils.NewEventEmitterMock()
+		r := &ReconcileHyperConverged{eventEmitter: eventEmitter}
+
+		r.enforceFeatureGatesPolicy(req)
+
+		Expect(req.Dirty).To(BeFalse())
+		Expect(hco.Spec.FeatureGates).To(HaveLen(2))
+		Expect(eventEmitter.CheckNoEventEmitted()).To(BeTrue())
+		Expect(metrics.IsFeatureGatePolicyViolated("alignCPUs", "alpha")).To(BeFalse())
+	})
+
+	It("should remove the feature gates according to the policy, and emit an event", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.FeatureGates = hcofg.HyperConvergedFeatureGates{
+			{Name: "alignCPUs"},
+			{Name: "persistentReservation", State: new(hcofg.Disabled)},
+			{Name: "decentralizedLiveMigration"},
+		}
+		hco.Spec.FeatureGatesPolicy = &hcov1.FeatureGatesPolicy{
+			Deprecated: hcov1.FeatureGatePolicyRemove,
+		}
+		req := commontestutils.NewReq(hco)
+		eventEmitter := commontestutils.NewEventEmitterMock()
+		r := &ReconcileHyperConverged{eventEmitter: eventEmitter}
+
+		r.enforceFeatureGatesPolicy(req)
+
+		Expect(req.Dirty).To(BeTrue())
+		Expect(hco.Spec.FeatureGates).To(Equal(hcofg.HyperConvergedFeatureGates{
+			{Name: "alignCPUs"},
+			{Name: "decentralizedLiveMigration"},
+		}))
+		Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+			{
+				EventType: corev1.EventTypeNormal,
+				Reason:    "FeatureGateRemoved",
+				Msg:       "Removed the persistentReservation feature gate (deprecated), according to spec.featureGatesPolicy",
+			},
+		})).To(BeTrue())
+	})
+
+	It("should report the enabled feature gates that are denied by the policy", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.FeatureGates = hcofg.HyperConvergedFeatureGates{
+			{Name: "alignCPUs"},
+			{Name: "downwardMetrics", State: new(hcofg.Disabled)},
+		}
+		hco.Spec.FeatureGatesPolicy = &hcov1.FeatureGatesPolicy{
+			NoAlphaFeatureGates: true,
+		}
+		req := commontestutils.NewReq(hco)
+		eventEmitter := commontestutils.NewEventEmitterMock()
+		r := &ReconcileHyperConverged{eventEmitter: eventEmitter}
+
+		r.enforceFeatureGatesPolicy(req)
+
+		Expect(req.Dirty).To(BeFalse())
+		Expect(hco.Spec.FeatureGates).To(HaveLen(2))
+		Expect(metrics.IsFeatureGatePolicyViolated("alignCPUs", "alpha")).To(BeTrue())
+		Expect(metrics.IsFeatureGatePolicyViolated("downwardMetrics", "alpha")).To(BeFalse())
+
+		hco.Spec.FeatureGatesPolicy = nil
+		r.enforceFeatureGatesPolicy(req)
+		Expect(metrics.IsFeatureGatePolicyViolated("alignCPUs", "alpha")).To(BeFalse())
+	})
+})
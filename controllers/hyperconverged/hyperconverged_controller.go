@@ -7,6 +7,7 @@ import (
 	"os"
 	"reflect"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/blang/semver/v4"
@@ -42,6 +43,7 @@ import (
 
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	migrationv1alpha1 "kubevirt.io/kubevirt-migration-operator/api/v1alpha1"
@@ -51,6 +53,9 @@ import (
 	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/alerts"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/aie"
+	waspagent "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/wasp-agent"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/ingresscluster"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operandhandler"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/reqresolver"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
@@ -82,6 +87,7 @@ const (
 	commonProgressingReason     = "HCOProgressing"
 	taintedConfigurationReason  = "UnsupportedFeatureAnnotation"
 	taintedConfigurationMessage = "Unsupported feature was activated via an HCO annotation"
+	operandsPausedReason        = "OperandsPaused"
 	systemHealthStatusHealthy   = "healthy"
 	systemHealthStatusWarning   = "warning"
 	systemHealthStatusError     = "error"
@@ -125,16 +131,23 @@ func newReconciler(mgr manager.Manager, ci hcoutil.ClusterInfo, upgradeableCond
 
 	pwdFS = os.DirFS(pwd)
 
+	// the tuning recommender and the live migration profiles counter share the same sample of the VMIs
+	sampler := newVMISampler()
+
 	r := &ReconcileHyperConverged{
 		client:               mgr.GetClient(),
+		apiReader:            mgr.GetAPIReader(),
 		scheme:               mgr.GetScheme(),
-		operandHandler:       operandhandler.NewOperandHandler(mgr.GetClient(), mgr.GetScheme(), ci, hcoutil.GetEventEmitter()),
+		operandHandler:       operandhandler.NewOperandHandler(mgr.GetClient(), mgr.GetAPIReader(), mgr.GetScheme(), ci, hcoutil.GetEventEmitter()),
 		upgradeMode:          false,
 		ownVersion:           ownresources.Version(),
 		eventEmitter:         hcoutil.GetEventEmitter(),
 		firstLoop:            true,
 		upgradeableCondition: upgradeableCond,
 		pwdFS:                pwdFS,
+		preflightChecker:     newDefaultPreflightChecker(),
+		tuningRecommender:    newTuningRecommender(sampler),
+		migrationPolicies:    newMigrationPolicyCounter(sampler),
 	}
 
 	if ci.IsMonitoringAvailable() {
@@ -174,6 +187,7 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo, in
 		&networkaddonsv1.NetworkAddonsConfig{},
 		&aaqv1alpha1.AAQ{},
 		&migrationv1alpha1.MigController{},
+		&migrationsv1alpha1.MigrationPolicy{},
 		&vmfr.FileRestoreOperator{},
 		&schedulingv1.PriorityClass{},
 		&corev1.ConfigMap{},
@@ -207,6 +221,11 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo, in
 			&appsv1.Deployment{},
 			&securityv1.SecurityContextConstraints{},
 		}...)
+	} else {
+		secondaryResources = append(secondaryResources, []client.Object{
+			&networkingv1.Ingress{},
+			&cdiv1beta1.DataImportCron{},
+		}...)
 	}
 
 	// Watch secondary resources
@@ -265,6 +284,47 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo, in
 		}
 	}
 
+	// the swap status of the nodes that run wasp-agent depends on the node conditions, and the nodes are not owned
+	// by HCO, so they are watched directly
+	err = c.Watch(
+		source.Kind(mgr.GetCache(), &corev1.Node{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, node *corev1.Node) []reconcile.Request {
+				isWaspAgentNode, err := waspagent.IsWaspAgentNode(ctx, mgr.GetClient(), node.Name)
+				if err != nil {
+					log.Error(err, "failed to check if wasp-agent runs on the node", "node", node.Name)
+					return nil
+				}
+
+				if !isWaspAgentNode {
+					return nil
+				}
+
+				log.Info("Reconciling for a condition change of a wasp-agent node", "node", node.Name)
+				return []reconcile.Request{
+					reqresolver.GetSecondaryCRRequest(),
+				}
+			}),
+			swapNodeConditionsPredicate{},
+		))
+	if err != nil {
+		return err
+	}
+
+	err = c.Watch(
+		source.Channel(
+			aie.DigestResolvedEvents(),
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+				// the digest of an AIE rule image was resolved in the background; report it in the status
+				log.Info("Reconciling for a resolved AIE rule image digest")
+				return []reconcile.Request{
+					reqresolver.GetSecondaryCRRequest(),
+				}
+			}),
+		))
+	if err != nil {
+		return err
+	}
+
 	err = c.Watch(
 		source.Channel(
 			nodeEventChannel,
@@ -291,7 +351,10 @@ var _ reconcile.Reconciler = &ReconcileHyperConverged{}
 type ReconcileHyperConverged struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
-	client               client.Client
+	client client.Client
+	// apiReader reads directly from the apiserver, for objects that are not cached, like VMIs
+	apiReader client.Reader
+
 	scheme               *runtime.Scheme
 	operandHandler       *operandhandler.OperandHandler
 	upgradeMode          bool
@@ -301,6 +364,12 @@ type ReconcileHyperConverged struct {
 	upgradeableCondition hcoutil.Condition
 	monitoringReconciler *alerts.MonitoringReconciler
 	pwdFS                fs.FS
+	preflightChecker     *preflightChecker
+	tuningRecommender    *tuningRecommender
+	migrationPolicies    *migrationPolicyCounter
+
+	// uninstallBlockedLastCheck is the last time the workloads that block the uninstallation were listed
+	uninstallBlockedLastCheck time.Time
 }
 
 // Reconcile reads that state of the cluster for a HyperConverged object and makes changes based on the state read
@@ -389,6 +458,11 @@ func (r *ReconcileHyperConverged) doReconcile(req *common.HcoRequest) (reconcile
 	r.setLabels(req)
 
 	updateStatus(req)
+	r.enforceFeatureGatesPolicy(req)
+	updateFeatureGatesStatus(req)
+	r.tuningRecommender.update(req, r.apiReader)
+	updateEffectiveTuningStatus(req)
+	r.migrationPolicies.update(req, r.apiReader)
 
 	metrics.SetHCOMetricMemoryOvercommitPercentage(
 		getMemoryOvercommitPercentage(req.Instance.Spec.Virtualization.HigherWorkloadDensity),
@@ -410,6 +484,10 @@ func (r *ReconcileHyperConverged) doReconcile(req *common.HcoRequest) (reconcile
 
 	applyDataImportSchedule(req)
 
+	if !hcoutil.GetClusterInfo().IsOpenshift() {
+		ingresscluster.SetStandaloneDownloadHost(req.Instance)
+	}
+
 	// If the current version is not updated in CR ,then we're updating. This is also works when updating from
 	// an old version, since Status.Versions will be empty.
 	knownHcoVersion, _ := GetVersion(&req.Instance.Status, hcoVersionName)
@@ -435,6 +513,11 @@ func (r *ReconcileHyperConverged) doReconcile(req *common.HcoRequest) (reconcile
 }
 
 func (r *ReconcileHyperConverged) handleUpgrade(req *common.HcoRequest) (*reconcile.Result, error) {
+	if err := r.ensureUpgradeJournal(req); err != nil {
+		req.Logger.Error(err, "failed to record the upgrade journal")
+		return &reconcile.Result{RequeueAfter: requeueAfter}, err
+	}
+
 	modified, err := r.migrateBeforeUpgrade(req)
 	if err != nil {
 		return &reconcile.Result{RequeueAfter: requeueAfter}, err
@@ -457,6 +540,13 @@ func (r *ReconcileHyperConverged) EnsureOperandAndComplete(req *common.HcoReques
 		return reconcile.Result{RequeueAfter: requeue}, nil
 	}
 
+	updateEffectiveOverrides(req)
+
+	if err := r.reconcilePlan(req); err != nil {
+		req.Logger.Error(err, "failed to generate the plan report")
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "PlanFailed", err.Error())
+	}
+
 	req.Logger.Info("Reconcile complete")
 
 	// Requeue if we just created everything
@@ -466,7 +556,24 @@ func (r *ReconcileHyperConverged) EnsureOperandAndComplete(req *common.HcoReques
 
 	r.completeReconciliation(req)
 
-	return reconcile.Result{}, nil
+	requeue := earliestRequeue(
+		r.preflightChecker.requeueAfter(),
+		r.tuningRecommender.requeueAfter(req.Instance),
+		r.migrationPolicies.requeueAfter(req.Instance),
+	)
+
+	return reconcile.Result{RequeueAfter: requeue}, nil
+}
+
+// earliestRequeue returns the shortest of the requeue durations, ignoring the zero ones; zero means no requeue
+func earliestRequeue(durations ...time.Duration) time.Duration {
+	var requeue time.Duration
+	for _, d := range durations {
+		if d > 0 && (requeue == 0 || d < requeue) {
+			requeue = d
+		}
+	}
+	return requeue
 }
 
 func updateStatus(req *common.HcoRequest) {
@@ -653,6 +760,10 @@ func (r *ReconcileHyperConverged) setInitialConditions(req *common.HcoRequest) {
 }
 
 func (r *ReconcileHyperConverged) ensureHcoDeleted(req *common.HcoRequest) (reconcile.Result, error) {
+	if err := r.backupWorkloads(req); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	err := r.operandHandler.EnsureDeleted(req)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -877,6 +988,8 @@ func (r *ReconcileHyperConverged) aggregateComponentConditions(req *common.HcoRe
 
 func (r *ReconcileHyperConverged) completeReconciliation(req *common.HcoRequest) {
 	allComponentsAreUp := r.aggregateComponentConditions(req)
+	r.checkUpgradePreflight(req)
+	r.reportUninstallBlocked(req)
 
 	hcoReady := false
 
@@ -886,7 +999,9 @@ func (r *ReconcileHyperConverged) completeReconciliation(req *common.HcoRequest)
 		// if in upgrade mode, and all the components are upgraded, and nothing pending to be written - upgrade is completed
 		if r.upgradeMode && req.ComponentUpgradeInProgress && !req.Dirty {
 			// update the new version only when upgrade is completed
+			knownHcoVersion, _ := GetVersion(&req.Instance.Status, hcoVersionName)
 			UpdateVersion(&req.Instance.Status, hcoVersionName, r.ownVersion)
+			setUpgradeJournal(&req.Instance.Status, hcoVersionName, getUpgradeJournalName(knownHcoVersion, r.ownVersion))
 			req.StatusDirty = true
 
 			r.upgradeMode = false
@@ -953,6 +1068,9 @@ func (r *ReconcileHyperConverged) updateConditions(req *common.HcoRequest) {
 	// Detect a "TaintedConfiguration" state, and raise a corresponding event
 	r.detectTaintedConfiguration(req, &conditions)
 
+	// Detect paused operands
+	detectPausedOperands(req, &conditions)
+
 	if !reflect.DeepEqual(conditions, req.Instance.Status.Conditions) {
 		req.Instance.Status.Conditions = conditions
 		req.StatusDirty = true
@@ -1020,6 +1138,28 @@ func (r *ReconcileHyperConverged) detectTaintedConfiguration(req *common.HcoRequ
 	}
 }
 
+func detectPausedOperands(req *common.HcoRequest, conditions *[]metav1.Condition) {
+	var paused []string
+	for _, component := range req.Instance.Status.Components {
+		if component.Paused {
+			paused = append(paused, component.Kind)
+		}
+	}
+
+	if len(paused) == 0 {
+		apimetav1.RemoveStatusCondition(conditions, hcov1.ConditionOperandsPaused)
+		return
+	}
+
+	apimetav1.SetStatusCondition(conditions, metav1.Condition{
+		Type:               hcov1.ConditionOperandsPaused,
+		Status:             metav1.ConditionTrue,
+		Reason:             operandsPausedReason,
+		Message:            fmt.Sprintf("The reconciliation of the following operands is paused: %s", strings.Join(paused, ", ")),
+		ObservedGeneration: req.Instance.Generation,
+	})
+}
+
 func (r *ReconcileHyperConverged) getSystemHealthStatus(req *common.HcoRequest) string {
 	if isSystemHealthStatusError(req) {
 		return systemHealthStatusError
@@ -1083,44 +1223,42 @@ func (r *ReconcileHyperConverged) firstLoopInitialization(request *common.HcoReq
 	r.firstLoop = false
 }
 
+// setOperatorUpgradeableStatus sets the Upgradeable operator condition; in the OperatorCondition CR when managed by
+// OLM, or in the operator conditions ConfigMap otherwise.
 func (r *ReconcileHyperConverged) setOperatorUpgradeableStatus(request *common.HcoRequest) error {
-	if hcoutil.GetClusterInfo().IsManagedByOLM() {
-
-		upgradeable := !r.upgradeMode && request.Upgradeable
+	upgradeable := !r.upgradeMode && request.Upgradeable
 
-		request.Logger.Info("setting the Upgradeable operator condition", requestedStatusKey, upgradeable)
+	request.Logger.Info("setting the Upgradeable operator condition", requestedStatusKey, upgradeable)
 
-		msg := hcoutil.UpgradeableAllowMessage
-		status := metav1.ConditionTrue
-		reason := hcoutil.UpgradeableAllowReason
+	msg := hcoutil.UpgradeableAllowMessage
+	status := metav1.ConditionTrue
+	reason := hcoutil.UpgradeableAllowReason
 
-		if !upgradeable {
-			status = metav1.ConditionFalse
+	if !upgradeable {
+		status = metav1.ConditionFalse
 
-			if r.upgradeMode {
-				msg = hcoutil.UpgradeableUpgradingMessage + r.ownVersion
-				reason = hcoutil.UpgradeableUpgradingReason
-			} else {
-				condition, found := request.Conditions.GetCondition(hcov1.ConditionUpgradeable)
-				if found && condition.Status == metav1.ConditionFalse {
-					reason = condition.Reason
-					msg = condition.Message
-				}
+		if r.upgradeMode {
+			msg = hcoutil.UpgradeableUpgradingMessage + r.ownVersion
+			reason = hcoutil.UpgradeableUpgradingReason
+		} else {
+			condition, found := request.Conditions.GetCondition(hcov1.ConditionUpgradeable)
+			if found && condition.Status == metav1.ConditionFalse {
+				reason = condition.Reason
+				msg = condition.Message
 			}
 		}
+	}
 
-		if err := r.upgradeableCondition.Set(request.Ctx, status, reason, msg); err != nil {
-			request.Logger.Error(err, "can't set the Upgradeable operator condition", requestedStatusKey, upgradeable)
-			return err
-		}
-
+	if err := r.upgradeableCondition.Set(request.Ctx, status, reason, msg); err != nil {
+		request.Logger.Error(err, "can't set the Upgradeable operator condition", requestedStatusKey, upgradeable)
+		return err
 	}
 
 	return nil
 }
 
 func (r *ReconcileHyperConverged) migrateBeforeUpgrade(req *common.HcoRequest) (bool, error) {
-	changed, err := removeWrongJsonPatch(req.Instance)
+	changed, err := upgradepatch.RemoveWrongJSONPatch(req.Instance)
 	if err != nil {
 		return false, err
 	}
@@ -1148,10 +1286,7 @@ func (r *ReconcileHyperConverged) applyUpgradePatches(req *common.HcoRequest) (b
 	modified := false
 
 	knownHcoVersion, _ := GetVersion(&req.Instance.Status, hcoVersionName)
-	if knownHcoVersion == "" {
-		knownHcoVersion = "0.0.0"
-	}
-	knownHcoSV, err := semver.ParseTolerant(knownHcoVersion)
+	knownHcoSV, err := getKnownHcoSemver(knownHcoVersion)
 	if err != nil {
 		req.Logger.Error(err, "Error!")
 		return false, err
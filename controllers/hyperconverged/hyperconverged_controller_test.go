@@ -19,6 +19,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -28,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	sspv1beta3 "kubevirt.io/ssp-operator/api/v1beta3"
 
@@ -42,6 +44,7 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
 	fakeownresources "github.com/kubevirt/hyperconverged-cluster-operator/pkg/ownresources/fake"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/tlssecprofile"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/uninstall"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 	"github.com/kubevirt/hyperconverged-cluster-operator/version"
 )
@@ -411,6 +414,55 @@ var _ = Describe("HyperconvergedController", func() {
 				Expect(latestHCO.Status.RelatedObjects).To(ContainElement(*kubevirtRef))
 			})
 
+			It("should restore a modified or deleted migration policy", func() {
+
+				expected := getBasicDeployment()
+				expected.hco.Spec.Virtualization.MigrationPolicies = []hcov1.MigrationPolicyProfile{
+					{
+						Name:                  "bulk",
+						VMSelector:            map[string]string{"workload": "bulk"},
+						BandwidthPerMigration: new("64Mi"),
+					},
+				}
+				cl := expected.initClient()
+				r := initReconciler(cl, nil)
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				policyKey := types.NamespacedName{Name: "hco-bulk"}
+				policy := &migrationsv1alpha1.MigrationPolicy{}
+				Expect(cl.Get(context.TODO(), policyKey, policy)).To(Succeed())
+				Expect(policy.Spec.BandwidthPerMigration).To(HaveValue(Equal(resource.MustParse("64Mi"))))
+
+				// modify the policy, as if it was changed by someone else
+				policy.Spec.BandwidthPerMigration = new(resource.MustParse("1Gi"))
+				policy.Spec.Selectors.VirtualMachineInstanceSelector = nil
+				Expect(cl.Update(context.TODO(), policy)).To(Succeed())
+
+				// mock a reconciliation triggered by a change in the migration policy
+				_, err = r.Reconcile(context.TODO(), reqresolver.GetSecondaryCRRequest())
+				Expect(err).ToNot(HaveOccurred())
+
+				policy = &migrationsv1alpha1.MigrationPolicy{}
+				Expect(cl.Get(context.TODO(), policyKey, policy)).To(Succeed())
+				Expect(policy.Spec.BandwidthPerMigration).To(HaveValue(Equal(resource.MustParse("64Mi"))))
+				Expect(policy.Spec.Selectors.VirtualMachineInstanceSelector).To(HaveKeyWithValue("workload", "bulk"))
+
+				// delete the policy
+				Expect(cl.Delete(context.TODO(), policy)).To(Succeed())
+				Expect(cl.Get(context.TODO(), policyKey, &migrationsv1alpha1.MigrationPolicy{})).To(MatchError(apierrors.IsNotFound, "IsNotFound"))
+
+				// mock a reconciliation triggered by the deletion of the migration policy
+				_, err = r.Reconcile(context.TODO(), reqresolver.GetSecondaryCRRequest())
+				Expect(err).ToNot(HaveOccurred())
+
+				policy = &migrationsv1alpha1.MigrationPolicy{}
+				Expect(cl.Get(context.TODO(), policyKey, policy)).To(Succeed())
+				Expect(policy.Spec.BandwidthPerMigration).To(HaveValue(Equal(resource.MustParse("64Mi"))))
+				Expect(policy.Spec.Selectors.VirtualMachineInstanceSelector).To(HaveKeyWithValue("workload", "bulk"))
+			})
+
 			It("should update APIVersion of objects in relatedObjects", func() {
 
 				expected := getBasicDeployment()
@@ -2619,6 +2671,165 @@ var _ = Describe("HyperconvergedController", func() {
 			})
 		})
 
+		Context("Detection of paused operands", func() {
+			It("should raise the OperandsPaused condition while operands are paused, and remove it once resumed", func() {
+				hco := commontestutils.NewHco()
+				UpdateVersion(&hco.Status, hcoVersionName, version.Version)
+				hco.Spec.Deployment.PausedOperands = []hcov1.OperandKind{hcov1.OperandKindKubeVirt, hcov1.OperandKindCDI}
+
+				cl := commontestutils.InitClient([]client.Object{commontestutils.NewHcoNamespace(), hco, commontestutils.GetCSV()})
+				r := initReconciler(cl, nil)
+
+				// the first reconciliation only initializes the HyperConverged status
+				for range 2 {
+					_, err := r.Reconcile(context.TODO(), request)
+					Expect(err).ToNot(HaveOccurred())
+				}
+
+				foundResource := &hcov1.HyperConverged{}
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(hco), foundResource)).To(Succeed())
+
+				Expect(foundResource.Status.Conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+					Type:    hcov1.ConditionOperandsPaused,
+					Status:  metav1.ConditionTrue,
+					Reason:  operandsPausedReason,
+					Message: "The reconciliation of the following operands is paused: KubeVirt, CDI",
+				})))
+
+				foundResource.Spec.Deployment.PausedOperands = nil
+				Expect(cl.Update(context.TODO(), foundResource)).To(Succeed())
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(hco), foundResource)).To(Succeed())
+				Expect(apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1.ConditionOperandsPaused)).To(BeNil())
+			})
+		})
+
+		Context("Uninstall workloads", func() {
+			It("should report the blocking workloads in the UninstallBlocked condition", func() {
+				expected := getBasicDeployment()
+				expected.hco.Spec.Deployment.UninstallStrategy = hcov1.HyperConvergedUninstallStrategyBlockUninstallAndReportWorkloads
+				cl := expected.initClient()
+				Expect(cl.Create(context.TODO(), &kubevirtcorev1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "ns1"}})).To(Succeed())
+
+				foundResource, r, _ := doReconcile(cl, expected.hco, nil)
+
+				Expect(foundResource.Status.Conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+					Type:    hcov1.ConditionUninstallBlocked,
+					Status:  metav1.ConditionTrue,
+					Reason:  uninstallBlockedReason,
+					Message: "The following workloads block the uninstallation: namespace ns1: VirtualMachines (1): vm1",
+				})))
+
+				By("change the uninstall strategy")
+				foundResource.Spec.Deployment.UninstallStrategy = hcov1.HyperConvergedUninstallStrategyBlockUninstallIfWorkloadsExist
+				Expect(cl.Update(context.TODO(), foundResource)).To(Succeed())
+
+				foundResource, _, _ = doReconcile(cl, foundResource, r)
+				Expect(apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1.ConditionUninstallBlocked)).To(BeNil())
+			})
+
+			It("should set the UninstallBlocked condition to false, if there are no workloads", func() {
+				expected := getBasicDeployment()
+				expected.hco.Spec.Deployment.UninstallStrategy = hcov1.HyperConvergedUninstallStrategyBlockUninstallAndReportWorkloads
+				cl := expected.initClient()
+
+				foundResource, _, _ := doReconcile(cl, expected.hco, nil)
+
+				Expect(foundResource.Status.Conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+					Type:    hcov1.ConditionUninstallBlocked,
+					Status:  metav1.ConditionFalse,
+					Reason:  uninstallNotBlockedReason,
+					Message: "No workloads block the uninstallation",
+				})))
+			})
+
+			It("should back up the workloads before removing them", func() {
+				expected := getBasicDeployment()
+				expected.hco.Spec.Deployment.UninstallStrategy = hcov1.HyperConvergedUninstallStrategyRemoveWorkloads
+				expected.hco.Spec.Deployment.UninstallBackup = &hcov1.UninstallBackupConfig{Enable: new(true)}
+				cl := expected.initClient()
+				Expect(cl.Create(context.TODO(), &kubevirtcorev1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "ns1"}})).To(Succeed())
+
+				r := initReconciler(cl, nil)
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(cl.Delete(context.TODO(), expected.hco)).To(Succeed())
+
+				_, err = r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				index := &corev1.Secret{}
+				Expect(cl.Get(context.TODO(), client.ObjectKey{Name: uninstall.BackupIndexName, Namespace: expected.hco.Namespace}, index)).To(Succeed())
+				Expect(index.Data).To(HaveKeyWithValue(uninstall.BackupIndexKey, []byte("kubevirt-hyperconverged-workloads-backup-ns1-0")))
+
+				kvList := &kubevirtcorev1.KubeVirtList{}
+				Expect(cl.List(context.TODO(), kvList)).To(Succeed())
+				Expect(kvList.Items).To(BeEmpty(), "The KubeVirt object should be deleted")
+			})
+
+			It("should not remove the operands if the backup fails", func() {
+				expected := getBasicDeployment()
+				expected.hco.Spec.Deployment.UninstallStrategy = hcov1.HyperConvergedUninstallStrategyRemoveWorkloads
+				expected.hco.Spec.Deployment.UninstallBackup = &hcov1.UninstallBackupConfig{Enable: new(true)}
+				cl := expected.initClient()
+				Expect(cl.Create(context.TODO(), &kubevirtcorev1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "ns1"}})).To(Succeed())
+
+				r := initReconciler(cl, nil)
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(cl.Delete(context.TODO(), expected.hco)).To(Succeed())
+
+				cl.InitiateCreateErrors(func(obj client.Object) error {
+					if _, ok := obj.(*corev1.Secret); ok {
+						return errors.New("fake backup error")
+					}
+					return nil
+				})
+
+				_, err = r.Reconcile(context.TODO(), request)
+				Expect(err).To(MatchError(ContainSubstring("fake backup error")))
+
+				kvList := &kubevirtcorev1.KubeVirtList{}
+				Expect(cl.List(context.TODO(), kvList)).To(Succeed())
+				Expect(kvList.Items).To(HaveLen(1), "The KubeVirt object should not be deleted")
+			})
+		})
+
+		Context("Upgrade pre-flight checks", func() {
+			It("should block the upgrade if a pre-flight check failed", func() {
+				expected := getBasicDeployment()
+				expected.hco.Spec.FeatureGates.Enable("persistentReservation")
+				cl := expected.initClient()
+				foundResource, r, _ := doReconcile(cl, expected.hco, nil)
+
+				cd := apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1.ConditionUpgradeable)
+				Expect(cd).ToNot(BeNil())
+				Expect(cd.Status).To(BeEquivalentTo(metav1.ConditionFalse))
+				Expect(cd.Reason).To(Equal(preflightDeprecatedFeatureGatesReason))
+				Expect(cd.Message).To(Equal("the following deprecated feature gates are enabled: persistentReservation"))
+
+				cd = apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1.ConditionAvailable)
+				Expect(cd.Status).To(BeEquivalentTo(metav1.ConditionTrue))
+
+				validateOperatorCondition(r, metav1.ConditionFalse, preflightDeprecatedFeatureGatesReason, "the following deprecated feature gates are enabled: persistentReservation")
+
+				By("remove the deprecated feature gate")
+				foundResource.Spec.FeatureGates = nil
+				Expect(cl.Update(context.TODO(), foundResource)).To(Succeed())
+
+				foundResource, r, _ = doReconcile(cl, foundResource, r)
+
+				cd = apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1.ConditionUpgradeable)
+				Expect(cd.Status).To(BeEquivalentTo(metav1.ConditionTrue))
+				validateOperatorCondition(r, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
+			})
+		})
+
 		Context("nodeInfo status", func() {
 			AfterEach(func() {
 				commontestutils.ResetNodeInfoMocks()
@@ -0,0 +1,143 @@
+package hyperconverged
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+)
+
+// migrationPolicyCounter counts the VMIs that each live migration profile applies to
+type migrationPolicyCounter struct {
+	sampler    *vmiSampler
+	sampleTime time.Time
+	profiles   []hcov1.MigrationPolicyProfile
+	vmis       map[string]int32
+}
+
+func newMigrationPolicyCounter(sampler *vmiSampler) *migrationPolicyCounter {
+	return &migrationPolicyCounter{
+		sampler: sampler,
+	}
+}
+
+// update publishes the state of the live migration profiles in the HyperConverged status. The VMIs are counted when
+// they are sampled again, or when the profiles are modified. A failure to count the VMIs is ignored, and the previous
+// counts are used.
+func (mc *migrationPolicyCounter) update(req *common.HcoRequest, reader client.Reader) {
+	profiles := req.Instance.Spec.Virtualization.MigrationPolicies
+	if len(profiles) == 0 {
+		mc.sampleTime = time.Time{}
+		mc.profiles = nil
+		mc.vmis = nil
+		if req.Instance.Status.MigrationPolicies != nil {
+			req.Instance.Status.MigrationPolicies = nil
+			req.StatusDirty = true
+		}
+		return
+	}
+
+	if vmiList, sampleTime, ok := mc.sampler.getVMIs(req, reader); ok && (!sampleTime.Equal(mc.sampleTime) || !equality.Semantic.DeepEqual(profiles, mc.profiles)) {
+		vmis, err := countProfileVMIs(req, reader, vmiList, profiles)
+		if err != nil {
+			req.Logger.Error(err, "failed to count the VMIs of the live migration profiles")
+		} else {
+			mc.vmis = vmis
+			mc.profiles = getProfilesCopy(profiles)
+		}
+		mc.sampleTime = sampleTime
+	}
+
+	statuses := make([]hcov1.MigrationPolicyStatus, 0, len(profiles))
+	for _, profile := range profiles {
+		statuses = append(statuses, hcov1.MigrationPolicyStatus{
+			Name:            profile.Name,
+			MigrationPolicy: handlers.GetMigrationPolicyName(profile.Name),
+			VMIs:            mc.vmis[profile.Name],
+		})
+	}
+
+	if !equality.Semantic.DeepEqual(statuses, req.Instance.Status.MigrationPolicies) {
+		req.Instance.Status.MigrationPolicies = statuses
+		req.StatusDirty = true
+	}
+}
+
+// requeueAfter returns the sample interval if there are live migration profiles, so the VMI counts are kept up to
+// date, or zero otherwise
+func (mc *migrationPolicyCounter) requeueAfter(hc *hcov1.HyperConverged) time.Duration {
+	if len(hc.Spec.Virtualization.MigrationPolicies) > 0 {
+		return mc.sampler.requeueAfter()
+	}
+	return 0
+}
+
+// countProfileVMIs returns the number of the sampled VMIs that match the selectors of each profile. The selectors of
+// the profiles don't overlap, so each VMI is counted for one profile at the most.
+func countProfileVMIs(req *common.HcoRequest, reader client.Reader, vmiList []metav1.PartialObjectMetadata, profiles []hcov1.MigrationPolicyProfile) (map[string]int32, error) {
+	nsLabels, err := getNamespaceLabels(req, reader, profiles)
+	if err != nil {
+		return nil, err
+	}
+
+	vmis := make(map[string]int32, len(profiles))
+	for _, vmi := range vmiList {
+		for _, profile := range profiles {
+			if matchesSelector(profile.NamespaceSelector, nsLabels[vmi.Namespace]) && matchesSelector(profile.VMSelector, vmi.Labels) {
+				vmis[profile.Name]++
+				break
+			}
+		}
+	}
+
+	return vmis, nil
+}
+
+// getNamespaceLabels returns the labels of the namespaces, if any profile selects the VMIs by their namespace
+func getNamespaceLabels(req *common.HcoRequest, reader client.Reader, profiles []hcov1.MigrationPolicyProfile) (map[string]map[string]string, error) {
+	nsLabels := make(map[string]map[string]string)
+
+	if !hasNamespaceSelector(profiles) {
+		return nsLabels, nil
+	}
+
+	nsList := &metav1.PartialObjectMetadataList{}
+	nsList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NamespaceList"))
+	if err := reader.List(req.Ctx, nsList); err != nil {
+		return nil, err
+	}
+
+	for _, ns := range nsList.Items {
+		nsLabels[ns.Name] = ns.Labels
+	}
+
+	return nsLabels, nil
+}
+
+func hasNamespaceSelector(profiles []hcov1.MigrationPolicyProfile) bool {
+	for _, profile := range profiles {
+		if len(profile.NamespaceSelector) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesSelector(selector, objLabels map[string]string) bool {
+	return labels.SelectorFromSet(selector).Matches(labels.Set(objLabels))
+}
+
+func getProfilesCopy(profiles []hcov1.MigrationPolicyProfile) []hcov1.MigrationPolicyProfile {
+	profilesCopy := make([]hcov1.MigrationPolicyProfile, len(profiles))
+	for i, profile := range profiles {
+		profile.DeepCopyInto(&profilesCopy[i])
+	}
+	return profilesCopy
+}
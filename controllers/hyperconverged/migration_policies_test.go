@@ -0,0 +1,142 @@
+package hyperconverged
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("Live migration profiles status", func() {
+	var (
+		hco     *hcov1.HyperConverged
+		req     *common.HcoRequest
+		mc      *migrationPolicyCounter
+		sampler *vmiSampler
+		now     time.Time
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		hco.Spec.Virtualization.MigrationPolicies = []hcov1.MigrationPolicyProfile{
+			{
+				Name:       "latency-sensitive",
+				VMSelector: map[string]string{"workload": "latency-sensitive"},
+			},
+			{
+				Name:              "bulk",
+				NamespaceSelector: map[string]string{"tier": "batch"},
+			},
+		}
+		req = commontestutils.NewReq(hco)
+
+		now = time.Now()
+		sampler = newVMISampler()
+		sampler.now = func() time.Time { return now }
+		mc = newMigrationPolicyCounter(sampler)
+	})
+
+	genVMIs := func(prefix, namespace string, vmLabels map[string]string, count int) []client.Object {
+		vmis := make([]client.Object, 0, count)
+		for i := range count {
+			vmis = append(vmis, &kubevirtcorev1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("%s-%d", prefix, i),
+					Namespace: namespace,
+					Labels:    vmLabels,
+				},
+			})
+		}
+		return vmis
+	}
+
+	genObjects := func() []client.Object {
+		objects := []client.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "apps"}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "batch-jobs", Labels: map[string]string{"tier": "batch"}}},
+		}
+		objects = append(objects, genVMIs("ls", "apps", map[string]string{"workload": "latency-sensitive"}, 3)...)
+		objects = append(objects, genVMIs("other", "apps", nil, 2)...)
+		objects = append(objects, genVMIs("bulk", "batch-jobs", nil, 4)...)
+		return objects
+	}
+
+	It("should count the VMIs of each profile", func() {
+		mc.update(req, commontestutils.InitClient(genObjects()))
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.MigrationPolicies).To(Equal([]hcov1.MigrationPolicyStatus{
+			{Name: "latency-sensitive", MigrationPolicy: "hco-latency-sensitive", VMIs: 3},
+			{Name: "bulk", MigrationPolicy: "hco-bulk", VMIs: 4},
+		}))
+	})
+
+	It("should count the VMIs again only after the sample interval", func() {
+		mc.update(req, commontestutils.InitClient(genObjects()))
+
+		cl := commontestutils.InitClient(append(genObjects(), genVMIs("more-bulk", "batch-jobs", nil, 2)...))
+
+		req.StatusDirty = false
+		now = now.Add(vmiSampleInterval / 2)
+		mc.update(req, cl)
+		Expect(req.StatusDirty).To(BeFalse())
+		Expect(hco.Status.MigrationPolicies[1].VMIs).To(Equal(int32(4)))
+
+		now = now.Add(vmiSampleInterval)
+		mc.update(req, cl)
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.MigrationPolicies[1].VMIs).To(Equal(int32(6)))
+	})
+
+	It("should use the VMIs that were sampled for the tuning recommendation", func() {
+		tr := newTuningRecommender(sampler)
+		tr.update(req, commontestutils.InitClient(genObjects()))
+
+		mc.update(req, commontestutils.InitClient(append(genObjects(), genVMIs("more-bulk", "batch-jobs", nil, 2)...)))
+		Expect(hco.Status.TuningRecommendation.VMIs).To(Equal(int32(9)))
+		Expect(hco.Status.MigrationPolicies[1].VMIs).To(Equal(int32(4)))
+	})
+
+	It("should count the VMIs again when the profiles are modified", func() {
+		cl := commontestutils.InitClient(genObjects())
+		mc.update(req, cl)
+
+		hco.Spec.Virtualization.MigrationPolicies[1].NamespaceSelector = nil
+		hco.Spec.Virtualization.MigrationPolicies[1].VMSelector = map[string]string{"workload": "bulk"}
+		mc.update(req, cl)
+
+		Expect(hco.Status.MigrationPolicies).To(Equal([]hcov1.MigrationPolicyStatus{
+			{Name: "latency-sensitive", MigrationPolicy: "hco-latency-sensitive", VMIs: 3},
+			{Name: "bulk", MigrationPolicy: "hco-bulk", VMIs: 0},
+		}))
+	})
+
+	It("should remove the status when there are no profiles", func() {
+		mc.update(req, commontestutils.InitClient(genObjects()))
+		Expect(hco.Status.MigrationPolicies).To(HaveLen(2))
+		Expect(mc.requeueAfter(hco)).To(Equal(vmiSampleInterval))
+
+		hco.Spec.Virtualization.MigrationPolicies = nil
+		req.StatusDirty = false
+		mc.update(req, commontestutils.InitClient(genObjects()))
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.MigrationPolicies).To(BeNil())
+		Expect(mc.requeueAfter(hco)).To(BeZero())
+	})
+
+	It("should return the earliest requeue", func() {
+		Expect(earliestRequeue(0, time.Minute, 0, time.Second)).To(Equal(time.Second))
+		Expect(earliestRequeue(0, 0)).To(BeZero())
+	})
+})
@@ -0,0 +1,55 @@
+package hyperconverged
+
+import (
+	"slices"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// updateEffectiveOverrides sets the status.effectiveOverrides field with the spec.overrides entries that were
+// applied to their target operand CRs. It should only be called after all the operands were successfully reconciled,
+// so the overrides that the operand handlers applied are recorded.
+func updateEffectiveOverrides(req *common.HcoRequest) {
+	effective := getEffectiveOverrides(req.Instance, hcoutil.GetClusterInfo().IsOpenshift())
+
+	if !slices.Equal(effective, req.Instance.Status.EffectiveOverrides) {
+		req.Instance.Status.EffectiveOverrides = effective
+		req.StatusDirty = true
+	}
+}
+
+func getEffectiveOverrides(hc *hcov1.HyperConverged, isOpenshift bool) []hcov1.EffectiveOperandOverride {
+	var effective []hcov1.EffectiveOperandOverride
+	for i, override := range hc.Spec.Overrides {
+		// SSP is only deployed on OpenShift
+		if override.Target == hcov1.OperandOverrideTargetSSP && !isOpenshift {
+			continue
+		}
+
+		// the operand CR is not reconciled while the operand is paused
+		if slices.Contains(hc.Spec.Deployment.PausedOperands, hcov1.OperandKind(override.Target)) {
+			continue
+		}
+
+		// the patch failed, or did not modify the operand CR
+		if !slices.Contains(operands.GetAppliedOverrides(override.Target), i) {
+			continue
+		}
+
+		patchType := override.Type
+		if patchType == "" {
+			patchType = hcov1.OperandOverridePatchTypeJSONPatch
+		}
+
+		effective = append(effective, hcov1.EffectiveOperandOverride{
+			Index:  i,
+			Target: override.Target,
+			Type:   patchType,
+		})
+	}
+
+	return effective
+}
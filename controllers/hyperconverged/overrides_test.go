@@ -0,0 +1,123 @@
+package hyperconverged
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	sspv1beta3 "kubevirt.io/ssp-operator/api/v1beta3"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("test effective overrides", func() {
+	imagePullPolicyPatch := []hcov1.JSONPatchOperation{
+		{Op: "add", Path: "/spec/imagePullPolicy", Value: &apiextensionsv1.JSON{Raw: []byte(`"Always"`)}},
+	}
+
+	overrides := []hcov1.OperandOverride{
+		{Target: hcov1.OperandOverrideTargetKubeVirt, JSONPatch: imagePullPolicyPatch},
+		{
+			Target:              hcov1.OperandOverrideTargetSSP,
+			Type:                hcov1.OperandOverridePatchTypeStrategicMerge,
+			StrategicMergePatch: &apiextensionsv1.JSON{Raw: []byte(`{"spec": {"commonTemplates": {"namespace": "templates"}}}`)},
+		},
+		{Target: hcov1.OperandOverrideTargetCDI, Type: hcov1.OperandOverridePatchTypeJSONPatch, JSONPatch: imagePullPolicyPatch},
+	}
+
+	// applyOverrides applies the overrides as the operand handlers do, so they are recorded as applied
+	applyOverrides := func(hco *hcov1.HyperConverged) {
+		GinkgoHelper()
+		Expect(operands.ApplyOverrides(hco, hcov1.OperandOverrideTargetKubeVirt, &kubevirtcorev1.KubeVirt{})).To(Succeed())
+		Expect(operands.ApplyOverrides(hco, hcov1.OperandOverrideTargetSSP, &sspv1beta3.SSP{})).To(Succeed())
+		Expect(operands.ApplyOverrides(hco, hcov1.OperandOverrideTargetCDI, &cdiv1beta1.CDI{})).To(Succeed())
+	}
+
+	It("should return nil if there are no overrides", func() {
+		Expect(getEffectiveOverrides(commontestutils.NewHco(), true)).To(BeNil())
+	})
+
+	It("should list all the overrides on OpenShift", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.Overrides = overrides
+		applyOverrides(hco)
+
+		Expect(getEffectiveOverrides(hco, true)).To(Equal([]hcov1.EffectiveOperandOverride{
+			{Index: 0, Target: hcov1.OperandOverrideTargetKubeVirt, Type: hcov1.OperandOverridePatchTypeJSONPatch},
+			{Index: 1, Target: hcov1.OperandOverrideTargetSSP, Type: hcov1.OperandOverridePatchTypeStrategicMerge},
+			{Index: 2, Target: hcov1.OperandOverrideTargetCDI, Type: hcov1.OperandOverridePatchTypeJSONPatch},
+		}))
+	})
+
+	It("should skip the SSP overrides if not on OpenShift", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.Overrides = overrides
+		applyOverrides(hco)
+
+		Expect(getEffectiveOverrides(hco, false)).To(Equal([]hcov1.EffectiveOperandOverride{
+			{Index: 0, Target: hcov1.OperandOverrideTargetKubeVirt, Type: hcov1.OperandOverridePatchTypeJSONPatch},
+			{Index: 2, Target: hcov1.OperandOverrideTargetCDI, Type: hcov1.OperandOverridePatchTypeJSONPatch},
+		}))
+	})
+
+	It("should skip the overrides of paused operands", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.Overrides = overrides
+		applyOverrides(hco)
+		hco.Spec.Deployment.PausedOperands = []hcov1.OperandKind{hcov1.OperandKindKubeVirt}
+
+		Expect(getEffectiveOverrides(hco, true)).To(Equal([]hcov1.EffectiveOperandOverride{
+			{Index: 1, Target: hcov1.OperandOverrideTargetSSP, Type: hcov1.OperandOverridePatchTypeStrategicMerge},
+			{Index: 2, Target: hcov1.OperandOverrideTargetCDI, Type: hcov1.OperandOverridePatchTypeJSONPatch},
+		}))
+	})
+
+	It("should skip the overrides that did not modify the operand CR", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.Overrides = []hcov1.OperandOverride{
+			overrides[0],
+			{Target: hcov1.OperandOverrideTargetKubeVirt, JSONPatch: imagePullPolicyPatch},
+			overrides[2],
+		}
+		applyOverrides(hco)
+
+		Expect(getEffectiveOverrides(hco, true)).To(Equal([]hcov1.EffectiveOperandOverride{
+			{Index: 0, Target: hcov1.OperandOverrideTargetKubeVirt, Type: hcov1.OperandOverridePatchTypeJSONPatch},
+			{Index: 2, Target: hcov1.OperandOverrideTargetCDI, Type: hcov1.OperandOverridePatchTypeJSONPatch},
+		}))
+	})
+
+	It("should update the status only if the effective overrides were changed", func() {
+		getClusterInfo := hcoutil.GetClusterInfo
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return commontestutils.ClusterInfoMock{}
+		}
+		DeferCleanup(func() {
+			hcoutil.GetClusterInfo = getClusterInfo
+		})
+
+		hco := commontestutils.NewHco()
+		hco.Spec.Overrides = overrides
+		applyOverrides(hco)
+		req := commontestutils.NewReq(hco)
+
+		updateEffectiveOverrides(req)
+		Expect(hco.Status.EffectiveOverrides).To(HaveLen(3))
+		Expect(req.StatusDirty).To(BeTrue())
+
+		req = commontestutils.NewReq(hco)
+		updateEffectiveOverrides(req)
+		Expect(req.StatusDirty).To(BeFalse())
+
+		hco.Spec.Overrides = nil
+		applyOverrides(hco)
+		updateEffectiveOverrides(req)
+		Expect(hco.Status.EffectiveOverrides).To(BeEmpty())
+		Expect(req.StatusDirty).To(BeTrue())
+	})
+})
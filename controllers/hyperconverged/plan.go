@@ -0,0 +1,158 @@
+package hyperconverged
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// PlanAnnotation holds a JSON patch (RFC6902) to the HyperConverged spec. When set, HCO reports what applying the
+	// patch would do to the operands, in the PlanReportConfigMapName ConfigMap, without applying it.
+	PlanAnnotation = hcoutil.HCOAnnotationPrefix + "plan"
+
+	// PlanReportConfigMapName is the name of the ConfigMap that holds the plan report
+	PlanReportConfigMapName = "kubevirt-hyperconverged-plan"
+
+	planInputKey      = "input"
+	planGenerationKey = "generation"
+	planReportKey     = "report"
+)
+
+type planReport struct {
+	Error    string                `json:"error,omitempty"`
+	Operands []operands.PlanResult `json:"operands,omitempty"`
+}
+
+// reconcilePlan generates the plan report if the plan annotation was added or modified, or if the HyperConverged spec
+// was changed since the last report. It removes the report if the annotation was removed.
+func (r *ReconcileHyperConverged) reconcilePlan(req *common.HcoRequest) error {
+	patch, requested := req.Instance.Annotations[PlanAnnotation]
+
+	cm := &corev1.ConfigMap{}
+	err := r.client.Get(req.Ctx, client.ObjectKey{Name: PlanReportConfigMapName, Namespace: req.Instance.Namespace}, cm)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		cm = nil
+	}
+
+	if !requested {
+		if cm == nil {
+			return nil
+		}
+
+		req.Logger.Info("the plan annotation was removed; removing the plan report")
+		return client.IgnoreNotFound(r.client.Delete(req.Ctx, cm))
+	}
+
+	generation := strconv.FormatInt(req.Instance.Generation, 10)
+	if cm != nil && cm.Data[planInputKey] == patch && cm.Data[planGenerationKey] == generation {
+		return nil
+	}
+
+	req.Logger.Info("generating the plan report")
+	reportBytes, err := yaml.Marshal(r.getPlanReport(req, patch))
+	if err != nil {
+		return err
+	}
+
+	data := map[string]string{
+		planInputKey:      patch,
+		planGenerationKey: generation,
+		planReportKey:     string(reportBytes),
+	}
+
+	if cm == nil {
+		cm = newPlanReportConfigMap(req.Instance, data)
+		if err = controllerutil.SetControllerReference(req.Instance, cm, r.scheme); err != nil {
+			return err
+		}
+
+		err = r.client.Create(req.Ctx, cm)
+	} else {
+		cm.Data = data
+		err = r.client.Update(req.Ctx, cm)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "PlanReady", fmt.Sprintf("The plan report is available in the %s ConfigMap", PlanReportConfigMapName))
+	return nil
+}
+
+func (r *ReconcileHyperConverged) getPlanReport(req *common.HcoRequest, patch string) *planReport {
+	proposed, err := applyPlanPatch(req.Instance, patch)
+	if err != nil {
+		return &planReport{Error: fmt.Sprintf("invalid jsonPatch in the %s annotation: %v", PlanAnnotation, err)}
+	}
+
+	planReq := common.NewHcoRequest(req.Ctx, req.Request, req.Logger, req.UpgradeMode, true)
+	planReq.Instance = proposed
+
+	return &planReport{Operands: r.operandHandler.Plan(planReq)}
+}
+
+func applyPlanPatch(hc *hcov1.HyperConverged, patch string) (*hcov1.HyperConverged, error) {
+	patches, err := jsonpatch.DecodePatch([]byte(patch))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range patches {
+		path, err := p.Path()
+		if err != nil {
+			return nil, err
+		}
+
+		if !strings.HasPrefix(path, "/spec/") {
+			return nil, errors.New("can only modify spec fields")
+		}
+	}
+
+	hcBytes, err := json.Marshal(hc)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedBytes, err := patches.Apply(hcBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	proposed := &hcov1.HyperConverged{}
+	if err = json.Unmarshal(patchedBytes, proposed); err != nil {
+		return nil, err
+	}
+
+	return proposed, nil
+}
+
+func newPlanReportConfigMap(hc *hcov1.HyperConverged, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PlanReportConfigMapName,
+			Namespace: hc.Namespace,
+			Labels:    hcoutil.GetLabels(hc.Name, hcoutil.AppComponentDeployment),
+		},
+		Data: data,
+	}
+}
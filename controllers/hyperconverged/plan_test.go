@@ -0,0 +1,186 @@
+package hyperconverged
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/reqresolver"
+	fakeownresources "github.com/kubevirt/hyperconverged-cluster-operator/pkg/ownresources/fake"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+	"github.com/kubevirt/hyperconverged-cluster-operator/version"
+)
+
+var _ = Describe("Plan", func() {
+	getClusterInfo := hcoutil.GetClusterInfo
+
+	origOperatorCondVarName := os.Getenv(hcoutil.OperatorConditionNameEnvVar)
+	origVirtIOWinContainer := os.Getenv(hcoutil.VirtioWinImageEnvV)
+	origVersion := os.Getenv(hcoutil.HcoKvIoVersionName)
+
+	BeforeEach(func() {
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return commontestutils.ClusterInfoMock{}
+		}
+		fakeownresources.OLMV0OwnResourcesMock()
+
+		Expect(os.Setenv(hcoutil.OperatorConditionNameEnvVar, "OPERATOR_CONDITION")).To(Succeed())
+		Expect(os.Setenv(hcoutil.VirtioWinImageEnvV, commontestutils.VirtioWinImage)).To(Succeed())
+		Expect(os.Setenv(hcoutil.HcoKvIoVersionName, version.Version)).To(Succeed())
+
+		reqresolver.GeneratePlaceHolders()
+
+		DeferCleanup(func() {
+			hcoutil.GetClusterInfo = getClusterInfo
+			fakeownresources.ResetOwnResources()
+
+			Expect(os.Setenv(hcoutil.OperatorConditionNameEnvVar, origOperatorCondVarName)).To(Succeed())
+			Expect(os.Setenv(hcoutil.VirtioWinImageEnvV, origVirtIOWinContainer)).To(Succeed())
+			Expect(os.Setenv(hcoutil.HcoKvIoVersionName, origVersion)).To(Succeed())
+		})
+	})
+
+	getPlanReport := func(cl client.Client) (*corev1.ConfigMap, *planReport) {
+		cm := &corev1.ConfigMap{}
+		Expect(cl.Get(context.TODO(), types.NamespacedName{Name: PlanReportConfigMapName, Namespace: namespace}, cm)).To(Succeed())
+
+		report := &planReport{}
+		Expect(yaml.Unmarshal([]byte(cm.Data[planReportKey]), report)).To(Succeed())
+
+		return cm, report
+	}
+
+	findOperand := func(report *planReport, kind string) *operands.PlanResult {
+		for i := range report.Operands {
+			if report.Operands[i].Type == kind {
+				return &report.Operands[i]
+			}
+		}
+		return nil
+	}
+
+	It("should not create the plan report if the plan annotation is not set", func() {
+		expected := getBasicDeployment()
+		cl := expected.initClient()
+
+		_, _, _ = doReconcile(cl, expected.hco, nil)
+
+		cm := &corev1.ConfigMap{}
+		err := cl.Get(context.TODO(), types.NamespacedName{Name: PlanReportConfigMapName, Namespace: namespace}, cm)
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+	})
+
+	It("should report the planned changes, without applying them", func() {
+		const patch = `[{"op": "replace", "path": "/spec/virtualization/liveMigrationConfig/allowPostCopy", "value": true}]`
+
+		expected := getBasicDeployment()
+		expected.hco.Annotations = map[string]string{PlanAnnotation: patch}
+		cl := expected.initClient()
+
+		foundHC, _, _ := doReconcile(cl, expected.hco, nil)
+		Expect(foundHC.Spec.Virtualization.LiveMigrationConfig.AllowPostCopy).To(HaveValue(BeFalse()))
+
+		cm, report := getPlanReport(cl)
+		Expect(cm.Data).To(HaveKeyWithValue(planInputKey, patch))
+		Expect(cm.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, expected.hco.Name))
+		Expect(cm.OwnerReferences).To(HaveLen(1))
+
+		Expect(report.Error).To(BeEmpty())
+		kvPlan := findOperand(report, "KubeVirt")
+		Expect(kvPlan).ToNot(BeNil())
+		Expect(kvPlan.Err).To(BeEmpty())
+		Expect(kvPlan.Action).To(Equal(operands.PlanActionUpdate))
+		Expect(kvPlan.Changes).To(ContainElement(HaveField("Path", "/spec/configuration/migrations/allowPostCopy")))
+
+		cdiPlan := findOperand(report, "CDI")
+		Expect(cdiPlan).ToNot(BeNil())
+		Expect(cdiPlan.Action).To(Equal(operands.PlanActionNone))
+
+		foundKV := &kubevirtcorev1.KubeVirt{}
+		Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(expected.kv), foundKV)).To(Succeed())
+		Expect(foundKV.Spec.Configuration.MigrationConfiguration.AllowPostCopy).To(HaveValue(BeFalse()))
+	})
+
+	It("should report an invalid patch", func() {
+		expected := getBasicDeployment()
+		expected.hco.Annotations = map[string]string{PlanAnnotation: `[{"op": "replace", "path": "/metadata/name", "value": "other"}]`}
+		cl := expected.initClient()
+
+		_, _, _ = doReconcile(cl, expected.hco, nil)
+
+		_, report := getPlanReport(cl)
+		Expect(report.Error).To(ContainSubstring("can only modify spec fields"))
+		Expect(report.Operands).To(BeEmpty())
+	})
+
+	It("should update the plan report when the plan annotation is modified", func() {
+		expected := getBasicDeployment()
+		expected.hco.Annotations = map[string]string{PlanAnnotation: `[]`}
+		cl := expected.initClient()
+
+		foundHC, r, _ := doReconcile(cl, expected.hco, nil)
+
+		_, report := getPlanReport(cl)
+		Expect(findOperand(report, "KubeVirt").Action).To(Equal(operands.PlanActionNone))
+
+		const patch = `[{"op": "replace", "path": "/spec/virtualization/liveMigrationConfig/allowPostCopy", "value": true}]`
+		foundHC.Annotations[PlanAnnotation] = patch
+		Expect(cl.Update(context.TODO(), foundHC)).To(Succeed())
+
+		_, _, _ = doReconcile(cl, expected.hco, r)
+
+		cm, report := getPlanReport(cl)
+		Expect(cm.Data).To(HaveKeyWithValue(planInputKey, patch))
+		Expect(findOperand(report, "KubeVirt").Action).To(Equal(operands.PlanActionUpdate))
+	})
+
+	It("should remove the plan report when the plan annotation is removed", func() {
+		expected := getBasicDeployment()
+		hc := expected.hco
+		cl := expected.initClient()
+
+		Expect(cl.Create(context.TODO(), newPlanReportConfigMap(hc, map[string]string{planInputKey: "[]"}))).To(Succeed())
+
+		_, _, _ = doReconcile(cl, hc, nil)
+
+		cm := &corev1.ConfigMap{}
+		err := cl.Get(context.TODO(), types.NamespacedName{Name: PlanReportConfigMapName, Namespace: namespace}, cm)
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+	})
+
+	Context("applyPlanPatch", func() {
+		It("should apply the patch to a copy of the HyperConverged", func() {
+			hc := commontestutils.NewHco()
+
+			proposed, err := applyPlanPatch(hc, `[{"op": "replace", "path": "/spec/virtualization/liveMigrationConfig/allowPostCopy", "value": true}]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(proposed.Spec.Virtualization.LiveMigrationConfig.AllowPostCopy).To(HaveValue(BeTrue()))
+			Expect(hc.Spec.Virtualization.LiveMigrationConfig.AllowPostCopy).To(HaveValue(BeFalse()))
+		})
+
+		DescribeTable("should reject invalid patches", func(patch, errMsg string) {
+			_, err := applyPlanPatch(commontestutils.NewHco(), patch)
+			Expect(err).To(MatchError(ContainSubstring(errMsg)))
+		},
+			Entry("not a JSON patch", `{"spec": {}}`, "cannot unmarshal"),
+			Entry("metadata", `[{"op": "add", "path": "/metadata/labels", "value": {"a": "b"}}]`, "can only modify spec fields"),
+			Entry("status", `[{"op": "add", "path": "/status/conditions", "value": []}]`, "can only modify spec fields"),
+		)
+	})
+
+	It("should name the plan report ConfigMap", func() {
+		cm := newPlanReportConfigMap(commontestutils.NewHco(), nil)
+		Expect(cm.ObjectMeta).To(HaveField("Name", PlanReportConfigMapName))
+		Expect(cm.ObjectMeta).To(HaveField("Namespace", namespace))
+		Expect(cm.ObjectMeta.Labels).To(HaveKeyWithValue(hcoutil.AppLabelComponent, string(hcoutil.AppComponentDeployment)))
+	})
+})
@@ -0,0 +1,29 @@
+package hyperconverged
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	waspagent "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/wasp-agent"
+)
+
+// swapNodeConditionsPredicate detects changes of the node conditions that affect the swap status of the node. Added
+// and removed nodes are handled by the wasp-agent DaemonSet status.
+type swapNodeConditionsPredicate predicate.TypedFuncs[*corev1.Node]
+
+func (swapNodeConditionsPredicate) Update(e event.TypedUpdateEvent[*corev1.Node]) bool {
+	return waspagent.SwapNodeConditionsChanged(e.ObjectOld, e.ObjectNew)
+}
+
+func (swapNodeConditionsPredicate) Create(_ event.TypedCreateEvent[*corev1.Node]) bool {
+	return false
+}
+
+func (swapNodeConditionsPredicate) Delete(_ event.TypedDeleteEvent[*corev1.Node]) bool {
+	return false
+}
+
+func (swapNodeConditionsPredicate) Generic(_ event.TypedGenericEvent[*corev1.Node]) bool {
+	return false
+}
@@ -0,0 +1,342 @@
+package hyperconverged
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregatedetails"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregates"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
+)
+
+const (
+	preflightDeprecatedFeatureGates            = "DeprecatedFeatureGates"
+	preflightJSONPatchAnnotations              = "JSONPatchAnnotations"
+	preflightDICTsWithoutArchitectures         = "DataImportCronTemplatesWithoutArchitectures"
+	preflightInsufficientNodesForLiveMigration = "InsufficientNodesForLiveMigration"
+	preflightVMIsWithObsoleteCPUModels         = "VMIsWithObsoleteCPUModels"
+	preflightVMIsWithDeprecatedMachineTypes    = "VMIsWithDeprecatedMachineTypes"
+
+	// the reasons of the Upgradeable condition, when a pre-flight check fails
+	preflightDeprecatedFeatureGatesReason            = "DeprecatedFeatureGatesEnabled"
+	preflightJSONPatchAnnotationsReason              = "JSONPatchAnnotationsFound"
+	preflightDICTsWithoutArchitecturesReason         = "DataImportCronTemplatesMissingArchitectures"
+	preflightInsufficientNodesForLiveMigrationReason = "InsufficientNodesForLiveMigration"
+	preflightVMIsWithObsoleteCPUModelsReason         = "ObsoleteCPUModelsInUse"
+	preflightVMIsWithDeprecatedMachineTypesReason    = "DeprecatedMachineTypesInUse"
+
+	// listing the VMIs is expensive, so the checks that do that, are done less frequently
+	vmiPreflightCheckInterval = 10 * time.Minute
+
+	// the maximum number of object names to list in a pre-flight check message
+	maxPreflightNames = 5
+)
+
+// deprecatedMachineTypePrefixes are the machine types that are not supported by the next versions of KubeVirt
+var deprecatedMachineTypePrefixes = []string{
+	"pc-i440fx-",
+	"pc-q35-rhel7.",
+	"pc-q35-rhel8.",
+}
+
+// preflightCheck is an upgrade pre-flight check. A failed check blocks the upgrade, by setting the Upgradeable
+// condition to False.
+type preflightCheck struct {
+	// name is used as the prefix of the check message in the Upgradeable condition, and as the check_name label of
+	// the kubevirt_hco_upgrade_preflight_check_failed metric
+	name string
+	// reason is the reason of the Upgradeable condition, when the check fails
+	reason string
+	// interval is the minimal time between two runs of the check. Zero means running the check on each reconciliation.
+	interval time.Duration
+	// check returns a non-empty message if the upgrade should be blocked
+	check func(env *preflightEnv) (string, error)
+}
+
+// preflightEnv holds the input of the pre-flight checks, for a single reconciliation
+type preflightEnv struct {
+	req    *common.HcoRequest
+	reader client.Reader
+
+	vmis       []kubevirtcorev1.VirtualMachineInstance
+	vmisListed bool
+}
+
+// getVMIs lists the VMIs in the cluster, at most once per reconciliation
+func (env *preflightEnv) getVMIs() ([]kubevirtcorev1.VirtualMachineInstance, error) {
+	if !env.vmisListed {
+		vmiList := &kubevirtcorev1.VirtualMachineInstanceList{}
+		if err := env.reader.List(env.req.Ctx, vmiList); err != nil {
+			return nil, err
+		}
+
+		env.vmis = vmiList.Items
+		env.vmisListed = true
+	}
+
+	return env.vmis, nil
+}
+
+type preflightResult struct {
+	message string
+	lastRun time.Time
+}
+
+// preflightChecker runs the upgrade pre-flight checks, and keeps their last results
+type preflightChecker struct {
+	checks  []preflightCheck
+	results map[string]preflightResult
+	now     func() time.Time
+}
+
+func newPreflightChecker(checks ...preflightCheck) *preflightChecker {
+	return &preflightChecker{
+		checks:  checks,
+		results: make(map[string]preflightResult),
+		now:     time.Now,
+	}
+}
+
+func newDefaultPreflightChecker() *preflightChecker {
+	return newPreflightChecker(
+		preflightCheck{name: preflightDeprecatedFeatureGates, reason: preflightDeprecatedFeatureGatesReason, check: checkDeprecatedFeatureGates},
+		preflightCheck{name: preflightJSONPatchAnnotations, reason: preflightJSONPatchAnnotationsReason, check: checkJSONPatchAnnotations},
+		preflightCheck{name: preflightDICTsWithoutArchitectures, reason: preflightDICTsWithoutArchitecturesReason, check: checkDICTsWithoutArchitectures},
+		preflightCheck{name: preflightInsufficientNodesForLiveMigration, reason: preflightInsufficientNodesForLiveMigrationReason, interval: vmiPreflightCheckInterval, check: checkNodesForLiveMigration},
+		preflightCheck{name: preflightVMIsWithObsoleteCPUModels, reason: preflightVMIsWithObsoleteCPUModelsReason, interval: vmiPreflightCheckInterval, check: checkVMIsWithObsoleteCPUModels},
+		preflightCheck{name: preflightVMIsWithDeprecatedMachineTypes, reason: preflightVMIsWithDeprecatedMachineTypesReason, interval: vmiPreflightCheckInterval, check: checkVMIsWithDeprecatedMachineTypes},
+	)
+}
+
+// run runs the pre-flight checks, and returns the failed ones, in the checks order. A check that failed to run is
+// ignored, and does not block the upgrade.
+func (pc *preflightChecker) run(req *common.HcoRequest, reader client.Reader) []preflightCheck {
+	env := &preflightEnv{req: req, reader: reader}
+	now := pc.now()
+
+	var failed []preflightCheck
+	for _, check := range pc.checks {
+		result, found := pc.results[check.name]
+		if !found || now.Sub(result.lastRun) >= check.interval {
+			msg, err := check.check(env)
+			if err != nil {
+				req.Logger.Error(err, "failed to run the upgrade pre-flight check", "check", check.name)
+				msg = ""
+			}
+
+			result = preflightResult{message: msg, lastRun: now}
+			pc.results[check.name] = result
+		}
+
+		metrics.SetUpgradePreflightCheckFailed(check.name, result.message != "")
+		if result.message != "" {
+			failed = append(failed, check)
+		}
+	}
+
+	return failed
+}
+
+// requeueAfter returns the time to wait before re-running a failed periodic check, or zero if there is no such check
+func (pc *preflightChecker) requeueAfter() time.Duration {
+	var requeue time.Duration
+	for _, check := range pc.checks {
+		if check.interval == 0 || pc.results[check.name].message == "" {
+			continue
+		}
+
+		if requeue == 0 || check.interval < requeue {
+			requeue = check.interval
+		}
+	}
+
+	return requeue
+}
+
+// checkUpgradePreflight runs the upgrade pre-flight checks, and vetoes the upgrade if any of them failed. The
+// Upgradeable condition only reports the failed checks if nothing else already blocks the upgrade.
+//
+// The reason and the message of the Upgradeable condition are taken from the first failed check, in the checks order.
+// The messages of the other failed checks, if any, are appended to the message.
+func (r *ReconcileHyperConverged) checkUpgradePreflight(req *common.HcoRequest) {
+	if r.upgradeMode {
+		return
+	}
+
+	failed := r.preflightChecker.run(req, r.apiReader)
+	if len(failed) == 0 {
+		return
+	}
+
+	req.Upgradeable = false
+
+	if !req.Conditions.IsStatusConditionTrue(hcov1.ConditionUpgradeable) {
+		return
+	}
+
+	primary := failed[0]
+	message := r.preflightChecker.results[primary.name].message
+	if len(failed) > 1 {
+		others := make([]string, 0, len(failed)-1)
+		for _, check := range failed[1:] {
+			others = append(others, fmt.Sprintf("%s: %s", check.reason, r.preflightChecker.results[check.name].message))
+		}
+		message = fmt.Sprintf("%s; other failed pre-flight checks: %s", message, strings.Join(others, "; "))
+	}
+
+	req.Conditions.SetStatusCondition(metav1.Condition{
+		Type:               hcov1.ConditionUpgradeable,
+		Status:             metav1.ConditionFalse,
+		Reason:             primary.reason,
+		Message:            message,
+		ObservedGeneration: req.Instance.Generation,
+	})
+}
+
+func checkDeprecatedFeatureGates(env *preflightEnv) (string, error) {
+	var deprecated []string
+	for _, fg := range env.req.Instance.Spec.FeatureGates {
+		if phase, _ := featuregatedetails.GetFeatureGatePhase(fg.Name); phase != featuregates.PhaseDeprecated {
+			continue
+		}
+
+		if enabled, _ := env.req.Instance.Spec.FeatureGates.IsExplicitlyEnabled(fg.Name); enabled {
+			deprecated = append(deprecated, fg.Name)
+		}
+	}
+
+	if len(deprecated) == 0 {
+		return "", nil
+	}
+
+	return fmt.Sprintf("the following deprecated feature gates are enabled: %s", formatPreflightNames(deprecated)), nil
+}
+
+func checkJSONPatchAnnotations(env *preflightEnv) (string, error) {
+	var annotations []string
+	for _, jpa := range JSONPatchAnnotationNames {
+		if jsonPatch, exists := env.req.Instance.Annotations[jpa]; exists && getNumOfChangesJSONPatch(jsonPatch) > 0 {
+			annotations = append(annotations, jpa)
+		}
+	}
+
+	if len(annotations) == 0 {
+		return "", nil
+	}
+
+	return fmt.Sprintf("the HyperConverged CR contains the following JSON patch annotations: %s", formatPreflightNames(annotations)), nil
+}
+
+func checkDICTsWithoutArchitectures(env *preflightEnv) (string, error) {
+	hc := env.req.Instance
+	if !goldenimages.IsMultiArchEnabled(hc) {
+		return "", nil
+	}
+
+	var dicts []string
+	for _, dict := range hc.Status.DataImportCronTemplates {
+		if dict.Status.OriginalSupportedArchitectures == "" {
+			dicts = append(dicts, dict.Name)
+		}
+	}
+
+	if len(dicts) == 0 {
+		return "", nil
+	}
+
+	return fmt.Sprintf("the following DataImportCronTemplates are missing the %s annotation: %s", goldenimages.MultiArchDICTAnnotation, formatPreflightNames(dicts)), nil
+}
+
+func checkNodesForLiveMigration(env *preflightEnv) (string, error) {
+	if nodeinfo.IsWorkloadsMultiNode() {
+		return "", nil
+	}
+
+	vmis, err := env.getVMIs()
+	if err != nil {
+		return "", err
+	}
+
+	defaultStrategy := ptr.Deref(env.req.Instance.Spec.Virtualization.EvictionStrategy, kubevirtcorev1.EvictionStrategyNone)
+
+	var names []string
+	for _, vmi := range vmis {
+		if !vmi.IsFinal() && ptr.Deref(vmi.Spec.EvictionStrategy, defaultStrategy) == kubevirtcorev1.EvictionStrategyLiveMigrate {
+			names = append(names, vmi.Namespace+"/"+vmi.Name)
+		}
+	}
+
+	if len(names) == 0 {
+		return "", nil
+	}
+
+	return fmt.Sprintf("there are not enough nodes to live migrate the following VMIs during the upgrade: %s", formatPreflightNames(names)), nil
+}
+
+func checkVMIsWithObsoleteCPUModels(env *preflightEnv) (string, error) {
+	vmis, err := env.getVMIs()
+	if err != nil {
+		return "", err
+	}
+
+	var names []string
+	for _, vmi := range vmis {
+		cpu := vmi.Spec.Domain.CPU
+		if !vmi.IsFinal() && cpu != nil && cpu.Model != "" && handlers.IsObsoleteCPUModel(env.req.Instance, cpu.Model) {
+			names = append(names, vmi.Namespace+"/"+vmi.Name)
+		}
+	}
+
+	if len(names) == 0 {
+		return "", nil
+	}
+
+	return fmt.Sprintf("the following VMIs are running with obsolete CPU models: %s", formatPreflightNames(names)), nil
+}
+
+func checkVMIsWithDeprecatedMachineTypes(env *preflightEnv) (string, error) {
+	vmis, err := env.getVMIs()
+	if err != nil {
+		return "", err
+	}
+
+	var names []string
+	for _, vmi := range vmis {
+		if !vmi.IsFinal() && vmi.Status.Machine != nil && isDeprecatedMachineType(vmi.Status.Machine.Type) {
+			names = append(names, vmi.Namespace+"/"+vmi.Name)
+		}
+	}
+
+	if len(names) == 0 {
+		return "", nil
+	}
+
+	return fmt.Sprintf("the following VMIs are running with deprecated machine types: %s", formatPreflightNames(names)), nil
+}
+
+func isDeprecatedMachineType(machineType string) bool {
+	return slices.ContainsFunc(deprecatedMachineTypePrefixes, func(prefix string) bool {
+		return strings.HasPrefix(machineType, prefix)
+	})
+}
+
+func formatPreflightNames(names []string) string {
+	if len(names) <= maxPreflightNames {
+		return strings.Join(names, ", ")
+	}
+
+	return fmt.Sprintf("%s and %d more", strings.Join(names[:maxPreflightNames], ", "), len(names)-maxPreflightNames)
+}
@@ -0,0 +1,361 @@
+package hyperconverged
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
+)
+
+var _ = Describe("Upgrade pre-flight checks", func() {
+	var (
+		hco *hcov1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+	})
+
+	newVMI := func(name string, modify func(vmi *kubevirtcorev1.VirtualMachineInstance)) *kubevirtcorev1.VirtualMachineInstance {
+		vmi := &kubevirtcorev1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "vms",
+			},
+			Status: kubevirtcorev1.VirtualMachineInstanceStatus{
+				Phase:   kubevirtcorev1.Running,
+				Machine: &kubevirtcorev1.Machine{Type: "pc-q35-rhel9.6.0"},
+			},
+		}
+
+		if modify != nil {
+			modify(vmi)
+		}
+
+		return vmi
+	}
+
+	runCheck := func(check func(env *preflightEnv) (string, error), objects ...client.Object) string {
+		GinkgoHelper()
+		cl := commontestutils.InitClient(objects)
+		msg, err := check(&preflightEnv{req: req, reader: cl})
+		Expect(err).ToNot(HaveOccurred())
+		return msg
+	}
+
+	Context("checkDeprecatedFeatureGates", func() {
+		It("should pass if no deprecated feature gate is enabled", func() {
+			hco.Spec.FeatureGates.Enable("downwardMetrics")
+			hco.Spec.FeatureGates.Disable("persistentReservation")
+			Expect(runCheck(checkDeprecatedFeatureGates)).To(BeEmpty())
+		})
+
+		It("should fail if deprecated feature gates are enabled", func() {
+			hco.Spec.FeatureGates.Enable("persistentReservation")
+			hco.Spec.FeatureGates.Enable("disableMDevConfiguration")
+			Expect(runCheck(checkDeprecatedFeatureGates)).To(Equal("the following deprecated feature gates are enabled: persistentReservation, disableMDevConfiguration"))
+		})
+	})
+
+	Context("checkJSONPatchAnnotations", func() {
+		It("should pass if there are no JSON patch annotations", func() {
+			Expect(runCheck(checkJSONPatchAnnotations)).To(BeEmpty())
+		})
+
+		It("should pass if the JSON patch annotation is empty", func() {
+			hco.Annotations = map[string]string{common.JSONPatchKVAnnotationName: "[]"}
+			Expect(runCheck(checkJSONPatchAnnotations)).To(BeEmpty())
+		})
+
+		It("should fail if there are JSON patch annotations", func() {
+			hco.Annotations = map[string]string{
+				common.JSONPatchCDIAnnotationName: `[{"op": "add", "path": "/spec/config/featureGates/-", "value": "fg1"}]`,
+			}
+			Expect(runCheck(checkJSONPatchAnnotations)).To(Equal("the HyperConverged CR contains the following JSON patch annotations: " + common.JSONPatchCDIAnnotationName))
+		})
+	})
+
+	Context("checkDICTsWithoutArchitectures", func() {
+		BeforeEach(func() {
+			hco.Status.DataImportCronTemplates = []hcov1.DataImportCronTemplateStatus{
+				{
+					DataImportCronTemplate: hcov1.DataImportCronTemplate{ObjectMeta: metav1.ObjectMeta{Name: "with-arch"}},
+					Status:                 hcov1.DataImportCronStatus{OriginalSupportedArchitectures: "amd64,arm64"},
+				},
+				{
+					DataImportCronTemplate: hcov1.DataImportCronTemplate{ObjectMeta: metav1.ObjectMeta{Name: "without-arch"}},
+				},
+			}
+		})
+
+		It("should pass if multi-arch is disabled", func() {
+			Expect(runCheck(checkDICTsWithoutArchitectures)).To(BeEmpty())
+		})
+
+		It("should fail if multi-arch is enabled, and there are DICTs without architectures", func() {
+			hco.Spec.WorkloadSources.EnableMultiArchBootImageImport = ptr.To(true)
+			Expect(runCheck(checkDICTsWithoutArchitectures)).To(Equal("the following DataImportCronTemplates are missing the " + goldenimages.MultiArchDICTAnnotation + " annotation: without-arch"))
+		})
+	})
+
+	Context("checkNodesForLiveMigration", func() {
+		setWorkloadNodes := func(workers int) {
+			GinkgoHelper()
+			nodes := make([]client.Object, 0, workers)
+			for i := range workers {
+				nodes = append(nodes, &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "worker-" + string(rune('a'+i)),
+						Labels: map[string]string{nodeinfo.LabelNodeRoleWorker: ""},
+					},
+				})
+			}
+
+			_, err := nodeinfo.HandleNodeChanges(context.Background(), commontestutils.InitClient(nodes), hco, GinkgoLogr)
+			Expect(err).ToNot(HaveOccurred())
+
+			DeferCleanup(func() {
+				_, err := nodeinfo.HandleNodeChanges(context.Background(), commontestutils.InitClient(nil), hco, GinkgoLogr)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		}
+
+		liveMigrate := func(vmi *kubevirtcorev1.VirtualMachineInstance) {
+			vmi.Spec.EvictionStrategy = ptr.To(kubevirtcorev1.EvictionStrategyLiveMigrate)
+		}
+
+		It("should pass if there are multiple workload nodes", func() {
+			setWorkloadNodes(2)
+			Expect(runCheck(checkNodesForLiveMigration, newVMI("vmi1", liveMigrate))).To(BeEmpty())
+		})
+
+		It("should pass if no VMI should be live migrated", func() {
+			setWorkloadNodes(1)
+			Expect(runCheck(checkNodesForLiveMigration, newVMI("vmi1", nil))).To(BeEmpty())
+		})
+
+		It("should fail if VMIs should be live migrated, and there is a single workload node", func() {
+			setWorkloadNodes(1)
+			Expect(runCheck(checkNodesForLiveMigration, newVMI("vmi1", liveMigrate), newVMI("vmi2", nil))).
+				To(Equal("there are not enough nodes to live migrate the following VMIs during the upgrade: vms/vmi1"))
+		})
+
+		It("should use the cluster level eviction strategy", func() {
+			setWorkloadNodes(1)
+			hco.Spec.Virtualization.EvictionStrategy = ptr.To(kubevirtcorev1.EvictionStrategyLiveMigrate)
+			Expect(runCheck(checkNodesForLiveMigration, newVMI("vmi1", nil))).
+				To(Equal("there are not enough nodes to live migrate the following VMIs during the upgrade: vms/vmi1"))
+		})
+	})
+
+	Context("checkVMIsWithObsoleteCPUModels", func() {
+		withCPUModel := func(model string) func(vmi *kubevirtcorev1.VirtualMachineInstance) {
+			return func(vmi *kubevirtcorev1.VirtualMachineInstance) {
+				vmi.Spec.Domain.CPU = &kubevirtcorev1.CPU{Model: model}
+			}
+		}
+
+		It("should pass if no VMI uses an obsolete CPU model", func() {
+			Expect(runCheck(checkVMIsWithObsoleteCPUModels, newVMI("vmi1", nil), newVMI("vmi2", withCPUModel("Skylake-Server")))).To(BeEmpty())
+		})
+
+		It("should fail if VMIs use obsolete CPU models", func() {
+			hco.Spec.Virtualization.ObsoleteCPUModels = []string{"Skylake-Server"}
+
+			stopped := newVMI("vmi3", withCPUModel("pentium"))
+			stopped.Status.Phase = kubevirtcorev1.Succeeded
+
+			Expect(runCheck(checkVMIsWithObsoleteCPUModels, newVMI("vmi1", withCPUModel("pentium")), newVMI("vmi2", withCPUModel("Skylake-Server")), stopped)).
+				To(Equal("the following VMIs are running with obsolete CPU models: vms/vmi1, vms/vmi2"))
+		})
+	})
+
+	Context("checkVMIsWithDeprecatedMachineTypes", func() {
+		withMachineType := func(machineType string) func(vmi *kubevirtcorev1.VirtualMachineInstance) {
+			return func(vmi *kubevirtcorev1.VirtualMachineInstance) {
+				vmi.Status.Machine.Type = machineType
+			}
+		}
+
+		It("should pass if no VMI uses a deprecated machine type", func() {
+			Expect(runCheck(checkVMIsWithDeprecatedMachineTypes, newVMI("vmi1", nil))).To(BeEmpty())
+		})
+
+		It("should fail if VMIs use deprecated machine types", func() {
+			Expect(runCheck(checkVMIsWithDeprecatedMachineTypes,
+				newVMI("vmi1", withMachineType("pc-q35-rhel8.6.0")),
+				newVMI("vmi2", withMachineType("pc-i440fx-rhel7.6.0")),
+				newVMI("vmi3", nil),
+			)).To(Equal("the following VMIs are running with deprecated machine types: vms/vmi1, vms/vmi2"))
+		})
+	})
+
+	Context("preflightChecker", func() {
+		var (
+			now       time.Time
+			runs      map[string]int
+			messages  map[string]string
+			newCheck  func(name string, interval time.Duration) preflightCheck
+			newTested func(checks ...preflightCheck) *preflightChecker
+		)
+
+		BeforeEach(func() {
+			now = time.Now()
+			runs = map[string]int{}
+			messages = map[string]string{}
+
+			newCheck = func(name string, interval time.Duration) preflightCheck {
+				return preflightCheck{
+					name:     name,
+					interval: interval,
+					check: func(_ *preflightEnv) (string, error) {
+						runs[name]++
+						return messages[name], nil
+					},
+				}
+			}
+
+			newTested = func(checks ...preflightCheck) *preflightChecker {
+				pc := newPreflightChecker(checks...)
+				pc.now = func() time.Time { return now }
+				return pc
+			}
+		})
+
+		It("should return the failed checks, and set the metrics", func() {
+			messages["Check2"] = "check2 failed"
+			pc := newTested(newCheck("Check1", 0), newCheck("Check2", 0))
+
+			failed := pc.run(req, nil)
+			Expect(failed).To(HaveLen(1))
+			Expect(failed[0].name).To(Equal("Check2"))
+
+			Expect(metrics.IsUpgradePreflightCheckFailed("Check1")).To(BeFalse())
+			Expect(metrics.IsUpgradePreflightCheckFailed("Check2")).To(BeTrue())
+
+			messages["Check2"] = ""
+			Expect(pc.run(req, nil)).To(BeEmpty())
+			Expect(metrics.IsUpgradePreflightCheckFailed("Check2")).To(BeFalse())
+		})
+
+		It("should ignore checks that failed to run", func() {
+			pc := newTested(preflightCheck{
+				name: "ErrCheck",
+				check: func(_ *preflightEnv) (string, error) {
+					return "should be ignored", errors.New("fake error")
+				},
+			})
+
+			Expect(pc.run(req, nil)).To(BeEmpty())
+		})
+
+		It("should run periodic checks only after their interval", func() {
+			messages["Periodic"] = "periodic failed"
+			pc := newTested(newCheck("Periodic", time.Minute), newCheck("Always", 0))
+
+			Expect(pc.run(req, nil)).To(HaveLen(1))
+			Expect(pc.requeueAfter()).To(Equal(time.Minute))
+
+			messages["Periodic"] = ""
+			now = now.Add(30 * time.Second)
+			Expect(pc.run(req, nil)).To(HaveLen(1))
+			Expect(runs).To(Equal(map[string]int{"Periodic": 1, "Always": 2}))
+
+			now = now.Add(30 * time.Second)
+			Expect(pc.run(req, nil)).To(BeEmpty())
+			Expect(runs).To(Equal(map[string]int{"Periodic": 2, "Always": 3}))
+			Expect(pc.requeueAfter()).To(BeZero())
+		})
+	})
+
+	Context("checkUpgradePreflight", func() {
+		var (
+			r             *ReconcileHyperConverged
+			check1Message string
+		)
+
+		BeforeEach(func() {
+			check1Message = "check1 failed"
+			r = &ReconcileHyperConverged{
+				preflightChecker: newPreflightChecker(
+					preflightCheck{name: "Check1", reason: "Check1Failed", check: func(_ *preflightEnv) (string, error) { return check1Message, nil }},
+					preflightCheck{name: "Check2", reason: "Check2Failed", check: func(_ *preflightEnv) (string, error) { return "", nil }},
+					preflightCheck{name: "Check3", reason: "Check3Failed", check: func(_ *preflightEnv) (string, error) { return "check3 failed", nil }},
+				),
+			}
+
+			req.Conditions = common.NewHcoConditions()
+			req.Conditions.SetStatusCondition(metav1.Condition{
+				Type:   hcov1.ConditionUpgradeable,
+				Status: metav1.ConditionTrue,
+				Reason: reconcileCompleted,
+			})
+		})
+
+		It("should set the Upgradeable condition to false, with the reason of the failed check", func() {
+			check1Message = ""
+
+			r.checkUpgradePreflight(req)
+
+			Expect(req.Upgradeable).To(BeFalse())
+			cond, found := req.Conditions.GetCondition(hcov1.ConditionUpgradeable)
+			Expect(found).To(BeTrue())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal("Check3Failed"))
+			Expect(cond.Message).To(Equal("check3 failed"))
+		})
+
+		It("should use the reason of the first failed check, and list the other failed checks in the message", func() {
+			r.checkUpgradePreflight(req)
+
+			Expect(req.Upgradeable).To(BeFalse())
+			cond, found := req.Conditions.GetCondition(hcov1.ConditionUpgradeable)
+			Expect(found).To(BeTrue())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal("Check1Failed"))
+			Expect(cond.Message).To(Equal("check1 failed; other failed pre-flight checks: Check3Failed: check3 failed"))
+		})
+
+		It("should not override a false Upgradeable condition", func() {
+			req.Conditions.SetStatusCondition(metav1.Condition{
+				Type:    hcov1.ConditionUpgradeable,
+				Status:  metav1.ConditionFalse,
+				Reason:  commonDegradedReason,
+				Message: "HCO is not Upgradeable due to degraded components",
+			})
+
+			r.checkUpgradePreflight(req)
+
+			Expect(req.Upgradeable).To(BeFalse())
+			cond, _ := req.Conditions.GetCondition(hcov1.ConditionUpgradeable)
+			Expect(cond.Reason).To(Equal(commonDegradedReason))
+		})
+
+		It("should not run the checks during an upgrade", func() {
+			r.upgradeMode = true
+			req.Upgradeable = true
+
+			r.checkUpgradePreflight(req)
+
+			Expect(req.Upgradeable).To(BeTrue())
+			Expect(r.preflightChecker.results).To(BeEmpty())
+		})
+	})
+})
@@ -58,7 +58,7 @@ func initReconciler(cli client.Client, old *ReconcileHyperConverged) *ReconcileH
 	s := commontestutils.GetScheme()
 	eventEmitter := commontestutils.NewEventEmitterMock()
 	ci := commontestutils.ClusterInfoMock{}
-	operandHandler := operandhandler.NewOperandHandler(cli, s, ci, eventEmitter)
+	operandHandler := operandhandler.NewOperandHandler(cli, cli, s, ci, eventEmitter)
 	upgradeMode := false
 	firstLoop := true
 	upgradeableCondition := newStubOperatorCondition()
@@ -67,10 +67,12 @@ func initReconciler(cli client.Client, old *ReconcileHyperConverged) *ReconcileH
 		firstLoop = old.firstLoop
 		upgradeableCondition = old.upgradeableCondition
 	}
+	sampler := newVMISampler()
 
 	// Create a ReconcileHyperConverged object with the scheme and fake client
 	return &ReconcileHyperConverged{
 		client:               cli,
+		apiReader:            cli,
 		scheme:               s,
 		operandHandler:       operandHandler,
 		eventEmitter:         eventEmitter,
@@ -79,6 +81,9 @@ func initReconciler(cli client.Client, old *ReconcileHyperConverged) *ReconcileH
 		upgradeMode:          upgradeMode,
 		upgradeableCondition: upgradeableCondition,
 		pwdFS:                dirtest.New(),
+		preflightChecker:     newDefaultPreflightChecker(),
+		tuningRecommender:    newTuningRecommender(sampler),
+		migrationPolicies:    newMigrationPolicyCounter(sampler),
 	}
 }
 
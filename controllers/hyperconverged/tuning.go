@@ -0,0 +1,72 @@
+package hyperconverged
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+// updateEffectiveTuningStatus publishes the KubeVirt client rate limits, that are set by the tuning policy, in the
+// HyperConverged status and in the metrics
+func updateEffectiveTuningStatus(req *common.HcoRequest) {
+	tuning, err := handlers.GetEffectiveTuning(req.Instance)
+	if err != nil {
+		// the KubeVirt handler fails for the same reason, and reports it in the conditions
+		req.Logger.Error(err, "failed to read the tuning policy")
+		tuning = nil
+	}
+
+	setTuningMetrics(tuning)
+
+	if !equality.Semantic.DeepEqual(tuning, req.Instance.Status.EffectiveTuning) {
+		req.Instance.Status.EffectiveTuning = tuning
+		req.StatusDirty = true
+	}
+}
+
+func setTuningMetrics(tuning *hcov1.EffectiveTuning) {
+	metrics.ResetKubeVirtClientRateLimits()
+	if tuning == nil {
+		return
+	}
+
+	for _, rl := range []struct {
+		component string
+		rateLimit *hcov1.EffectiveRateLimit
+	}{
+		{component: "virt-api", rateLimit: tuning.API},
+		{component: "virt-controller", rateLimit: tuning.Controller},
+		{component: "virt-handler", rateLimit: tuning.Handler},
+		{component: "virt-webhook", rateLimit: tuning.Webhook},
+	} {
+		if rl.rateLimit != nil {
+			metrics.SetKubeVirtClientRateLimit(rl.component, rl.rateLimit.QPS.AsApproximateFloat64(), float64(rl.rateLimit.Burst))
+		}
+	}
+}
+
+type componentRateLimit struct {
+	component string
+	rateLimit *hcov1.RateLimit
+}
+
+// getRateLimitsByComponent returns the rate limits that are set in the tuning config, with the name of their
+// KubeVirt component
+func getRateLimitsByComponent(tuning hcov1.TuningConfig) []componentRateLimit {
+	var rateLimits []componentRateLimit
+	for _, rl := range []componentRateLimit{
+		{component: "virt-api", rateLimit: tuning.API},
+		{component: "virt-controller", rateLimit: tuning.Controller},
+		{component: "virt-handler", rateLimit: tuning.Handler},
+		{component: "virt-webhook", rateLimit: tuning.Webhook},
+	} {
+		if rl.rateLimit != nil {
+			rateLimits = append(rateLimits, rl)
+		}
+	}
+
+	return rateLimits
+}
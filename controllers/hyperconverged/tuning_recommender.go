@@ -0,0 +1,193 @@
+package hyperconverged
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
+)
+
+const (
+	// the cluster size thresholds of the tuning profiles
+	mediumClusterNodes = 20
+	mediumClusterVMIs  = 500
+	largeClusterNodes  = 100
+	largeClusterVMIs   = 2000
+	// the cluster size must drop this much below the thresholds of the current profile, before a smaller profile is
+	// recommended, so the recommendation does not flip when the cluster size is around a threshold
+	tuningHysteresisPercent = 10
+
+	// virt-controller sends more requests to the API server for each concurrent live migration
+	qpsPerParallelMigration   = 5
+	burstPerParallelMigration = 10
+
+	// the KubeVirt defaults, when spec.virtualization.liveMigrationConfig does not set the parallelism
+	defaultParallelMigrationsPerCluster      = 5
+	defaultParallelOutboundMigrationsPerNode = 2
+
+	// the recommended live migration parallelism
+	minRecommendedParallelMigrationsPerCluster = 5
+	maxRecommendedParallelMigrationsPerCluster = 50
+	denseNodeVMIs                              = 50
+
+	tuningRecommendationMatchingReason    = "Matching"
+	tuningRecommendationNotMatchingReason = "NotMatching"
+	tuningRecommendationAppliedReason     = "AutoTuning"
+)
+
+// tuningRecommender recommends the KubeVirt client rate limits and the live migration parallelism, according to the
+// number of the workload nodes and to the last sample of the VMIs
+type tuningRecommender struct {
+	sampler *vmiSampler
+}
+
+func newTuningRecommender(sampler *vmiSampler) *tuningRecommender {
+	return &tuningRecommender{
+		sampler: sampler,
+	}
+}
+
+// update publishes the tuning recommendation in the HyperConverged status, in the TuningRecommendation condition and
+// in the metrics. Nothing is published until the VMIs are sampled for the first time.
+func (tr *tuningRecommender) update(req *common.HcoRequest, reader client.Reader) {
+	vmis, _, ok := tr.sampler.getVMIs(req, reader)
+	if !ok {
+		return
+	}
+
+	var currentProfile hcov1.HyperConvergedTuningPolicy
+	if req.Instance.Status.TuningRecommendation != nil {
+		currentProfile = req.Instance.Status.TuningRecommendation.Profile
+	}
+
+	recommendation := recommendTuning(nodeinfo.GetWorkloadNodeCount(), int32(len(vmis)), req.Instance.Spec.Virtualization.LiveMigrationConfig, currentProfile)
+
+	setTuningRecommendationMetrics(recommendation)
+
+	if !equality.Semantic.DeepEqual(recommendation, req.Instance.Status.TuningRecommendation) {
+		req.Instance.Status.TuningRecommendation = recommendation
+		req.StatusDirty = true
+	}
+
+	setTuningRecommendationCondition(req, recommendation)
+}
+
+// requeueAfter returns the sample interval if the recommendation is applied, so it follows the cluster size, or zero
+// otherwise
+func (tr *tuningRecommender) requeueAfter(hc *hcov1.HyperConverged) time.Duration {
+	if hc.Spec.Virtualization.TuningPolicy == hcov1.HyperConvergedAutoTuningPolicy {
+		return tr.sampler.requeueAfter()
+	}
+	return 0
+}
+
+func recommendTuning(nodes, vmis int32, lmConfig hcov1.LiveMigrationConfigurations, currentProfile hcov1.HyperConvergedTuningPolicy) *hcov1.TuningRecommendation {
+	parallelMigrations := int32(ptr.Deref(lmConfig.ParallelMigrationsPerCluster, defaultParallelMigrationsPerCluster))
+	if nodeMigrations := nodes * int32(ptr.Deref(lmConfig.ParallelOutboundMigrationsPerNode, defaultParallelOutboundMigrationsPerNode)); nodeMigrations < parallelMigrations {
+		parallelMigrations = nodeMigrations
+	}
+
+	profile := selectTuningProfile(nodes, vmis, currentProfile)
+
+	rateLimits, _ := handlers.GetTuningProfile(profile)
+	rateLimits.Controller.QPS += qpsPerParallelMigration * parallelMigrations
+	rateLimits.Controller.Burst += burstPerParallelMigration * parallelMigrations
+
+	perNode := uint32(1)
+	if nodes > 0 && vmis/nodes >= denseNodeVMIs {
+		// draining a dense node takes long, if its VMs are migrated one by one
+		perNode = 2
+	}
+
+	return &hcov1.TuningRecommendation{
+		Nodes:                             nodes,
+		VMIs:                              vmis,
+		CurrentParallelMigrations:         parallelMigrations,
+		Profile:                           profile,
+		RateLimits:                        rateLimits,
+		ParallelMigrationsPerCluster:      uint32(min(max(nodes/2, minRecommendedParallelMigrationsPerCluster), maxRecommendedParallelMigrationsPerCluster)),
+		ParallelOutboundMigrationsPerNode: perNode,
+	}
+}
+
+// selectTuningProfile returns the predefined tuning profile that matches the cluster size. The thresholds of the
+// current profile, and of the smaller profiles, are lowered by tuningHysteresisPercent, so the current profile is kept
+// until the cluster size drops clearly below its thresholds.
+func selectTuningProfile(nodes, vmis int32, currentProfile hcov1.HyperConvergedTuningPolicy) hcov1.HyperConvergedTuningPolicy {
+	exceeds := func(nodeThreshold, vmiThreshold int32, isCurrentOrSmaller bool) bool {
+		if isCurrentOrSmaller {
+			nodeThreshold -= nodeThreshold * tuningHysteresisPercent / 100
+			vmiThreshold -= vmiThreshold * tuningHysteresisPercent / 100
+		}
+		return nodes >= nodeThreshold || vmis >= vmiThreshold
+	}
+
+	isLarge := currentProfile == hcov1.HyperConvergedLargeTuningPolicy
+	isMedium := currentProfile == hcov1.HyperConvergedMediumTuningPolicy
+
+	switch {
+	case exceeds(largeClusterNodes, largeClusterVMIs, isLarge):
+		return hcov1.HyperConvergedLargeTuningPolicy
+	case exceeds(mediumClusterNodes, mediumClusterVMIs, isLarge || isMedium):
+		return hcov1.HyperConvergedMediumTuningPolicy
+	}
+
+	return hcov1.HyperConvergedSmallTuningPolicy
+}
+
+func setTuningRecommendationCondition(req *common.HcoRequest, recommendation *hcov1.TuningRecommendation) {
+	cond := metav1.Condition{
+		Type:               hcov1.ConditionTuningRecommendation,
+		Status:             metav1.ConditionTrue,
+		Reason:             tuningRecommendationMatchingReason,
+		Message:            getTuningRecommendationMessage(recommendation),
+		ObservedGeneration: req.Instance.Generation,
+	}
+
+	if req.Instance.Spec.Virtualization.TuningPolicy == hcov1.HyperConvergedAutoTuningPolicy {
+		cond.Reason = tuningRecommendationAppliedReason
+	} else if effective, _ := handlers.GetEffectiveTuning(req.Instance); effective == nil || !equality.Semantic.DeepEqual(effective, handlers.ToEffectiveTuning(effective.Policy, recommendation.RateLimits)) {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = tuningRecommendationNotMatchingReason
+	}
+
+	if apimetav1.SetStatusCondition(&req.Instance.Status.Conditions, cond) {
+		req.StatusDirty = true
+	}
+}
+
+func getTuningRecommendationMessage(recommendation *hcov1.TuningRecommendation) string {
+	rateLimits := getRateLimitsByComponent(recommendation.RateLimits)
+	limits := make([]string, 0, len(rateLimits))
+	for _, rl := range rateLimits {
+		limits = append(limits, fmt.Sprintf("%s: qps=%d, burst=%d", rl.component, rl.rateLimit.QPS, rl.rateLimit.Burst))
+	}
+
+	return fmt.Sprintf(
+		"recommended for %d nodes, %d VMIs and %d parallel migrations; profile: %s; rate limits: %s; live migration: parallelMigrationsPerCluster=%d, parallelOutboundMigrationsPerNode=%d",
+		recommendation.Nodes, recommendation.VMIs, recommendation.CurrentParallelMigrations, recommendation.Profile,
+		strings.Join(limits, "; "), recommendation.ParallelMigrationsPerCluster, recommendation.ParallelOutboundMigrationsPerNode,
+	)
+}
+
+func setTuningRecommendationMetrics(recommendation *hcov1.TuningRecommendation) {
+	for _, rl := range getRateLimitsByComponent(recommendation.RateLimits) {
+		metrics.SetRecommendedKubeVirtClientRateLimit(rl.component, float64(rl.rateLimit.QPS), float64(rl.rateLimit.Burst))
+	}
+
+	metrics.SetRecommendedMigrationParallelism(
+		float64(recommendation.ParallelMigrationsPerCluster),
+		float64(recommendation.ParallelOutboundMigrationsPerNode),
+	)
+}
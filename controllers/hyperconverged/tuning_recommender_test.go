@@ -0,0 +1,247 @@
+package hyperconverged
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
+)
+
+var _ = Describe("Tuning recommendations", func() {
+	var (
+		hco *hcov1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+	})
+
+	genVMIs := func(count int) []client.Object {
+		vmis := make([]client.Object, 0, count)
+		for i := range count {
+			vmis = append(vmis, &kubevirtcorev1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("vmi-%d", i),
+					Namespace: "vms",
+				},
+			})
+		}
+		return vmis
+	}
+
+	setWorkloadNodes := func(workers int) {
+		GinkgoHelper()
+		nodes := make([]client.Object, 0, workers)
+		for i := range workers {
+			nodes = append(nodes, &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   fmt.Sprintf("worker-%d", i),
+					Labels: map[string]string{nodeinfo.LabelNodeRoleWorker: ""},
+				},
+			})
+		}
+
+		_, err := nodeinfo.HandleNodeChanges(context.Background(), commontestutils.InitClient(nodes), hco, GinkgoLogr)
+		Expect(err).ToNot(HaveOccurred())
+
+		DeferCleanup(func() {
+			_, err := nodeinfo.HandleNodeChanges(context.Background(), commontestutils.InitClient(nil), hco, GinkgoLogr)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	}
+
+	Context("recommendTuning", func() {
+		defaultLMConfig := hcov1.LiveMigrationConfigurations{
+			ParallelMigrationsPerCluster:      new(uint32(5)),
+			ParallelOutboundMigrationsPerNode: new(uint32(2)),
+		}
+
+		DescribeTable("should recommend the rate limits according to the cluster size", func(nodes, vmis int32, apiQPS, controllerQPS, handlerQPS int32) {
+			recommendation := recommendTuning(nodes, vmis, defaultLMConfig, "")
+
+			Expect(recommendation.Nodes).To(Equal(nodes))
+			Expect(recommendation.VMIs).To(Equal(vmis))
+			Expect(recommendation.RateLimits.API.QPS).To(Equal(apiQPS))
+			Expect(recommendation.RateLimits.Controller.QPS).To(Equal(controllerQPS))
+			Expect(recommendation.RateLimits.Handler.QPS).To(Equal(handlerQPS))
+		},
+			Entry("small cluster", int32(3), int32(50), int32(100), int32(125), int32(5)),
+			Entry("medium cluster by nodes", int32(30), int32(50), int32(200), int32(225), int32(25)),
+			Entry("medium cluster by VMIs", int32(3), int32(600), int32(200), int32(225), int32(25)),
+			Entry("large cluster by nodes", int32(150), int32(50), int32(400), int32(425), int32(50)),
+			Entry("large cluster by VMIs", int32(10), int32(3000), int32(400), int32(425), int32(50)),
+		)
+
+		DescribeTable("should keep the current profile until the cluster size drops clearly below its thresholds", func(nodes, vmis int32, currentProfile, expectedProfile hcov1.HyperConvergedTuningPolicy) {
+			recommendation := recommendTuning(nodes, vmis, defaultLMConfig, currentProfile)
+			Expect(recommendation.Profile).To(Equal(expectedProfile))
+		},
+			Entry("small, below the medium thresholds", int32(3), int32(480), hcov1.HyperConvergedSmallTuningPolicy, hcov1.HyperConvergedSmallTuningPolicy),
+			Entry("small, above the medium thresholds", int32(3), int32(500), hcov1.HyperConvergedSmallTuningPolicy, hcov1.HyperConvergedMediumTuningPolicy),
+			Entry("medium, slightly below the medium thresholds", int32(3), int32(480), hcov1.HyperConvergedMediumTuningPolicy, hcov1.HyperConvergedMediumTuningPolicy),
+			Entry("medium, clearly below the medium thresholds", int32(3), int32(440), hcov1.HyperConvergedMediumTuningPolicy, hcov1.HyperConvergedSmallTuningPolicy),
+			Entry("medium, slightly below the medium nodes threshold", int32(18), int32(50), hcov1.HyperConvergedMediumTuningPolicy, hcov1.HyperConvergedMediumTuningPolicy),
+			Entry("medium, above the large thresholds", int32(3), int32(2000), hcov1.HyperConvergedMediumTuningPolicy, hcov1.HyperConvergedLargeTuningPolicy),
+			Entry("large, slightly below the large thresholds", int32(3), int32(1900), hcov1.HyperConvergedLargeTuningPolicy, hcov1.HyperConvergedLargeTuningPolicy),
+			Entry("large, clearly below the large thresholds", int32(3), int32(1700), hcov1.HyperConvergedLargeTuningPolicy, hcov1.HyperConvergedMediumTuningPolicy),
+			Entry("large, slightly below the medium thresholds", int32(3), int32(480), hcov1.HyperConvergedLargeTuningPolicy, hcov1.HyperConvergedMediumTuningPolicy),
+			Entry("large, clearly below the medium thresholds", int32(3), int32(440), hcov1.HyperConvergedLargeTuningPolicy, hcov1.HyperConvergedSmallTuningPolicy),
+		)
+
+		It("should take the parallel migrations from the live migration configuration", func() {
+			lmConfig := hcov1.LiveMigrationConfigurations{
+				ParallelMigrationsPerCluster:      new(uint32(20)),
+				ParallelOutboundMigrationsPerNode: new(uint32(1)),
+			}
+
+			recommendation := recommendTuning(30, 50, lmConfig, "")
+			Expect(recommendation.CurrentParallelMigrations).To(Equal(int32(20)))
+			Expect(recommendation.RateLimits.Controller).To(Equal(&hcov1.RateLimit{QPS: 200 + 20*5, Burst: 400 + 20*10}))
+		})
+
+		It("should limit the parallel migrations by the number of the nodes", func() {
+			lmConfig := hcov1.LiveMigrationConfigurations{
+				ParallelMigrationsPerCluster:      new(uint32(20)),
+				ParallelOutboundMigrationsPerNode: new(uint32(1)),
+			}
+
+			recommendation := recommendTuning(3, 50, lmConfig, "")
+			Expect(recommendation.CurrentParallelMigrations).To(Equal(int32(3)))
+		})
+
+		It("should use the KubeVirt defaults if the live migration parallelism is not set", func() {
+			recommendation := recommendTuning(30, 50, hcov1.LiveMigrationConfigurations{}, "")
+			Expect(recommendation.CurrentParallelMigrations).To(Equal(int32(defaultParallelMigrationsPerCluster)))
+		})
+
+		DescribeTable("should recommend the live migration parallelism", func(nodes, vmis int32, perCluster, perNode uint32) {
+			recommendation := recommendTuning(nodes, vmis, defaultLMConfig, "")
+			Expect(recommendation.ParallelMigrationsPerCluster).To(Equal(perCluster))
+			Expect(recommendation.ParallelOutboundMigrationsPerNode).To(Equal(perNode))
+		},
+			Entry("no nodes", int32(0), int32(0), uint32(5), uint32(1)),
+			Entry("small cluster", int32(3), int32(20), uint32(5), uint32(1)),
+			Entry("medium cluster", int32(30), int32(300), uint32(15), uint32(1)),
+			Entry("large cluster", int32(300), int32(3000), uint32(50), uint32(1)),
+			Entry("dense nodes", int32(10), int32(600), uint32(5), uint32(2)),
+		)
+	})
+
+	Context("update", func() {
+		var (
+			tr  *tuningRecommender
+			now time.Time
+		)
+
+		BeforeEach(func() {
+			now = time.Now()
+			sampler := newVMISampler()
+			sampler.now = func() time.Time { return now }
+			tr = newTuningRecommender(sampler)
+		})
+
+		It("should publish the recommendation in the status, the condition and the metrics", func() {
+			setWorkloadNodes(3)
+
+			tr.update(req, commontestutils.InitClient(genVMIs(4)))
+
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(hco.Status.TuningRecommendation).ToNot(BeNil())
+			Expect(hco.Status.TuningRecommendation.Nodes).To(Equal(int32(3)))
+			Expect(hco.Status.TuningRecommendation.VMIs).To(Equal(int32(4)))
+
+			cond := apimetav1.FindStatusCondition(hco.Status.Conditions, hcov1.ConditionTuningRecommendation)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(tuningRecommendationNotMatchingReason))
+			Expect(cond.Message).To(ContainSubstring("recommended for 3 nodes, 4 VMIs"))
+			Expect(cond.Message).To(ContainSubstring("profile: small"))
+			Expect(cond.Message).To(ContainSubstring("virt-handler: qps=5, burst=10"))
+
+			qps, burst, err := metrics.GetRecommendedKubeVirtClientRateLimit("virt-api")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(qps).To(BeEquivalentTo(100))
+			Expect(burst).To(BeEquivalentTo(200))
+
+			perCluster, perNode, err := metrics.GetRecommendedMigrationParallelism()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(perCluster).To(BeEquivalentTo(5))
+			Expect(perNode).To(BeEquivalentTo(1))
+		})
+
+		It("should keep the profile of the previous recommendation", func() {
+			tr.update(req, commontestutils.InitClient(genVMIs(mediumClusterVMIs)))
+			Expect(hco.Status.TuningRecommendation.Profile).To(Equal(hcov1.HyperConvergedMediumTuningPolicy))
+
+			now = now.Add(vmiSampleInterval)
+			tr.update(req, commontestutils.InitClient(genVMIs(mediumClusterVMIs-1)))
+			Expect(hco.Status.TuningRecommendation.VMIs).To(Equal(int32(mediumClusterVMIs - 1)))
+			Expect(hco.Status.TuningRecommendation.Profile).To(Equal(hcov1.HyperConvergedMediumTuningPolicy))
+		})
+
+		It("should sample the VMIs only once in the sample interval", func() {
+			tr.update(req, commontestutils.InitClient(genVMIs(4)))
+			Expect(hco.Status.TuningRecommendation.VMIs).To(Equal(int32(4)))
+
+			now = now.Add(vmiSampleInterval / 2)
+			tr.update(req, commontestutils.InitClient(genVMIs(8)))
+			Expect(hco.Status.TuningRecommendation.VMIs).To(Equal(int32(4)))
+
+			now = now.Add(vmiSampleInterval)
+			tr.update(req, commontestutils.InitClient(genVMIs(8)))
+			Expect(hco.Status.TuningRecommendation.VMIs).To(Equal(int32(8)))
+		})
+
+		It("should report that the rate limits match the recommendation", func() {
+			tr.update(req, commontestutils.InitClient(nil))
+
+			hco.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedCustomTuningPolicy
+			hco.Spec.Virtualization.Tuning = hco.Status.TuningRecommendation.RateLimits.DeepCopy()
+			tr.update(req, commontestutils.InitClient(nil))
+
+			cond := apimetav1.FindStatusCondition(hco.Status.Conditions, hcov1.ConditionTuningRecommendation)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(tuningRecommendationMatchingReason))
+		})
+
+		It("should apply the recommendation with the auto tuning policy", func() {
+			hco.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedAutoTuningPolicy
+
+			tr.update(req, commontestutils.InitClient(genVMIs(4)))
+			updateEffectiveTuningStatus(req)
+
+			cond := apimetav1.FindStatusCondition(hco.Status.Conditions, hcov1.ConditionTuningRecommendation)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(tuningRecommendationAppliedReason))
+
+			Expect(hco.Status.EffectiveTuning).ToNot(BeNil())
+			Expect(hco.Status.EffectiveTuning.Policy).To(Equal(hcov1.HyperConvergedAutoTuningPolicy))
+			Expect(hco.Status.EffectiveTuning).To(Equal(handlers.ToEffectiveTuning(hcov1.HyperConvergedAutoTuningPolicy, hco.Status.TuningRecommendation.RateLimits)))
+
+			Expect(tr.requeueAfter(hco)).To(Equal(vmiSampleInterval))
+		})
+
+		It("should not requeue if the auto tuning policy is not used", func() {
+			Expect(tr.requeueAfter(hco)).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,92 @@
+package hyperconverged
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+var _ = Describe("test the effective tuning status", func() {
+	It("should not set the status if no tuning policy is used", func() {
+		hco := commontestutils.NewHco()
+		req := commontestutils.NewReq(hco)
+
+		updateEffectiveTuningStatus(req)
+
+		Expect(req.StatusDirty).To(BeFalse())
+		Expect(hco.Status.EffectiveTuning).To(BeNil())
+	})
+
+	It("should report the rate limits of the tuning policy", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedCustomTuningPolicy
+		hco.Spec.Virtualization.Tuning = &hcov1.TuningConfig{
+			Controller: &hcov1.RateLimit{QPS: 150, Burst: 300},
+		}
+		req := commontestutils.NewReq(hco)
+
+		updateEffectiveTuningStatus(req)
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.EffectiveTuning).To(Equal(&hcov1.EffectiveTuning{
+			Policy:     hcov1.HyperConvergedCustomTuningPolicy,
+			Controller: &hcov1.EffectiveRateLimit{QPS: *resource.NewQuantity(150, resource.DecimalSI), Burst: 300},
+		}))
+
+		qps, burst, err := metrics.GetKubeVirtClientRateLimit("virt-controller")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(qps).To(BeEquivalentTo(150))
+		Expect(burst).To(BeEquivalentTo(300))
+
+		req.StatusDirty = false
+		updateEffectiveTuningStatus(req)
+		Expect(req.StatusDirty).To(BeFalse())
+	})
+
+	It("should report the exact fractional QPS of the annotation tuning policy", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedAnnotationTuningPolicy
+		hco.Annotations = map[string]string{common.TuningPolicyAnnotationName: `{"qps": 0.5, "burst": 2}`}
+		req := commontestutils.NewReq(hco)
+
+		updateEffectiveTuningStatus(req)
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.EffectiveTuning).ToNot(BeNil())
+		Expect(hco.Status.EffectiveTuning.API).ToNot(BeNil())
+		Expect(hco.Status.EffectiveTuning.API.QPS.String()).To(Equal("500m"))
+
+		qps, burst, err := metrics.GetKubeVirtClientRateLimit("virt-handler")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(qps).To(Equal(0.5))
+		Expect(burst).To(BeEquivalentTo(2))
+	})
+
+	It("should remove the status if the tuning policy was removed", func() {
+		hco := commontestutils.NewHco()
+		hco.Status.EffectiveTuning = &hcov1.EffectiveTuning{
+			Policy: hcov1.HyperConvergedLargeTuningPolicy,
+		}
+		req := commontestutils.NewReq(hco)
+
+		updateEffectiveTuningStatus(req)
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.EffectiveTuning).To(BeNil())
+	})
+
+	It("should not set the status if the tuning policy is invalid", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedCustomTuningPolicy
+		req := commontestutils.NewReq(hco)
+
+		updateEffectiveTuningStatus(req)
+
+		Expect(hco.Status.EffectiveTuning).To(BeNil())
+	})
+})
@@ -0,0 +1,87 @@
+package hyperconverged
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/uninstall"
+)
+
+const (
+	// listing the workloads is expensive, so the UninstallBlocked condition is updated less frequently
+	uninstallBlockedCheckInterval = 10 * time.Minute
+
+	uninstallBlockedReason    = "WorkloadsExist"
+	uninstallNotBlockedReason = "NoWorkloads"
+)
+
+// reportUninstallBlocked sets the UninstallBlocked condition, with the list of the workloads that block the
+// uninstallation, if the uninstall strategy is BlockUninstallAndReportWorkloads. Otherwise, it removes the condition.
+func (r *ReconcileHyperConverged) reportUninstallBlocked(req *common.HcoRequest) {
+	if req.Instance.Spec.Deployment.UninstallStrategy != hcov1.HyperConvergedUninstallStrategyBlockUninstallAndReportWorkloads {
+		r.uninstallBlockedLastCheck = time.Time{}
+		if apimetav1.FindStatusCondition(req.Instance.Status.Conditions, hcov1.ConditionUninstallBlocked) != nil {
+			apimetav1.RemoveStatusCondition(&req.Instance.Status.Conditions, hcov1.ConditionUninstallBlocked)
+			req.StatusDirty = true
+		}
+		return
+	}
+
+	now := time.Now()
+	if apimetav1.FindStatusCondition(req.Instance.Status.Conditions, hcov1.ConditionUninstallBlocked) != nil &&
+		now.Sub(r.uninstallBlockedLastCheck) < uninstallBlockedCheckInterval {
+		return
+	}
+
+	workloads, err := uninstall.ListWorkloads(req.Ctx, r.apiReader, req.Instance.Spec.WorkloadSources.CommonBootImageNamespace)
+	if err != nil {
+		req.Logger.Error(err, "failed to list the workloads that block the uninstallation")
+		return
+	}
+	r.uninstallBlockedLastCheck = now
+
+	cond := metav1.Condition{
+		Type:               hcov1.ConditionUninstallBlocked,
+		Status:             metav1.ConditionFalse,
+		Reason:             uninstallNotBlockedReason,
+		Message:            "No workloads block the uninstallation",
+		ObservedGeneration: req.Instance.Generation,
+	}
+
+	if len(workloads) > 0 {
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = uninstallBlockedReason
+		cond.Message = "The following workloads block the uninstallation: " + uninstall.FormatWorkloads(workloads)
+	}
+
+	if apimetav1.SetStatusCondition(&req.Instance.Status.Conditions, cond) {
+		req.StatusDirty = true
+	}
+}
+
+// backupWorkloads stores the manifests of the workloads before removing them, if the workloads backup is enabled.
+// The uninstallation does not proceed until the backup succeeds.
+func (r *ReconcileHyperConverged) backupWorkloads(req *common.HcoRequest) error {
+	if !uninstall.IsBackupEnabled(req.Instance) {
+		return nil
+	}
+
+	created, err := uninstall.BackupWorkloads(req.Ctx, r.client, r.apiReader, req.Instance)
+	if err != nil {
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "WorkloadsBackupFailed", fmt.Sprintf("Failed to back up the workloads; the uninstallation is blocked: %v", err))
+		return err
+	}
+
+	if created {
+		req.Logger.Info("backed up the workloads before removing them", "index", uninstall.BackupIndexName)
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "WorkloadsBackup", "Backed up the workloads before removing them; see the "+uninstall.BackupIndexName+" index")
+	}
+
+	return nil
+}
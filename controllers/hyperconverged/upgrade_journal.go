@@ -0,0 +1,249 @@
+package hyperconverged
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/upgradepatch"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// UpgradeJournalLabel is set on all the upgrade journal ConfigMaps
+	UpgradeJournalLabel = "hco.kubevirt.io/upgrade-journal"
+
+	upgradeJournalNamePrefix = "kubevirt-hyperconverged-upgrade-journal-"
+
+	// maxUpgradeJournals is the number of upgrade journals to keep. The oldest journals are removed.
+	maxUpgradeJournals = 5
+
+	upgradeJournalFromVersionKey        = "fromVersion"
+	upgradeJournalToVersionKey          = "toVersion"
+	upgradeJournalSpecKey               = "hyperConvergedSpec"
+	upgradeJournalOperandsKey           = "operands"
+	upgradeJournalPatchesKey            = "upgradePatches"
+	upgradeJournalObjectsToBeRemovedKey = "objectsToBeRemoved"
+)
+
+// upgradeJournalOperandKinds are the operand CRs to record in the upgrade journal
+var upgradeJournalOperandKinds = []string{
+	"KubeVirt",
+	"CDI",
+	"NetworkAddonsConfig",
+	"SSP",
+	"AAQ",
+	"MigController",
+	"FileRestoreOperator",
+}
+
+var invalidJournalNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// upgradeJournalOperand is the spec of an operand CR, as it was before the upgrade
+type upgradeJournalOperand struct {
+	APIVersion string          `json:"apiVersion"`
+	Kind       string          `json:"kind"`
+	Name       string          `json:"name"`
+	Namespace  string          `json:"namespace,omitempty"`
+	Spec       json.RawMessage `json:"spec,omitempty"`
+}
+
+// ensureUpgradeJournal records the state before the upgrade, if not already recorded, and points to the upgrade
+// journal from the operator version in the HyperConverged status. The journal is created only once for each upgrade,
+// before any change is done, and it is never modified later.
+func (r *ReconcileHyperConverged) ensureUpgradeJournal(req *common.HcoRequest) error {
+	knownHcoVersion, _ := GetVersion(&req.Instance.Status, hcoVersionName)
+	name := getUpgradeJournalName(knownHcoVersion, r.ownVersion)
+
+	if getUpgradeJournal(&req.Instance.Status, hcoVersionName) == name {
+		return nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	err := r.client.Get(req.Ctx, client.ObjectKey{Name: name, Namespace: req.Instance.Namespace}, cm)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		if cm, err = r.newUpgradeJournal(req, name, knownHcoVersion); err != nil {
+			return err
+		}
+
+		if err = r.client.Create(req.Ctx, cm); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+
+		req.Logger.Info("created the upgrade journal", "name", name)
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "UpgradeJournal", "Recorded the state before the upgrade in the "+name+" ConfigMap")
+
+		if err = r.removeOldUpgradeJournals(req, name); err != nil {
+			req.Logger.Error(err, "failed to remove old upgrade journals")
+		}
+	}
+
+	// when upgrading from a very old version, there is no operator version yet. The journal will be set when the
+	// upgrade is completed.
+	if setUpgradeJournal(&req.Instance.Status, hcoVersionName, name) {
+		req.StatusDirty = true
+	}
+
+	return nil
+}
+
+func (r *ReconcileHyperConverged) newUpgradeJournal(req *common.HcoRequest, name, knownHcoVersion string) (*corev1.ConfigMap, error) {
+	specJSON, err := json.Marshal(req.Instance.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	operandsJSON, err := r.getUpgradeJournalOperands(req)
+	if err != nil {
+		return nil, err
+	}
+
+	knownHcoSV, err := getKnownHcoSemver(knownHcoVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	simulation, err := upgradepatch.SimulateUpgradePatch(req.Instance, knownHcoSV)
+	if err != nil {
+		return nil, err
+	}
+
+	patchesJSON, err := json.Marshal(simulation.Patches)
+	if err != nil {
+		return nil, err
+	}
+
+	objectsToBeRemovedJSON, err := json.Marshal(simulation.ObjectsToBeRemoved)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := hcoutil.GetLabels(req.Instance.Name, hcoutil.AppComponentDeployment)
+	labels[UpgradeJournalLabel] = "true"
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: req.Instance.Namespace,
+			Labels:    labels,
+		},
+		Data: map[string]string{
+			upgradeJournalFromVersionKey:        knownHcoVersion,
+			upgradeJournalToVersionKey:          r.ownVersion,
+			upgradeJournalSpecKey:               string(specJSON),
+			upgradeJournalOperandsKey:           string(operandsJSON),
+			upgradeJournalPatchesKey:            string(patchesJSON),
+			upgradeJournalObjectsToBeRemovedKey: string(objectsToBeRemovedJSON),
+		},
+	}
+
+	if err = controllerutil.SetControllerReference(req.Instance, cm, r.scheme); err != nil {
+		return nil, err
+	}
+
+	return cm, nil
+}
+
+// getUpgradeJournalOperands reads the specs of the operand CRs from the related objects list
+func (r *ReconcileHyperConverged) getUpgradeJournalOperands(req *common.HcoRequest) ([]byte, error) {
+	operands := make([]upgradeJournalOperand, 0, len(upgradeJournalOperandKinds))
+	for _, ref := range req.Instance.Status.RelatedObjects {
+		if !slices.Contains(upgradeJournalOperandKinds, ref.Kind) {
+			continue
+		}
+
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(ref.GroupVersionKind())
+		if err := r.client.Get(req.Ctx, client.ObjectKey{Name: ref.Name, Namespace: ref.Namespace}, u); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		operand := upgradeJournalOperand{
+			APIVersion: ref.APIVersion,
+			Kind:       ref.Kind,
+			Name:       ref.Name,
+			Namespace:  ref.Namespace,
+		}
+
+		if spec, found := u.Object["spec"]; found {
+			specJSON, err := json.Marshal(spec)
+			if err != nil {
+				return nil, err
+			}
+			operand.Spec = specJSON
+		}
+
+		operands = append(operands, operand)
+	}
+
+	return json.Marshal(operands)
+}
+
+// removeOldUpgradeJournals keeps only the most recent upgrade journals
+func (r *ReconcileHyperConverged) removeOldUpgradeJournals(req *common.HcoRequest, current string) error {
+	cmList := &corev1.ConfigMapList{}
+	err := r.client.List(req.Ctx, cmList, client.InNamespace(req.Instance.Namespace), client.HasLabels{UpgradeJournalLabel})
+	if err != nil {
+		return err
+	}
+
+	journals := slices.DeleteFunc(cmList.Items, func(cm corev1.ConfigMap) bool {
+		return cm.Name == current
+	})
+
+	// keep room for the current journal
+	if len(journals) < maxUpgradeJournals {
+		return nil
+	}
+
+	slices.SortFunc(journals, func(a, b corev1.ConfigMap) int {
+		if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, cm := range journals[:len(journals)-maxUpgradeJournals+1] {
+		if err = r.client.Delete(req.Ctx, &cm); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		req.Logger.Info("removed an old upgrade journal", "name", cm.Name)
+	}
+
+	return nil
+}
+
+func getKnownHcoSemver(knownHcoVersion string) (semver.Version, error) {
+	if knownHcoVersion == "" {
+		knownHcoVersion = "0.0.0"
+	}
+
+	return semver.ParseTolerant(knownHcoVersion)
+}
+
+func getUpgradeJournalName(fromVersion, toVersion string) string {
+	if fromVersion == "" {
+		fromVersion = "unknown"
+	}
+
+	name := fmt.Sprintf("%s%s-to-%s", upgradeJournalNamePrefix, fromVersion, toVersion)
+	return strings.Trim(invalidJournalNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-.")
+}
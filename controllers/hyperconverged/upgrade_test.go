@@ -3,9 +3,11 @@ package hyperconverged
 import (
 	"cmp"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"slices"
+	"time"
 
 	"github.com/blang/semver/v4"
 	. "github.com/onsi/ginkgo/v2"
@@ -33,6 +35,7 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/reqresolver"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	fakeownresources "github.com/kubevirt/hyperconverged-cluster-operator/pkg/ownresources/fake"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/upgradepatch"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 	"github.com/kubevirt/hyperconverged-cluster-operator/version"
 )
@@ -631,6 +634,128 @@ var _ = Describe("Upgrade Mode", func() {
 		})
 	})
 
+	Context("upgrade journal", func() {
+		getJournal := func(cl client.Client, name string) *corev1.ConfigMap {
+			GinkgoHelper()
+			cm := &corev1.ConfigMap{}
+			Expect(cl.Get(context.TODO(), client.ObjectKey{Name: name, Namespace: expected.hco.Namespace}, cm)).To(Succeed())
+			return cm
+		}
+
+		It("should record the state before the upgrade", func() {
+			UpdateVersion(&expected.hco.Status, hcoVersionName, "1.16.5")
+			expected.hco.Spec.Virtualization.VirtualMachineOptions.DisableFreePageReporting = new(false)
+
+			kvRef, err := reference.GetReference(commontestutils.GetScheme(), expected.kv)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objectreferencesv1.SetObjectReference(&expected.hco.Status.RelatedObjects, *kvRef)).To(Succeed())
+
+			journalName := getUpgradeJournalName("1.16.5", newHCOVersion)
+
+			cl := expected.initClient()
+			foundResource, reconciler, requeue := doReconcile(cl, expected.hco, nil)
+			Expect(requeue).To(BeTrue())
+			Expect(getUpgradeJournal(&foundResource.Status, hcoVersionName)).To(Equal(journalName))
+			Expect(foundResource.Spec.Virtualization.VirtualMachineOptions.DisableFreePageReporting).To(HaveValue(BeTrue()))
+
+			cm := getJournal(cl, journalName)
+			Expect(cm.Labels).To(HaveKeyWithValue(UpgradeJournalLabel, "true"))
+			Expect(cm.OwnerReferences).To(HaveLen(1))
+			Expect(cm.Data).To(HaveKeyWithValue(upgradeJournalFromVersionKey, "1.16.5"))
+			Expect(cm.Data).To(HaveKeyWithValue(upgradeJournalToVersionKey, newHCOVersion))
+
+			spec := hcov1.HyperConvergedSpec{}
+			Expect(json.Unmarshal([]byte(cm.Data[upgradeJournalSpecKey]), &spec)).To(Succeed())
+			Expect(spec.Virtualization.VirtualMachineOptions.DisableFreePageReporting).To(HaveValue(BeFalse()))
+
+			var operands []upgradeJournalOperand
+			Expect(json.Unmarshal([]byte(cm.Data[upgradeJournalOperandsKey]), &operands)).To(Succeed())
+			Expect(operands).To(HaveLen(1))
+			Expect(operands[0].Kind).To(Equal("KubeVirt"))
+			Expect(operands[0].Name).To(Equal(expected.kv.Name))
+			kvSpec := kubevirtv1.KubeVirtSpec{}
+			Expect(json.Unmarshal(operands[0].Spec, &kvSpec)).To(Succeed())
+			Expect(kvSpec).To(Equal(expected.kv.Spec))
+
+			var patches []upgradepatch.PatchReport
+			Expect(json.Unmarshal([]byte(cm.Data[upgradeJournalPatchesKey]), &patches)).To(Succeed())
+			Expect(patches).To(ContainElement(HaveField("Status", upgradepatch.PatchApplied)))
+			Expect(cm.Data).To(HaveKey(upgradeJournalObjectsToBeRemovedKey))
+
+			// the journal must not be modified by the next reconciliations
+			resourceVersion := cm.ResourceVersion
+			for range 3 {
+				foundResource, reconciler, _ = doReconcile(cl, foundResource, reconciler)
+			}
+			Expect(getJournal(cl, journalName).ResourceVersion).To(Equal(resourceVersion))
+
+			ver, _ := GetVersion(&foundResource.Status, hcoVersionName)
+			Expect(ver).To(Equal(newHCOVersion))
+			Expect(getUpgradeJournal(&foundResource.Status, hcoVersionName)).To(Equal(journalName))
+		})
+
+		It("should set the journal when the upgrade is completed, if there is no operator version", func() {
+			expected.hco.Status.Versions = nil
+
+			journalName := getUpgradeJournalName("", newHCOVersion)
+			Expect(journalName).To(HavePrefix(upgradeJournalNamePrefix + "unknown-to-"))
+
+			cl := expected.initClient()
+			foundResource, reconciler, requeue := doReconcile(cl, expected.hco, nil)
+			Expect(requeue).To(BeTrue())
+			Expect(foundResource.Status.Versions).To(BeEmpty())
+			getJournal(cl, journalName)
+
+			for range 2 {
+				foundResource, reconciler, _ = doReconcile(cl, foundResource, reconciler)
+			}
+
+			ver, _ := GetVersion(&foundResource.Status, hcoVersionName)
+			Expect(ver).To(Equal(newHCOVersion))
+			Expect(getUpgradeJournal(&foundResource.Status, hcoVersionName)).To(Equal(journalName))
+		})
+
+		It("should keep only the most recent journals", func() {
+			UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
+
+			resources := expected.toArray()
+			now := time.Now()
+			for i := range maxUpgradeJournals {
+				resources = append(resources, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              fmt.Sprintf("%sold-%d", upgradeJournalNamePrefix, i),
+						Namespace:         expected.hco.Namespace,
+						Labels:            map[string]string{UpgradeJournalLabel: "true"},
+						CreationTimestamp: metav1.NewTime(now.Add(time.Duration(i-maxUpgradeJournals) * time.Hour)),
+					},
+				})
+			}
+
+			cl := commontestutils.InitClient(resources)
+			_, _, requeue := doReconcile(cl, expected.hco, nil)
+			Expect(requeue).To(BeTrue())
+
+			cmList := &corev1.ConfigMapList{}
+			Expect(cl.List(context.TODO(), cmList, client.HasLabels{UpgradeJournalLabel})).To(Succeed())
+			Expect(cmList.Items).To(HaveLen(maxUpgradeJournals))
+
+			names := make([]string, 0, len(cmList.Items))
+			for _, cm := range cmList.Items {
+				names = append(names, cm.Name)
+			}
+			Expect(names).ToNot(ContainElement(upgradeJournalNamePrefix + "old-0"))
+			Expect(names).To(ContainElement(getUpgradeJournalName(oldVersion, newHCOVersion)))
+		})
+
+		DescribeTable("should generate a valid journal name", func(from, to, expectedName string) {
+			Expect(getUpgradeJournalName(from, to)).To(Equal(expectedName))
+		},
+			Entry("regular versions", "1.18.2", "1.19.0", upgradeJournalNamePrefix+"1.18.2-to-1.19.0"),
+			Entry("unknown source version", "", "1.19.0", upgradeJournalNamePrefix+"unknown-to-1.19.0"),
+			Entry("invalid characters", "v1.18.2+Build_1", "1.19.0-unstable", upgradeJournalNamePrefix+"v1.18.2-build-1-to-1.19.0-unstable"),
+		)
+	})
+
 	Context("remove old quickstart guides", func() {
 		It("should drop old quickstart guide", func() {
 			const oldQSName = "old-quickstart-guide"
@@ -1260,7 +1385,7 @@ var _ = Describe("Upgrade Mode", func() {
 			hc := commontestutils.NewHco()
 			modify(hc)
 
-			changed, err := removeWrongJsonPatch(hc)
+			changed, err := upgradepatch.RemoveWrongJSONPatch(hc)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(changed).To(Equal(wasChange))
 
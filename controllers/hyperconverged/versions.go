@@ -28,3 +28,24 @@ func GetVersion(hcs *hcov1.HyperConvergedStatus, name string) (string, bool) {
 	}
 	return "", false
 }
+
+func getUpgradeJournal(hcs *hcov1.HyperConvergedStatus, name string) string {
+	for _, v := range hcs.Versions {
+		if v.Name == name {
+			return v.UpgradeJournal
+		}
+	}
+	return ""
+}
+
+// setUpgradeJournal sets the upgrade journal of an existing version. It returns false if the version is not found.
+func setUpgradeJournal(hcs *hcov1.HyperConvergedStatus, name, journal string) bool {
+	for i, v := range hcs.Versions {
+		if v.Name == name {
+			hcs.Versions[i].UpgradeJournal = journal
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,61 @@
+package hyperconverged
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// listing the VMIs is expensive, so the VMIs are sampled periodically, and not in each reconciliation
+const vmiSampleInterval = 10 * time.Minute
+
+// vmiSampler periodically lists the metadata of the VMIs. The same sample is shared by the tuning recommender and by
+// the live migration profiles counter, so the VMIs are listed only once in the sample interval.
+type vmiSampler struct {
+	interval    time.Duration
+	now         func() time.Time
+	lastAttempt time.Time
+	attempted   bool
+	sampleTime  time.Time
+	vmis        []metav1.PartialObjectMetadata
+	sampled     bool
+}
+
+func newVMISampler() *vmiSampler {
+	return &vmiSampler{
+		interval: vmiSampleInterval,
+		now:      time.Now,
+	}
+}
+
+// getVMIs returns the last sample of the VMIs, and the time it was taken. The VMIs are listed again once the sample
+// interval has passed. A failure to list the VMIs is ignored, and the previous sample is returned; ok is false if
+// there is no sample yet.
+func (s *vmiSampler) getVMIs(req *common.HcoRequest, reader client.Reader) (vmis []metav1.PartialObjectMetadata, sampleTime time.Time, ok bool) {
+	if now := s.now(); !s.attempted || now.Sub(s.lastAttempt) >= s.interval {
+		vmiList := &metav1.PartialObjectMetadataList{}
+		vmiList.SetGroupVersionKind(kubevirtcorev1.SchemeGroupVersion.WithKind("VirtualMachineInstanceList"))
+
+		if err := reader.List(req.Ctx, vmiList); err != nil {
+			req.Logger.Error(err, "failed to list the VMIs")
+		} else {
+			s.vmis = vmiList.Items
+			s.sampleTime = now
+			s.sampled = true
+		}
+		s.lastAttempt = now
+		s.attempted = true
+	}
+
+	return s.vmis, s.sampleTime, s.sampled
+}
+
+// requeueAfter returns the sample interval
+func (s *vmiSampler) requeueAfter() time.Duration {
+	return s.interval
+}
@@ -0,0 +1,23 @@
+package ingresscluster
+
+import (
+	configv1 "github.com/openshift/api/config/v1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/downloadhost"
+)
+
+// SetStandaloneDownloadHost sets the CLI downloads host from spec.deployment.standalone.cliDownloads. It is used
+// instead of the ingress-cluster controller, on Kubernetes clusters without the OpenShift cluster Ingress. It returns
+// true if the host was changed.
+func SetStandaloneDownloadHost(hc *hcov1.HyperConverged) bool {
+	var host configv1.Hostname
+	if sa := hc.Spec.Deployment.Standalone; sa != nil && sa.CLIDownloads != nil {
+		host = configv1.Hostname(sa.CLIDownloads.Host)
+	}
+
+	return downloadhost.Set(downloadhost.CLIDownloadHost{
+		DefaultHost: host,
+		CurrentHost: host,
+	})
+}
@@ -0,0 +1,46 @@
+package ingresscluster
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/downloadhost"
+)
+
+var _ = Describe("SetStandaloneDownloadHost", func() {
+	BeforeEach(func() {
+		origHost := downloadhost.Get()
+		DeferCleanup(func() {
+			downloadhost.Set(origHost)
+		})
+	})
+
+	It("should set the download host from the HyperConverged CR", func() {
+		hc := commontestutils.NewHco()
+		hc.Spec.Deployment.Standalone = &hcov1.StandaloneConfig{
+			CLIDownloads: &hcov1.CLIDownloadsConfig{
+				Host: "virtctl.example.com",
+			},
+		}
+
+		Expect(SetStandaloneDownloadHost(hc)).To(BeTrue())
+		Expect(downloadhost.Get()).To(Equal(downloadhost.CLIDownloadHost{
+			DefaultHost: "virtctl.example.com",
+			CurrentHost: "virtctl.example.com",
+		}))
+
+		Expect(SetStandaloneDownloadHost(hc)).To(BeFalse())
+	})
+
+	It("should clear the download host if the cliDownloads field is not set", func() {
+		downloadhost.Set(downloadhost.CLIDownloadHost{
+			DefaultHost: "virtctl.example.com",
+			CurrentHost: "virtctl.example.com",
+		})
+
+		Expect(SetStandaloneDownloadHost(commontestutils.NewHco())).To(BeTrue())
+		Expect(downloadhost.Get()).To(Equal(downloadhost.CLIDownloadHost{}))
+	})
+})
@@ -9,6 +9,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/alertoverrides"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
 )
 
@@ -18,6 +19,15 @@ func (r *Reconciler) ReconcileAlerts(ctx context.Context) error {
 		return fmt.Errorf("failed to build PrometheusRule: %v", err)
 	}
 
+	hc, err := r.getHyperConverged(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the HyperConverged CR: %v", err)
+	}
+
+	if err = alertoverrides.Apply(desiredPromRule, alertoverrides.GetConfig(hc)); err != nil {
+		return fmt.Errorf("failed to apply the alerting configuration: %v", err)
+	}
+
 	existingPromRule := &promv1.PrometheusRule{}
 	err = r.Get(ctx, types.NamespacedName{
 		Name:      desiredPromRule.Name,
@@ -14,6 +14,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/observability"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
@@ -69,4 +70,78 @@ var _ = Describe("Reconcile Alerts", func() {
 
 		Expect(foundPromRules.Spec).To(Equal(promRules.Spec))
 	})
+
+	It("Should apply the alert overrides of the HyperConverged CR", func() {
+		hco := commontestutils.NewHco()
+		hco.Namespace = namespace
+		hco.Spec.Observability = &hcov1.ObservabilityConfig{
+			AlertOverrides: []hcov1.AlertOverride{
+				{Alert: "HighCPUWorkload", Threshold: "0.8", Severity: hcov1.AlertSeverityCritical},
+			},
+		}
+
+		cl = commontestutils.InitClient([]client.Object{hco})
+		mgr, err := commontestutils.NewManagerMock(&rest.Config{}, manager.Options{}, cl, logger)
+		Expect(err).ToNot(HaveOccurred())
+		reconciler = observability.NewReconciler(mgr, namespace, fakeownresources.GetFakeDeploymentRef())
+
+		Expect(reconciler.ReconcileAlerts(context.TODO())).To(Succeed())
+
+		var foundPromRules promv1.PrometheusRule
+		Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(promRules), &foundPromRules)).To(Succeed())
+
+		var alert *promv1.Rule
+		for _, group := range foundPromRules.Spec.Groups {
+			for i := range group.Rules {
+				if group.Rules[i].Alert == "HighCPUWorkload" {
+					alert = &group.Rules[i]
+				}
+			}
+		}
+		Expect(alert).ToNot(BeNil())
+		Expect(alert.Expr.String()).To(Equal("instance:node_cpu_utilisation:rate1m >= 0.8"))
+		Expect(alert.Labels).To(HaveKeyWithValue("severity", "critical"))
+	})
+
+	It("Should apply the alerting configuration of the HyperConverged CR to the existing PrometheusRules", func() {
+		Expect(cl.Create(context.TODO(), promRules.DeepCopy())).To(Succeed())
+
+		hco := commontestutils.NewHco()
+		hco.Namespace = namespace
+		hco.Spec.Observability = &hcov1.ObservabilityConfig{
+			Alerting: &hcov1.AlertingConfig{
+				RunbookBaseURL:   "https://runbooks.example.com/kubevirt",
+				AdditionalLabels: map[string]string{"team": "virt"},
+				SeverityMapping: []hcov1.AlertSeverityMapping{
+					{From: hcov1.AlertSeverityWarning, To: hcov1.AlertSeverityInfo},
+				},
+			},
+		}
+		Expect(cl.Create(context.TODO(), hco)).To(Succeed())
+
+		Expect(reconciler.ReconcileAlerts(context.TODO())).To(Succeed())
+
+		var foundPromRules promv1.PrometheusRule
+		Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(promRules), &foundPromRules)).To(Succeed())
+
+		for g, group := range foundPromRules.Spec.Groups {
+			for r, alert := range group.Rules {
+				if alert.Alert == "" {
+					continue
+				}
+
+				origAlert := promRules.Spec.Groups[g].Rules[r]
+				Expect(alert.Labels).To(HaveKeyWithValue("team", "virt"))
+				if origAlert.Labels["severity"] == "warning" {
+					Expect(alert.Labels).To(HaveKeyWithValue("severity", "info"))
+				}
+
+				if alert.Alert == "DuplicateWaspAgentDSDetected" {
+					Expect(alert.Annotations["runbook_url"]).To(Equal(origAlert.Annotations["runbook_url"]))
+				} else {
+					Expect(alert.Annotations).To(HaveKeyWithValue("runbook_url", "https://runbooks.example.com/kubevirt/"+alert.Alert))
+				}
+			}
+		}
+	})
 })
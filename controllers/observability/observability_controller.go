@@ -8,12 +8,15 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
@@ -32,7 +35,10 @@ type Reconciler struct {
 	events    chan event.GenericEvent
 	owner     metav1.OwnerReference
 
-	amApi *alertmanager.Api
+	amApi        *alertmanager.Api
+	eventEmitter util.EventEmitter
+	// the IDs of the Alertmanager silences that were created for spec.observability.silences, by silence name
+	silenceIDs map[string]string
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
@@ -44,6 +50,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result
 		errors = append(errors, err)
 	}
 
+	if err := r.ReconcileSilences(ctx); err != nil {
+		errors = append(errors, err)
+	}
+
 	if err := r.ReconcileAlerts(ctx); err != nil {
 		errors = append(errors, err)
 	}
@@ -64,6 +74,9 @@ func NewReconciler(mgr ctrl.Manager, namespace string, ownerRef metav1.OwnerRefe
 		config:    mgr.GetConfig(),
 		events:    make(chan event.GenericEvent, 1),
 		owner:     ownerRef,
+
+		eventEmitter: util.GetEventEmitter(),
+		silenceIDs:   make(map[string]string),
 	}
 }
 
@@ -86,6 +99,12 @@ func SetupWithManager(mgr ctrl.Manager, ownerRef metav1.OwnerReference) error {
 			r.events,
 			&handler.EnqueueRequestForObject{},
 		)).
+		// reconcile the silences when they are modified in the HyperConverged CR
+		Watches(
+			&hcov1.HyperConverged{},
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		Complete(r)
 }
 
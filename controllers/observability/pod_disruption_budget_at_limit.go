@@ -20,15 +20,12 @@ const (
 )
 
 func (r *Reconciler) ensurePodDisruptionBudgetAtLimitIsSilenced() error {
-	if r.amApi == nil {
-		var err error
-		r.amApi, err = r.NewAlertmanagerApi()
-		if err != nil {
-			return fmt.Errorf("failed to initialize alertmanager api: %w", err)
-		}
+	amApi, err := r.getAlertmanagerApi()
+	if err != nil {
+		return err
 	}
 
-	amSilences, err := r.amApi.ListSilences()
+	amSilences, err := amApi.ListSilences()
 	if err != nil {
 		return fmt.Errorf("failed to list alertmanager silences: %w", err)
 	}
@@ -40,7 +37,7 @@ func (r *Reconciler) ensurePodDisruptionBudgetAtLimitIsSilenced() error {
 
 	silence := alertmanager.Silence{
 		Comment:   "Silence KubeVirt PodDisruptionBudgetAtLimit alerts",
-		CreatedBy: "hyperconverged-cluster-operator",
+		CreatedBy: silenceCreatedBy,
 		EndsAt:    "3000-01-01T00:00:00Z",
 		Matchers: []alertmanager.Matcher{
 			{
@@ -58,7 +55,7 @@ func (r *Reconciler) ensurePodDisruptionBudgetAtLimitIsSilenced() error {
 		StartsAt: time.Now().Format(time.RFC3339),
 	}
 
-	if err := r.amApi.CreateSilence(silence); err != nil {
+	if _, err := amApi.CreateSilence(silence); err != nil {
 		return fmt.Errorf("failed to create alertmanager silence: %w", err)
 	}
 	log.Info("Silenced PodDisruptionBudgetAtLimit alerts")
@@ -66,6 +63,18 @@ func (r *Reconciler) ensurePodDisruptionBudgetAtLimitIsSilenced() error {
 	return nil
 }
 
+func (r *Reconciler) getAlertmanagerApi() (*alertmanager.Api, error) {
+	if r.amApi == nil {
+		var err error
+		r.amApi, err = r.NewAlertmanagerApi()
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize alertmanager api: %w", err)
+		}
+	}
+
+	return r.amApi, nil
+}
+
 func (r *Reconciler) NewAlertmanagerApi() (*alertmanager.Api, error) {
 	httpClient, err := NewHTTPClient()
 	if err != nil {
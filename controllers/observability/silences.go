@@ -0,0 +1,253 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	silenceCreatedBy = "hyperconverged-cluster-operator"
+
+	defaultSilenceDuration    = 24 * time.Hour
+	defaultSilenceRenewBefore = 2 * time.Hour
+)
+
+// the comment of a managed silence starts with "[hco:<name>]"
+var managedSilenceCommentRegex = regexp.MustCompile(`^\[hco:([a-z0-9]([-a-z0-9]*[a-z0-9])?)]`)
+
+// ReconcileSilences creates the Alertmanager silences from spec.observability.silences of the HyperConverged CR,
+// renews them before they end, and expires the silences that were removed from the HyperConverged CR. A managed
+// silence that was expired or removed outside of HCO is created again, and the modification is reported by an event.
+func (r *Reconciler) ReconcileSilences(ctx context.Context) error {
+	hc, err := r.getHyperConverged(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the HyperConverged CR: %w", err)
+	}
+
+	var requiredSilences []hcov1.AlertSilence
+	if hc != nil && hc.Spec.Observability != nil {
+		requiredSilences = hc.Spec.Observability.Silences
+	}
+
+	amApi, err := r.getAlertmanagerApi()
+	if err != nil {
+		return err
+	}
+
+	amSilences, err := amApi.ListSilences()
+	if err != nil {
+		return fmt.Errorf("failed to list alertmanager silences: %w", err)
+	}
+
+	managedSilences := make(map[string][]alertmanager.Silence)
+	for _, silence := range amSilences {
+		if name, ok := getManagedSilenceName(silence); ok {
+			managedSilences[name] = append(managedSilences[name], silence)
+		}
+	}
+
+	now := time.Now().UTC()
+	var errs []error
+	requiredNames := make(map[string]bool, len(requiredSilences))
+	for _, silence := range requiredSilences {
+		requiredNames[silence.Name] = true
+		if err = r.reconcileSilence(hc, amApi, silence, managedSilences[silence.Name], now); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile the %s silence: %w", silence.Name, err))
+		}
+	}
+
+	for name, silences := range managedSilences {
+		if requiredNames[name] {
+			continue
+		}
+
+		for _, silence := range silences {
+			if silence.Status.State == alertmanager.SilenceStateExpired {
+				continue
+			}
+
+			if err = amApi.ExpireSilence(silence.ID); err != nil {
+				errs = append(errs, fmt.Errorf("failed to expire the %s silence: %w", name, err))
+				continue
+			}
+			log.Info("Expired a silence that was removed from the HyperConverged CR", "name", name, "id", silence.ID)
+		}
+	}
+
+	for name := range r.silenceIDs {
+		if !requiredNames[name] {
+			delete(r.silenceIDs, name)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *Reconciler) reconcileSilence(hc *hcov1.HyperConverged, amApi *alertmanager.Api, silence hcov1.AlertSilence, found []alertmanager.Silence, now time.Time) error {
+	duration, renewBefore := getSilenceDurations(silence)
+	required := newAlertmanagerSilence(silence, now, duration)
+
+	var (
+		current *alertmanager.Silence
+		stale   []alertmanager.Silence
+		expired []alertmanager.Silence
+	)
+	for _, amSilence := range found {
+		switch {
+		case amSilence.Status.State == alertmanager.SilenceStateExpired:
+			expired = append(expired, amSilence)
+		case current == nil && amSilence.Comment == required.Comment && slices.Equal(amSilence.Matchers, required.Matchers):
+			current = &amSilence
+		default:
+			stale = append(stale, amSilence)
+		}
+	}
+
+	if current == nil && len(stale) == 0 {
+		if drift := r.getSilenceDrift(silence.Name, expired, duration); drift != "" {
+			log.Info("A managed silence was modified outside of the HyperConverged CR; creating it again", "name", silence.Name, "drift", drift)
+			r.eventEmitter.EmitEvent(hc, corev1.EventTypeWarning, "SilenceModified",
+				fmt.Sprintf("The %s silence was modified outside of the HyperConverged CR: %s; creating it again", silence.Name, drift))
+		}
+	}
+
+	// the matchers or the comment were changed in the HyperConverged CR
+	for _, amSilence := range stale {
+		if err := amApi.ExpireSilence(amSilence.ID); err != nil {
+			return err
+		}
+		log.Info("Expired an outdated silence", "name", silence.Name, "id", amSilence.ID)
+	}
+
+	if current == nil {
+		id, err := amApi.CreateSilence(required)
+		if err != nil {
+			return err
+		}
+		r.silenceIDs[silence.Name] = id
+		log.Info("Created a silence", "name", silence.Name, "id", id)
+		return nil
+	}
+
+	r.silenceIDs[silence.Name] = current.ID
+	if endsAt, err := time.Parse(time.RFC3339, current.EndsAt); err == nil && endsAt.Sub(now) > renewBefore {
+		return nil
+	}
+
+	current.EndsAt = required.EndsAt
+	id, err := amApi.CreateSilence(*current)
+	if err != nil {
+		return err
+	}
+	r.silenceIDs[silence.Name] = id
+	log.Info("Renewed a silence", "name", silence.Name, "id", id, "endsAt", required.EndsAt)
+
+	return nil
+}
+
+// getSilenceDrift checks why a managed silence has no active Alertmanager silence. It returns an empty string if the
+// silence is new, or if its last Alertmanager silence ended on time.
+func (r *Reconciler) getSilenceDrift(name string, expired []alertmanager.Silence, duration time.Duration) string {
+	var last *alertmanager.Silence
+	var lastEndsAt time.Time
+	for _, amSilence := range expired {
+		endsAt, err := time.Parse(time.RFC3339, amSilence.EndsAt)
+		if err == nil && (last == nil || endsAt.After(lastEndsAt)) {
+			last = &amSilence
+			lastEndsAt = endsAt
+		}
+	}
+
+	if last != nil {
+		// HCO only expires a silence when it replaces it, so a silence that ended before its duration was expired by
+		// someone else
+		if startsAt, err := time.Parse(time.RFC3339, last.StartsAt); err == nil && lastEndsAt.Sub(startsAt) < duration {
+			return fmt.Sprintf("the Alertmanager silence %s was expired", last.ID)
+		}
+	}
+
+	if id, tracked := r.silenceIDs[name]; tracked && !slices.ContainsFunc(expired, func(amSilence alertmanager.Silence) bool {
+		return amSilence.ID == id
+	}) {
+		return fmt.Sprintf("the Alertmanager silence %s was removed", id)
+	}
+
+	return ""
+}
+
+func (r *Reconciler) getHyperConverged(ctx context.Context) (*hcov1.HyperConverged, error) {
+	hc := &hcov1.HyperConverged{}
+	err := r.Get(ctx, types.NamespacedName{Name: hcoutil.HyperConvergedName, Namespace: r.namespace}, hc)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return hc, nil
+}
+
+func getManagedSilenceName(silence alertmanager.Silence) (string, bool) {
+	if silence.CreatedBy != silenceCreatedBy {
+		return "", false
+	}
+
+	match := managedSilenceCommentRegex.FindStringSubmatch(silence.Comment)
+	if match == nil {
+		return "", false
+	}
+
+	return match[1], true
+}
+
+func getSilenceDurations(silence hcov1.AlertSilence) (time.Duration, time.Duration) {
+	duration := defaultSilenceDuration
+	if silence.Duration != nil {
+		duration = silence.Duration.Duration
+	}
+
+	renewBefore := defaultSilenceRenewBefore
+	if silence.RenewBefore != nil {
+		renewBefore = silence.RenewBefore.Duration
+	}
+
+	return duration, renewBefore
+}
+
+func newAlertmanagerSilence(silence hcov1.AlertSilence, now time.Time, duration time.Duration) alertmanager.Silence {
+	comment := fmt.Sprintf("[hco:%s]", silence.Name)
+	if silence.Comment != "" {
+		comment += " " + silence.Comment
+	}
+
+	matchers := make([]alertmanager.Matcher, len(silence.Matchers))
+	for i, matcher := range silence.Matchers {
+		matchers[i] = alertmanager.Matcher{
+			Name:    matcher.Name,
+			Value:   matcher.Value,
+			IsEqual: matcher.Type != hcov1.AlertSilenceMatcherNotEqual && matcher.Type != hcov1.AlertSilenceMatcherNotRegex,
+			IsRegex: matcher.Type == hcov1.AlertSilenceMatcherRegex || matcher.Type == hcov1.AlertSilenceMatcherNotRegex,
+		}
+	}
+
+	return alertmanager.Silence{
+		Comment:   comment,
+		CreatedBy: silenceCreatedBy,
+		StartsAt:  now.Format(time.RFC3339),
+		EndsAt:    now.Add(duration).Format(time.RFC3339),
+		Matchers:  matchers,
+	}
+}
@@ -0,0 +1,215 @@
+package observability
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+	fakealertmanager "github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager/fake"
+	fakeownresources "github.com/kubevirt/hyperconverged-cluster-operator/pkg/ownresources/fake"
+)
+
+var _ = Describe("Silences", func() {
+	var (
+		hco          *hcov1.HyperConverged
+		fakeAM       *fakealertmanager.Alertmanager
+		eventEmitter *commontestutils.EventEmitterMock
+	)
+
+	maintenanceSilence := hcov1.AlertSilence{
+		Name: "maintenance",
+		Matchers: []hcov1.AlertSilenceMatcher{
+			{Name: "alertname", Value: "KubeVirtVMIExcessiveMigrations", Type: hcov1.AlertSilenceMatcherEqual},
+			{Name: "namespace", Value: "test-.*", Type: hcov1.AlertSilenceMatcherRegex},
+		},
+		Comment:     "planned maintenance",
+		Duration:    &metav1.Duration{Duration: 24 * time.Hour},
+		RenewBefore: &metav1.Duration{Duration: 2 * time.Hour},
+	}
+
+	newReconciler := func(objs ...client.Object) *Reconciler {
+		cl := commontestutils.InitClient(objs)
+		mgr, err := commontestutils.NewManagerMock(&rest.Config{}, manager.Options{}, cl, logger)
+		Expect(err).ToNot(HaveOccurred())
+
+		r := NewReconciler(mgr, commontestutils.Namespace, fakeownresources.GetFakeDeploymentRef())
+		r.amApi = alertmanager.NewAPI(http.Client{}, fakeAM.URL, "token")
+		r.eventEmitter = eventEmitter
+		return r
+	}
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		hco.Spec.Observability = &hcov1.ObservabilityConfig{
+			Silences: []hcov1.AlertSilence{*maintenanceSilence.DeepCopy()},
+		}
+
+		fakeAM = fakealertmanager.NewAlertmanager("token")
+		DeferCleanup(fakeAM.Close)
+
+		eventEmitter = commontestutils.NewEventEmitterMock()
+	})
+
+	It("should create the silences from the HyperConverged CR", func(ctx context.Context) {
+		r := newReconciler(hco)
+		Expect(r.ReconcileSilences(ctx)).To(Succeed())
+
+		silences := fakeAM.ActiveSilences()
+		Expect(silences).To(HaveLen(1))
+		Expect(silences[0].CreatedBy).To(Equal("hyperconverged-cluster-operator"))
+		Expect(silences[0].Comment).To(Equal("[hco:maintenance] planned maintenance"))
+		Expect(silences[0].Matchers).To(Equal([]alertmanager.Matcher{
+			{IsEqual: true, IsRegex: false, Name: "alertname", Value: "KubeVirtVMIExcessiveMigrations"},
+			{IsEqual: true, IsRegex: true, Name: "namespace", Value: "test-.*"},
+		}))
+
+		startsAt, err := time.Parse(time.RFC3339, silences[0].StartsAt)
+		Expect(err).ToNot(HaveOccurred())
+		endsAt, err := time.Parse(time.RFC3339, silences[0].EndsAt)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(endsAt.Sub(startsAt)).To(Equal(24 * time.Hour))
+
+		By("not creating the silence again")
+		Expect(r.ReconcileSilences(ctx)).To(Succeed())
+		Expect(fakeAM.Silences()).To(HaveLen(1))
+		Expect(eventEmitter.CheckNoEventEmitted()).To(BeTrue())
+	})
+
+	It("should renew a silence before it ends", func(ctx context.Context) {
+		r := newReconciler(hco)
+
+		now := time.Now().UTC()
+		amSilence := newAlertmanagerSilence(maintenanceSilence, now.Add(-23*time.Hour), 24*time.Hour)
+		id := fakeAM.AddSilence(amSilence)
+
+		Expect(r.ReconcileSilences(ctx)).To(Succeed())
+
+		silences := fakeAM.Silences()
+		Expect(silences).To(HaveLen(1))
+		Expect(silences[0].ID).To(Equal(id))
+		Expect(silences[0].StartsAt).To(Equal(amSilence.StartsAt))
+
+		endsAt, err := time.Parse(time.RFC3339, silences[0].EndsAt)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(endsAt).To(BeTemporally(">", now.Add(23*time.Hour)))
+		Expect(eventEmitter.CheckNoEventEmitted()).To(BeTrue())
+	})
+
+	It("should replace a silence that was modified in the HyperConverged CR", func(ctx context.Context) {
+		r := newReconciler(hco)
+		oldID := fakeAM.AddSilence(newAlertmanagerSilence(maintenanceSilence, time.Now().UTC(), 24*time.Hour))
+
+		hco.Spec.Observability.Silences[0].Matchers[0].Value = "KubeVirtVMStuckInErrorState"
+		Expect(r.Update(ctx, hco)).To(Succeed())
+
+		Expect(r.ReconcileSilences(ctx)).To(Succeed())
+
+		silences := fakeAM.ActiveSilences()
+		Expect(silences).To(HaveLen(1))
+		Expect(silences[0].ID).ToNot(Equal(oldID))
+		Expect(silences[0].Matchers[0].Value).To(Equal("KubeVirtVMStuckInErrorState"))
+		Expect(eventEmitter.CheckNoEventEmitted()).To(BeTrue())
+	})
+
+	It("should expire the silences that were removed from the HyperConverged CR", func(ctx context.Context) {
+		r := newReconciler(hco)
+		Expect(r.ReconcileSilences(ctx)).To(Succeed())
+		Expect(fakeAM.ActiveSilences()).To(HaveLen(1))
+
+		hco.Spec.Observability.Silences = nil
+		Expect(r.Update(ctx, hco)).To(Succeed())
+
+		Expect(r.ReconcileSilences(ctx)).To(Succeed())
+		Expect(fakeAM.ActiveSilences()).To(BeEmpty())
+		Expect(r.silenceIDs).To(BeEmpty())
+	})
+
+	It("should expire the silences if the HyperConverged CR does not exist", func(ctx context.Context) {
+		fakeAM.AddSilence(newAlertmanagerSilence(maintenanceSilence, time.Now().UTC(), 24*time.Hour))
+
+		r := newReconciler()
+		Expect(r.ReconcileSilences(ctx)).To(Succeed())
+		Expect(fakeAM.ActiveSilences()).To(BeEmpty())
+	})
+
+	It("should not modify the silences that are not managed by HCO", func(ctx context.Context) {
+		now := time.Now().UTC()
+		userSilence := alertmanager.Silence{
+			Comment:   "[hco:maintenance] created by a user",
+			CreatedBy: "user",
+			StartsAt:  now.Format(time.RFC3339),
+			EndsAt:    now.Add(time.Hour).Format(time.RFC3339),
+			Matchers:  []alertmanager.Matcher{{IsEqual: true, Name: "alertname", Value: "TestAlert"}},
+		}
+		fakeAM.AddSilence(userSilence)
+
+		hco.Spec.Observability = nil
+		r := newReconciler(hco)
+		Expect(r.ReconcileSilences(ctx)).To(Succeed())
+
+		silences := fakeAM.ActiveSilences()
+		Expect(silences).To(HaveLen(1))
+		Expect(silences[0].CreatedBy).To(Equal("user"))
+	})
+
+	DescribeTable("should create a silence again, and report it, if it was modified outside of HCO",
+		func(ctx context.Context, modify func(id string), expectedMsg string) {
+			r := newReconciler(hco)
+			Expect(r.ReconcileSilences(ctx)).To(Succeed())
+
+			silences := fakeAM.ActiveSilences()
+			Expect(silences).To(HaveLen(1))
+			id := silences[0].ID
+
+			modify(id)
+			Expect(fakeAM.ActiveSilences()).To(BeEmpty())
+
+			Expect(r.ReconcileSilences(ctx)).To(Succeed())
+
+			silences = fakeAM.ActiveSilences()
+			Expect(silences).To(HaveLen(1))
+			Expect(silences[0].ID).ToNot(Equal(id))
+
+			Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{{
+				EventType: corev1.EventTypeWarning,
+				Reason:    "SilenceModified",
+				Msg:       "The maintenance silence was modified outside of the HyperConverged CR: the Alertmanager silence " + id + " was " + expectedMsg + "; creating it again",
+			}})).To(BeTrue())
+		},
+		Entry("expired", func(id string) { fakeAM.ExpireSilence(id) }, "expired"),
+		Entry("removed", func(id string) { fakeAM.RemoveSilence(id) }, "removed"),
+	)
+
+	It("should report a silence that was expired while HCO was not running", func(ctx context.Context) {
+		id := fakeAM.AddSilence(newAlertmanagerSilence(maintenanceSilence, time.Now().UTC().Add(-time.Hour), 24*time.Hour))
+		fakeAM.ExpireSilence(id)
+
+		r := newReconciler(hco)
+		Expect(r.ReconcileSilences(ctx)).To(Succeed())
+
+		Expect(fakeAM.ActiveSilences()).To(HaveLen(1))
+		Expect(eventEmitter.CheckNoEventEmitted()).To(BeFalse())
+	})
+
+	It("should not report a silence that ended on time", func(ctx context.Context) {
+		fakeAM.AddSilence(newAlertmanagerSilence(maintenanceSilence, time.Now().UTC().Add(-25*time.Hour), 24*time.Hour))
+
+		r := newReconciler(hco)
+		Expect(r.ReconcileSilences(ctx)).To(Succeed())
+
+		Expect(fakeAM.ActiveSilences()).To(HaveLen(1))
+		Expect(eventEmitter.CheckNoEventEmitted()).To(BeTrue())
+	})
+})
@@ -0,0 +1,109 @@
+package operandhandler
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+const noReason = "NoReason"
+
+// updateComponents replaces the status.components list with the components reported by the current reconciliation.
+// Components that are not reported anymore (e.g. a deleted optional operand) are removed from the list.
+func updateComponents(req *common.HcoRequest, reported []hcov1.ComponentStatus) {
+	components := make([]hcov1.ComponentStatus, 0, len(reported))
+	for _, component := range reported {
+		components = append(components, mergeComponentStatus(findComponent(req.Instance.Status.Components, component.Kind), component))
+	}
+
+	if len(components) == 0 {
+		components = nil
+	}
+
+	if !equality.Semantic.DeepEqual(components, req.Instance.Status.Components) {
+		req.Instance.Status.Components = components
+		req.StatusDirty = true
+	}
+}
+
+// mergeComponents updates the status of the components that were reported by the current reconciliation, and keeps
+// the status of the other components. It is used when the reconciliation of some operands failed or was skipped, so
+// the missing components are not necessarily removed.
+func mergeComponents(req *common.HcoRequest, reported []hcov1.ComponentStatus) {
+	components := slices.Clone(req.Instance.Status.Components)
+	for _, component := range reported {
+		existing := findComponent(components, component.Kind)
+		merged := mergeComponentStatus(existing, component)
+		if existing == nil {
+			components = append(components, merged)
+		} else {
+			*existing = merged
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(components, req.Instance.Status.Components) {
+		req.Instance.Status.Components = components
+		req.StatusDirty = true
+	}
+}
+
+// setComponentError only updates the reconciliation error of a failed component, and keeps the rest of its status, as
+// the operand CR could not be read.
+func setComponentError(req *common.HcoRequest, reported *hcov1.ComponentStatus) {
+	existing := findComponent(req.Instance.Status.Components, reported.Kind)
+	if existing == nil {
+		req.Instance.Status.Components = append(req.Instance.Status.Components, mergeComponentStatus(nil, *reported))
+		req.StatusDirty = true
+		return
+	}
+
+	if existing.LastReconcileError != reported.LastReconcileError {
+		existing.LastReconcileError = reported.LastReconcileError
+		req.StatusDirty = true
+	}
+}
+
+func findComponent(components []hcov1.ComponentStatus, kind string) *hcov1.ComponentStatus {
+	idx := slices.IndexFunc(components, func(c hcov1.ComponentStatus) bool {
+		return c.Kind == kind
+	})
+
+	if idx < 0 {
+		return nil
+	}
+
+	return &components[idx]
+}
+
+// mergeComponentStatus builds the new component status. The condition transition times are kept from the existing
+// status, unless the condition status was changed.
+func mergeComponentStatus(existing *hcov1.ComponentStatus, reported hcov1.ComponentStatus) hcov1.ComponentStatus {
+	var conditions []metav1.Condition
+	if existing != nil {
+		conditions = slices.Clone(existing.Conditions)
+	}
+
+	conditions = slices.DeleteFunc(conditions, func(cond metav1.Condition) bool {
+		return meta.FindStatusCondition(reported.Conditions, cond.Type) == nil
+	})
+
+	for _, cond := range reported.Conditions {
+		// the HyperConverged CRD requires a reason for each condition, but some operands don't always set it.
+		if cond.Reason == "" {
+			cond.Reason = noReason
+		}
+		meta.SetStatusCondition(&conditions, cond)
+	}
+
+	if len(conditions) == 0 {
+		conditions = nil
+	}
+
+	reported.Conditions = conditions
+	return reported
+}
@@ -0,0 +1,142 @@
+package operandhandler
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+)
+
+// operandNode is an operand in the operand DAG
+type operandNode struct {
+	name    string
+	operand operands.Operand
+	// dependsOn is the indexes of the nodes that must be reconciled successfully before this node
+	dependsOn []int
+}
+
+// operandDAG is the dependency graph of the operands. The nodes are kept in their declaration order. A node may only
+// depend on nodes that were declared before it, so the declaration order is always a valid reconciliation order, and
+// there are no cycles.
+type operandDAG struct {
+	nodes      []operandNode
+	index      map[string]int
+	dependents [][]int
+}
+
+func newOperandDAG() *operandDAG {
+	return &operandDAG{
+		index: make(map[string]int),
+	}
+}
+
+// add declares a new node in the DAG. It panics if the name is already in use, or if one of the dependencies was not
+// declared yet, as both are programming errors.
+func (d *operandDAG) add(name string, operand operands.Operand, dependsOn ...string) {
+	if _, exists := d.index[name]; exists {
+		panic(fmt.Sprintf("the %s operand is already declared", name))
+	}
+
+	node := operandNode{
+		name:      name,
+		operand:   operand,
+		dependsOn: make([]int, 0, len(dependsOn)),
+	}
+
+	i := len(d.nodes)
+	for _, dep := range dependsOn {
+		depIndex, exists := d.index[dep]
+		if !exists {
+			panic(fmt.Sprintf("the %s operand depends on the %s operand, that is not declared before it", name, dep))
+		}
+		node.dependsOn = append(node.dependsOn, depIndex)
+		d.dependents[depIndex] = append(d.dependents[depIndex], i)
+	}
+
+	d.nodes = append(d.nodes, node)
+	d.dependents = append(d.dependents, nil)
+	d.index[name] = i
+}
+
+// walk reconciles the nodes of the DAG, by calling visit for each one of them. A node is visited only after all its
+// dependencies were visited successfully; the dependents of a failed node are skipped. Up to limit independent nodes
+// are visited concurrently; if limit is 1, the nodes are visited in their declaration order.
+//
+// walk returns the results of the nodes, by their indexes. The result of a skipped node is nil.
+func (d *operandDAG) walk(limit int, visit func(int, *operandNode) *operands.EnsureResult) []*operands.EnsureResult {
+	results := make([]*operands.EnsureResult, len(d.nodes))
+
+	if limit <= 1 {
+		for i := range d.nodes {
+			if d.dependenciesSucceeded(i, results) {
+				results[i] = visit(i, &d.nodes[i])
+			}
+		}
+		return results
+	}
+
+	var (
+		lock sync.Mutex // protects results and pending
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, limit)
+	)
+
+	pending := make([]int, len(d.nodes))
+	for i, node := range d.nodes {
+		pending[i] = len(node.dependsOn)
+	}
+
+	var start func(i int)
+
+	// done releases the dependents of a visited or a skipped node. Must be called with the lock held.
+	var done func(i int)
+	done = func(i int) {
+		for _, dependent := range d.dependents[i] {
+			pending[dependent]--
+			if pending[dependent] > 0 {
+				continue
+			}
+
+			if d.dependenciesSucceeded(dependent, results) {
+				start(dependent)
+			} else {
+				// skip the node, and its dependents
+				done(dependent)
+			}
+		}
+	}
+
+	start = func(i int) {
+		wg.Go(func() {
+			sem <- struct{}{}
+			res := visit(i, &d.nodes[i])
+			<-sem
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			results[i] = res
+			done(i)
+		})
+	}
+
+	lock.Lock()
+	for i := range d.nodes {
+		if pending[i] == 0 {
+			start(i)
+		}
+	}
+	lock.Unlock()
+
+	wg.Wait()
+	return results
+}
+
+func (d *operandDAG) dependenciesSucceeded(i int, results []*operands.EnsureResult) bool {
+	for _, dep := range d.nodes[i].dependsOn {
+		if res := results[dep]; res == nil || res.Err != nil {
+			return false
+		}
+	}
+	return true
+}
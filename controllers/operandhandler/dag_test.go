@@ -0,0 +1,150 @@
+package operandhandler
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+)
+
+type fakeOperand struct {
+	ensure func() *operands.EnsureResult
+}
+
+func (o fakeOperand) Ensure(_ *common.HcoRequest) *operands.EnsureResult {
+	return o.ensure()
+}
+
+func (fakeOperand) Reset() {}
+
+var _ = Describe("test the operand DAG", func() {
+	var (
+		lock    sync.Mutex
+		visited []string
+	)
+
+	recordingOperand := func(name string, err error) operands.Operand {
+		return fakeOperand{ensure: func() *operands.EnsureResult {
+			lock.Lock()
+			defer lock.Unlock()
+
+			visited = append(visited, name)
+			return &operands.EnsureResult{Name: name, Err: err}
+		}}
+	}
+
+	BeforeEach(func() {
+		visited = nil
+	})
+
+	Context("add", func() {
+		It("should panic if a dependency is not declared before the node", func() {
+			dag := newOperandDAG()
+			dag.add("a", recordingOperand("a", nil))
+
+			Expect(func() {
+				dag.add("b", recordingOperand("b", nil), "c")
+			}).To(PanicWith(ContainSubstring("depends on the c operand")))
+		})
+
+		It("should panic if the name is already in use", func() {
+			dag := newOperandDAG()
+			dag.add("a", recordingOperand("a", nil))
+
+			Expect(func() {
+				dag.add("a", recordingOperand("a", nil))
+			}).To(PanicWith(ContainSubstring("already declared")))
+		})
+	})
+
+	Context("walk", func() {
+		DescribeTable("should visit the nodes after their dependencies", func(limit int) {
+			dag := newOperandDAG()
+			dag.add("a", recordingOperand("a", nil))
+			dag.add("b", recordingOperand("b", nil), "a")
+			dag.add("c", recordingOperand("c", nil))
+			dag.add("d", recordingOperand("d", nil), "b", "c")
+
+			results := dag.walk(limit, func(_ int, node *operandNode) *operands.EnsureResult {
+				return node.operand.Ensure(nil)
+			})
+
+			Expect(results).To(HaveLen(4))
+			Expect(results).ToNot(ContainElement(BeNil()))
+			Expect(visited).To(ConsistOf("a", "b", "c", "d"))
+			Expect(visited[3]).To(Equal("d"))
+			Expect(slices.Index(visited, "a")).To(BeNumerically("<", slices.Index(visited, "b")))
+		},
+			Entry("sequentially", 1),
+			Entry("concurrently", maxConcurrentOperands),
+		)
+
+		It("should visit the nodes in their declaration order, if the limit is 1", func() {
+			dag := newOperandDAG()
+			for _, name := range []string{"a", "b", "c", "d", "e"} {
+				dag.add(name, recordingOperand(name, nil))
+			}
+
+			dag.walk(1, func(_ int, node *operandNode) *operands.EnsureResult {
+				return node.operand.Ensure(nil)
+			})
+
+			Expect(visited).To(Equal([]string{"a", "b", "c", "d", "e"}))
+		})
+
+		DescribeTable("should skip the dependents of a failed node, and keep visiting the other nodes", func(limit int) {
+			dag := newOperandDAG()
+			dag.add("a", recordingOperand("a", errors.New("fake error")))
+			dag.add("b", recordingOperand("b", nil), "a")
+			dag.add("c", recordingOperand("c", nil), "b")
+			dag.add("d", recordingOperand("d", nil))
+			dag.add("e", recordingOperand("e", nil), "c", "d")
+
+			results := dag.walk(limit, func(_ int, node *operandNode) *operands.EnsureResult {
+				return node.operand.Ensure(nil)
+			})
+
+			Expect(visited).To(ConsistOf("a", "d"))
+			Expect(results[0].Err).To(MatchError("fake error"))
+			Expect(results[1]).To(BeNil())
+			Expect(results[2]).To(BeNil())
+			Expect(results[3].Err).ToNot(HaveOccurred())
+			Expect(results[4]).To(BeNil())
+		},
+			Entry("sequentially", 1),
+			Entry("concurrently", maxConcurrentOperands),
+		)
+
+		It("should visit independent nodes concurrently", func() {
+			bStarted := make(chan struct{})
+
+			dag := newOperandDAG()
+			dag.add("a", fakeOperand{ensure: func() *operands.EnsureResult {
+				// a can only complete if b runs at the same time
+				select {
+				case <-bStarted:
+					return &operands.EnsureResult{}
+				case <-time.After(5 * time.Second):
+					return &operands.EnsureResult{Err: errors.New("timeout")}
+				}
+			}})
+			dag.add("b", fakeOperand{ensure: func() *operands.EnsureResult {
+				close(bStarted)
+				return &operands.EnsureResult{}
+			}})
+
+			results := dag.walk(maxConcurrentOperands, func(_ int, node *operandNode) *operands.EnsureResult {
+				return node.operand.Ensure(nil)
+			})
+
+			Expect(results[0].Err).ToNot(HaveOccurred())
+			Expect(results[1].Err).ToNot(HaveOccurred())
+		})
+	})
+})
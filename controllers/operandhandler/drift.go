@@ -0,0 +1,91 @@
+package operandhandler
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// DriftReportConfigMapName is the name of the ConfigMap that holds the last out-of-band modifications of each
+	// operand, that were overwritten by HCO. Each key is in the form of <kind>.<name>, and its value is a JSON list of
+	// drift records, the most recent last.
+	DriftReportConfigMapName = "kubevirt-hyperconverged-drift"
+
+	// maxDriftRecords is the number of drift records to keep for each operand
+	maxDriftRecords = 10
+)
+
+// recordDrift adds the drift of an overwritten operand to the drift report ConfigMap
+func (h *OperandHandler) recordDrift(req *common.HcoRequest, res *operands.EnsureResult) error {
+	cm := &corev1.ConfigMap{}
+	err := h.client.Get(req.Ctx, client.ObjectKey{Name: DriftReportConfigMapName, Namespace: req.Instance.Namespace}, cm)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		cm = nil
+	}
+
+	key := getDriftRecordKey(res)
+
+	var records []operands.DriftRecord
+	if cm != nil {
+		if recordsJSON, ok := cm.Data[key]; ok {
+			if err = json.Unmarshal([]byte(recordsJSON), &records); err != nil {
+				req.Logger.Info("can't parse the drift records; dropping them", "key", key, "error", err.Error())
+				records = nil
+			}
+		}
+	}
+
+	records = append(records, *res.Drift)
+	if len(records) > maxDriftRecords {
+		records = records[len(records)-maxDriftRecords:]
+	}
+
+	recordsJSON, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+
+	if cm == nil {
+		cm = newDriftReportConfigMap(req.Instance)
+		if err = controllerutil.SetControllerReference(req.Instance, cm, h.client.Scheme()); err != nil {
+			return err
+		}
+		cm.Data[key] = string(recordsJSON)
+		return h.client.Create(req.Ctx, cm)
+	}
+
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[key] = string(recordsJSON)
+	return h.client.Update(req.Ctx, cm)
+}
+
+func getDriftRecordKey(res *operands.EnsureResult) string {
+	return fmt.Sprintf("%s.%s", res.Type, res.Name)
+}
+
+func newDriftReportConfigMap(hc *hcov1.HyperConverged) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DriftReportConfigMapName,
+			Namespace: hc.Namespace,
+			Labels:    hcoutil.GetLabels(hc.Name, hcoutil.AppComponentDeployment),
+		},
+		Data: map[string]string{},
+	}
+}
@@ -2,9 +2,11 @@ package operandhandler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/fs"
 	"os"
+	"strings"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -42,103 +44,133 @@ var (
 	logger = logf.Log.WithName("operandHandlerInit")
 )
 
+// maxConcurrentOperands is the maximum number of operands that are reconciled concurrently
+const maxConcurrentOperands = 10
+
 type OperandHandler struct {
-	client   client.Client
-	operands []operands.Operand
+	client client.Client
+	dag    *operandDAG
 	// save for deletions
 	objects      []client.Object
 	eventEmitter hcoutil.EventEmitter
 }
 
-func NewOperandHandler(client client.Client, scheme *runtime.Scheme, ci hcoutil.ClusterInfo, eventEmitter hcoutil.EventEmitter) *OperandHandler {
-	operandList := []operands.Operand{
-		handlers.NewKvPriorityClassHandler(client, scheme),
-		handlers.NewKubevirtHandler(client, scheme),
-		handlers.NewCdiHandler(client, scheme),
-		handlers.NewCnaHandler(client, scheme),
-		handlers.NewAAQHandler(client, scheme),
-		handlers.NewMigControllerHandler(client, scheme),
-		handlers.NewVMFileRestoreHandler(client, scheme),
-		aie.NewAIEWebhookServiceAccountHandler(client, scheme),
-		aie.NewAIEWebhookServiceHandler(client, scheme),
-		aie.NewAIEWebhookDeploymentHandler(client, scheme),
-		aie.NewAIEWebhookConfigMapHandler(client, scheme),
-		aie.NewAIEWebhookClusterRoleHandler(client, scheme),
-		aie.NewAIEWebhookClusterRoleBindingHandler(client, scheme),
-		aie.NewAIEWebhookMutatingWebhookConfigurationHandler(client, scheme),
-		netresinjector.NewClusterRoleHandler(client, scheme),
-		netresinjector.NewClusterRoleBindingHandler(client, scheme),
-		netresinjector.NewServiceAccountHandler(client, scheme),
-		netresinjector.NewServiceHandler(client, scheme),
-		netresinjector.NewDeploymentHandler(client, scheme),
-		netresinjector.NewPDBHandler(client, scheme),
-		netresinjector.NewMutatingWebhookConfigurationHandler(client, scheme),
-	}
+// NewOperandHandler declares the operands as a DAG. An operand is reconciled only after the operands it depends on
+// were reconciled successfully; e.g. a deployment is reconciled after its service account and its RBAC resources.
+// Operands that do not depend on each other are reconciled concurrently.
+func NewOperandHandler(client client.Client, apiReader client.Reader, scheme *runtime.Scheme, ci hcoutil.ClusterInfo, eventEmitter hcoutil.EventEmitter) *OperandHandler {
+	dag := newOperandDAG()
+
+	dag.add("kubevirt-priority-class", handlers.NewKvPriorityClassHandler(client, scheme))
+
+	dag.add("network-resources-injector-cluster-role", netresinjector.NewClusterRoleHandler(client, scheme))
+	dag.add("network-resources-injector-service-account", netresinjector.NewServiceAccountHandler(client, scheme))
+	dag.add("network-resources-injector-cluster-role-binding", netresinjector.NewClusterRoleBindingHandler(client, scheme),
+		"network-resources-injector-cluster-role", "network-resources-injector-service-account")
+	dag.add("network-resources-injector-service", netresinjector.NewServiceHandler(client, scheme))
+	dag.add("network-resources-injector-deployment", netresinjector.NewDeploymentHandler(client, scheme),
+		"network-resources-injector-service-account", "network-resources-injector-service", "network-resources-injector-cluster-role-binding")
+	dag.add("network-resources-injector-pdb", netresinjector.NewPDBHandler(client, scheme))
+	dag.add("network-resources-injector-mutating-webhook-configuration", netresinjector.NewMutatingWebhookConfigurationHandler(client, scheme),
+		"network-resources-injector-deployment")
+
+	// the KubeVirt feature gates depend on the readiness of the network resources injector
+	dag.add("kubevirt", handlers.NewKubevirtHandler(client, scheme),
+		"kubevirt-priority-class", "network-resources-injector-deployment")
+	dag.add("migration-policies", handlers.NewMigrationPoliciesHandler(client, scheme), "kubevirt")
+	dag.add("cdi", handlers.NewCdiHandler(client, scheme))
+	dag.add("cna", handlers.NewCnaHandler(client, scheme))
+	dag.add("aaq", handlers.NewAAQHandler(client, scheme))
+	dag.add("mig-controller", handlers.NewMigControllerHandler(client, scheme))
+	dag.add("vm-file-restore", handlers.NewVMFileRestoreHandler(client, scheme))
+
+	dag.add("aie-webhook-service-account", aie.NewAIEWebhookServiceAccountHandler(client, scheme))
+	dag.add("aie-webhook-service", aie.NewAIEWebhookServiceHandler(client, scheme))
+	dag.add("aie-webhook-configmap", aie.NewAIEWebhookConfigMapHandler(client, apiReader, scheme))
+	dag.add("aie-webhook-cluster-role", aie.NewAIEWebhookClusterRoleHandler(client, scheme))
+	dag.add("aie-webhook-cluster-role-binding", aie.NewAIEWebhookClusterRoleBindingHandler(client, scheme),
+		"aie-webhook-cluster-role", "aie-webhook-service-account")
+	dag.add("aie-webhook-deployment", aie.NewAIEWebhookDeploymentHandler(client, scheme),
+		"aie-webhook-service-account", "aie-webhook-service", "aie-webhook-cluster-role-binding")
+	dag.add("aie-webhook-pdb", aie.NewAIEWebhookPDBHandler(client, scheme))
+	dag.add("aie-webhook-mutating-webhook-configuration", aie.NewAIEWebhookMutatingWebhookConfigurationHandler(client, scheme),
+		"aie-webhook-deployment")
 
 	if ci.IsMonitoringAvailable() && os.Getenv(hcoutil.ObservabilityControllerImageEnvV) != "" {
-		operandList = append(operandList, []operands.Operand{
-			observabilitycontroller.NewServiceAccountHandler(client, scheme),
-			observabilitycontroller.NewClusterRoleHandler(client, scheme),
-			observabilitycontroller.NewClusterRoleBindingHandler(client, scheme),
-			observabilitycontroller.NewDeploymentHandler(client, scheme),
-		}...)
+		dag.add("observability-controller-service-account", observabilitycontroller.NewServiceAccountHandler(client, scheme))
+		dag.add("observability-controller-cluster-role", observabilitycontroller.NewClusterRoleHandler(client, scheme))
+		dag.add("observability-controller-cluster-role-binding", observabilitycontroller.NewClusterRoleBindingHandler(client, scheme),
+			"observability-controller-cluster-role", "observability-controller-service-account")
+		dag.add("observability-controller-deployment", observabilitycontroller.NewDeploymentHandler(client, scheme),
+			"observability-controller-service-account", "observability-controller-cluster-role-binding")
 	}
 
 	if ci.IsOpenshift() {
-		operandList = append(operandList, []operands.Operand{
-			handlers.NewSspHandler(client, scheme),
-			handlers.NewCliDownloadHandler(client, scheme),
-			handlers.NewCliDownloadsRouteHandler(client, scheme),
-			operands.NewServiceHandler(client, scheme, handlers.NewCliDownloadsService()),
-			waspagent.NewWaspAgentServiceAccountHandler(client, scheme),
-			waspagent.NewWaspAgentSCCHandler(client, scheme),
-			waspagent.NewWaspAgentDaemonSetHandler(client, scheme),
-			waspagent.NewWaspAgentClusterRoleHandler(client, scheme),
-			waspagent.NewWaspAgentClusterRoleBindingHandler(client, scheme),
-			handlers.NewVirtioWinCmReaderRoleHandler(client, scheme),
-			handlers.NewVirtioWinCmReaderRoleBindingHandler(client, scheme),
-		}...)
+		dag.add("ssp", handlers.NewSspHandler(client, scheme))
+		dag.add("cli-downloads-service", operands.NewServiceHandler(client, scheme, handlers.NewCliDownloadsService()))
+		dag.add("cli-downloads-route", handlers.NewCliDownloadsRouteHandler(client, scheme), "cli-downloads-service")
+		dag.add("cli-download", handlers.NewCliDownloadHandler(client, scheme), "cli-downloads-route")
+
+		dag.add("wasp-agent-service-account", waspagent.NewWaspAgentServiceAccountHandler(client, scheme))
+		dag.add("wasp-agent-scc", waspagent.NewWaspAgentSCCHandler(client, scheme), "wasp-agent-service-account")
+		dag.add("wasp-agent-cluster-role", waspagent.NewWaspAgentClusterRoleHandler(client, scheme))
+		dag.add("wasp-agent-cluster-role-binding", waspagent.NewWaspAgentClusterRoleBindingHandler(client, scheme),
+			"wasp-agent-cluster-role", "wasp-agent-service-account")
+		dag.add("wasp-agent-daemonset", waspagent.NewWaspAgentDaemonSetHandler(client, scheme),
+			"wasp-agent-service-account", "wasp-agent-scc", "wasp-agent-cluster-role-binding")
+
+		dag.add("virtio-win-cm-reader-role", handlers.NewVirtioWinCmReaderRoleHandler(client, scheme))
+		dag.add("virtio-win-cm-reader-role-binding", handlers.NewVirtioWinCmReaderRoleBindingHandler(client, scheme),
+			"virtio-win-cm-reader-role")
 
 		virtioWinCMHandler, err := handlers.NewVirtioWinCmHandler(client, scheme)
 		if err != nil {
 			logger.Error(err, "failed to create a handler for the virtio-win ConfigMap")
 		} else {
-			operandList = append(operandList, virtioWinCMHandler)
+			dag.add("virtio-win-cm", virtioWinCMHandler)
 		}
 
 		if ci.IsConsolePluginImageProvided() {
-			operandList = append(operandList, []operands.Operand{
-				handlers.NewConsoleHandler(client),
-				operands.NewServiceHandler(client, scheme, handlers.NewKvUIPluginSvc()),
-				operands.NewServiceHandler(client, scheme, handlers.NewKvUIProxySvc()),
-				handlers.NewKvUIPluginSAHandler(client, scheme),
-				handlers.NewKvUIProxySAHandler(client, scheme),
-				handlers.NewKvUIPluginDeploymentHandler(client, scheme),
-				handlers.NewKvUIProxyDeploymentHandler(client, scheme),
-				handlers.NewKvUIUserSettingsCMHandler(client, scheme),
-				handlers.NewKvUIFeaturesCMHandler(client, scheme),
-				handlers.NewKvUIPluginCRHandler(client, scheme),
-				handlers.NewKvUINginxCMHandler(client, scheme),
-				handlers.NewKvUIConfigReaderRoleHandler(client, scheme),
-				handlers.NewKvUIConfigReaderRoleBindingHandler(client, scheme),
-				handlers.NewKVConsolePluginNetworkPolicyHandler(client, scheme),
-				handlers.NewKVAPIServerProxyNetworkPolicyHandler(client, scheme),
-			}...)
+			dag.add("kubevirt-plugin-service", operands.NewServiceHandler(client, scheme, handlers.NewKvUIPluginSvc()))
+			dag.add("kubevirt-apiserver-proxy-service", operands.NewServiceHandler(client, scheme, handlers.NewKvUIProxySvc()))
+			dag.add("kubevirt-plugin-service-account", handlers.NewKvUIPluginSAHandler(client, scheme))
+			dag.add("kubevirt-apiserver-proxy-service-account", handlers.NewKvUIProxySAHandler(client, scheme))
+			dag.add("kubevirt-plugin-nginx-cm", handlers.NewKvUINginxCMHandler(client, scheme))
+			dag.add("kubevirt-plugin-deployment", handlers.NewKvUIPluginDeploymentHandler(client, scheme),
+				"kubevirt-plugin-service-account", "kubevirt-plugin-nginx-cm")
+			dag.add("kubevirt-apiserver-proxy-deployment", handlers.NewKvUIProxyDeploymentHandler(client, scheme),
+				"kubevirt-apiserver-proxy-service-account")
+			dag.add("kubevirt-plugin-pdb", handlers.NewKvUIPluginPDBHandler(client, scheme))
+			dag.add("kubevirt-apiserver-proxy-pdb", handlers.NewKvUIProxyPDBHandler(client, scheme))
+			dag.add("kubevirt-ui-user-settings-cm", handlers.NewKvUIUserSettingsCMHandler(client, scheme))
+			dag.add("kubevirt-ui-features-cm", handlers.NewKvUIFeaturesCMHandler(client, scheme))
+			dag.add("kubevirt-ui-config-reader-role", handlers.NewKvUIConfigReaderRoleHandler(client, scheme))
+			dag.add("kubevirt-ui-config-reader-role-binding", handlers.NewKvUIConfigReaderRoleBindingHandler(client, scheme),
+				"kubevirt-ui-config-reader-role")
+			dag.add("kubevirt-plugin-network-policy", handlers.NewKVConsolePluginNetworkPolicyHandler(client, scheme))
+			dag.add("kubevirt-apiserver-proxy-network-policy", handlers.NewKVAPIServerProxyNetworkPolicyHandler(client, scheme))
+			dag.add("kubevirt-console-plugin", handlers.NewKvUIPluginCRHandler(client, scheme),
+				"kubevirt-plugin-service", "kubevirt-apiserver-proxy-service")
+			dag.add("console", handlers.NewConsoleHandler(client), "kubevirt-console-plugin")
 		}
 	} else {
-		operandList = append(operandList,
-			handlers.NewCertManagerIssuerHandler(client, scheme),
-			netresinjector.NewCertManagerCertHandler(client, scheme),
-		)
+		dag.add("cert-manager-issuer", handlers.NewCertManagerIssuerHandler(client, scheme))
+		dag.add("network-resources-injector-certificate", netresinjector.NewCertManagerCertHandler(client, scheme),
+			"cert-manager-issuer")
+
+		// the standalone replacements of the OpenShift-only operands
+		dag.add("cli-downloads-service", operands.NewServiceHandler(client, scheme, handlers.NewCliDownloadsService()))
+		dag.add("cli-downloads-ingress", handlers.NewCliDownloadsIngressHandler(client, scheme), "cli-downloads-service")
+		dag.add("golden-images", handlers.NewGoldenImagesHandler(client, scheme), "cdi")
 	}
 
 	if ci.IsManagedByOLM() {
-		operandList = append(operandList, handlers.NewCsvHandler(client))
+		dag.add("csv", handlers.NewCsvHandler(client))
 	}
 
 	return &OperandHandler{
 		client:       client,
-		operands:     operandList,
+		dag:          dag,
 		eventEmitter: eventEmitter,
 	}
 }
@@ -147,20 +179,23 @@ func NewOperandHandler(client client.Client, scheme *runtime.Scheme, ci hcoutil.
 // The k8s client is not available when calling to NewOperandHandler.
 // Initial operations that need to read/write from the cluster can only be done when the client is already working.
 func (h *OperandHandler) FirstUseInitiation(scheme *runtime.Scheme, ci hcoutil.ClusterInfo, hc *hcov1.HyperConverged, pwdFS fs.FS) {
-	for _, operand := range h.operands {
-		h.addOperandObject(operand, hc)
+	for _, node := range h.dag.nodes {
+		h.addOperandObject(node.operand, hc)
 	}
 
 	if !ci.IsOpenshift() {
 		return
 	}
 
-	for _, fn := range []operands.GetHandlers{
-		handlers.GetQuickStartHandlers,
-		handlers.GetDashboardHandlers,
-		handlers.GetImageStreamHandlers,
+	for _, group := range []struct {
+		name string
+		fn   operands.GetHandlers
+	}{
+		{name: "quickstart", fn: handlers.GetQuickStartHandlers},
+		{name: "dashboard", fn: handlers.GetDashboardHandlers},
+		{name: "imagestream", fn: handlers.GetImageStreamHandlers},
 	} {
-		h.addOperands(scheme, hc, fn, pwdFS)
+		h.addOperands(scheme, hc, group.name, group.fn, pwdFS)
 	}
 }
 
@@ -195,56 +230,169 @@ func (h *OperandHandler) addOperandObject(handler operands.Operand, hc *hcov1.Hy
 	}
 }
 
-func (h *OperandHandler) addOperands(scheme *runtime.Scheme, hc *hcov1.HyperConverged, getHandlers operands.GetHandlers, dir fs.FS) {
+// addOperands adds a group of handlers that are created from manifest files. The handlers do not depend on each other.
+func (h *OperandHandler) addOperands(scheme *runtime.Scheme, hc *hcov1.HyperConverged, groupName string, getHandlers operands.GetHandlers, dir fs.FS) {
 	handlers, err := getHandlers(logger, h.client, scheme, hc, dir)
 	if err != nil {
 		logger.Error(err, "can't create handler")
 	} else if len(handlers) > 0 {
-		for _, handler := range handlers {
+		for i, handler := range handlers {
 			h.addOperandObject(handler, hc)
+			h.dag.add(fmt.Sprintf("%s-%d", groupName, i), handler)
 		}
-		h.operands = append(h.operands, handlers...)
 	}
 }
 
+// Ensure reconciles the operands according to the operand DAG. An error in one operand does not stop the
+// reconciliation of the operands that do not depend on it. All the errors are returned together.
+//
+// In upgrade mode, the operands are reconciled one by one, in their declaration order.
 func (h *OperandHandler) Ensure(req *common.HcoRequest) error {
-	for _, handler := range h.operands {
-		res := handler.Ensure(req)
+	limit := maxConcurrentOperands
+	if req.UpgradeMode {
+		limit = 1
+	}
+
+	// each operand modifies its own request; the modifications are merged by the declaration order of the operands, so
+	// the result does not depend on the order of the concurrent reconciliations.
+	operandReqs := make([]*common.HcoRequest, len(h.dag.nodes))
+	for i := range operandReqs {
+		operandReqs[i] = req.NewOperandRequest()
+	}
+
+	results := h.dag.walk(limit, func(i int, node *operandNode) *operands.EnsureResult {
+		start := time.Now()
+		defer func() {
+			metrics.ObserveOperandReconcileDuration(node.name, time.Since(start))
+		}()
+
+		return node.operand.Ensure(operandReqs[i])
+	})
+
+	var errs []error
+	upgradeDone := req.ComponentUpgradeInProgress
+	components := make([]hcov1.ComponentStatus, 0, len(req.Instance.Status.Components))
+	for i, res := range results {
+		name := h.dag.nodes[i].name
+		if res == nil {
+			req.Logger.Info("skipped the reconciliation of an operand, because one of its dependencies failed", "operand", name)
+			upgradeDone = false
+			continue
+		}
+
+		req.MergeOperandRequest(operandReqs[i])
+
 		if res.Err != nil {
-			req.Logger.Error(res.Err, "failed to Ensure an operand")
-
-			req.ComponentUpgradeInProgress = false
-			req.Conditions.SetStatusCondition(metav1.Condition{
-				Type:               hcov1.ConditionReconcileComplete,
-				Status:             metav1.ConditionFalse,
-				Reason:             reconcileFailed,
-				Message:            fmt.Sprintf("Error while reconciling: %v", res.Err),
-				ObservedGeneration: req.Instance.Generation,
-			})
-			return res.Err
+			req.Logger.Error(res.Err, "failed to Ensure an operand", "operand", name)
+
+			if res.Component != nil {
+				setComponentError(req, res.Component)
+			}
+
+			errs = append(errs, res.Err)
+			upgradeDone = false
+			continue
 		}
 
 		if res.Created {
 			h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created %s %s", res.Type, res.Name))
+		} else if res.Resumed {
+			h.handleResumedOperand(req, res)
 		} else if res.Updated {
 			h.handleUpdatedOperand(req, res)
 		} else if res.Deleted {
 			h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Killing", fmt.Sprintf("Removed %s %s", res.Type, res.Name))
 		}
 
-		req.ComponentUpgradeInProgress = req.ComponentUpgradeInProgress && res.UpgradeDone
+		if res.Component != nil {
+			components = append(components, *res.Component)
+		}
+
+		upgradeDone = upgradeDone && res.UpgradeDone
 	}
+
+	req.ComponentUpgradeInProgress = upgradeDone
+
+	if len(errs) > 0 {
+		err := errs[0]
+		if len(errs) > 1 {
+			err = errors.Join(errs...)
+		}
+
+		req.Conditions.SetStatusCondition(metav1.Condition{
+			Type:               hcov1.ConditionReconcileComplete,
+			Status:             metav1.ConditionFalse,
+			Reason:             reconcileFailed,
+			Message:            fmt.Sprintf("Error while reconciling: %v", err),
+			ObservedGeneration: req.Instance.Generation,
+		})
+
+		// the failed and the skipped operands did not report their components; keep their previous status
+		mergeComponents(req, components)
+		return err
+	}
+
+	updateComponents(req, components)
 	return nil
+}
 
+// Plan reports, for each operand, what the reconciliation of req.Instance would change, without writing anything.
+func (h *OperandHandler) Plan(req *common.HcoRequest) []operands.PlanResult {
+	// the handlers cache the required objects; make sure they are generated from req.Instance, and that the next
+	// reconciliation won't use the planned objects.
+	h.Reset()
+	defer h.Reset()
+
+	results := make([]operands.PlanResult, 0, len(h.dag.nodes))
+	for _, node := range h.dag.nodes {
+		planner, ok := node.operand.(operands.Planner)
+		if !ok {
+			continue
+		}
+
+		if res := planner.Plan(req); res != nil {
+			results = append(results, *res)
+		}
+	}
+
+	return results
 }
 
 func (h *OperandHandler) handleUpdatedOperand(req *common.HcoRequest, res *operands.EnsureResult) {
 	if !res.Overwritten {
 		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated %s %s", res.Type, res.Name))
 	} else {
-		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "Overwritten", fmt.Sprintf("Overwritten %s %s", res.Type, res.Name))
+		msg := fmt.Sprintf("Overwritten %s %s", res.Type, res.Name)
+		if res.Drift != nil {
+			msg = fmt.Sprintf("%s; modified fields: %s", msg, strings.Join(res.Drift.Paths(), ", "))
+		}
+		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "Overwritten", msg)
 		if !req.UpgradeMode {
 			metrics.IncOverwrittenModifications(res.Type, res.Name)
+			if res.Drift != nil {
+				if err := h.recordDrift(req, res); err != nil {
+					req.Logger.Error(err, "failed to record the drift of an operand", "type", res.Type, "name", res.Name)
+				}
+			}
+		}
+	}
+}
+
+// handleResumedOperand reports the manual modifications of a previously paused operand, that were overwritten. These
+// modifications were done on purpose, while the operand was paused, so they are not counted as out-of-band
+// modifications, but they are still kept in the drift report.
+func (h *OperandHandler) handleResumedOperand(req *common.HcoRequest, res *operands.EnsureResult) {
+	msg := fmt.Sprintf("Resumed the reconciliation of %s %s", res.Type, res.Name)
+	if res.Drift != nil {
+		msg = fmt.Sprintf("%s; overwritten modifications: %s", msg, strings.Join(res.Drift.Paths(), ", "))
+	}
+
+	req.Logger.Info(msg)
+	h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Resumed", msg)
+
+	if res.Drift != nil {
+		if err := h.recordDrift(req, res); err != nil {
+			req.Logger.Error(err, "failed to record the drift of an operand", "type", res.Type, "name", res.Name)
 		}
 	}
 }
@@ -270,6 +418,19 @@ func (h *OperandHandler) deleteMultipleResources(tCtx context.Context, req *comm
 	eg, egCtx := errgroup.WithContext(tCtx)
 	eg.SetLimit(10)
 
+	for _, node := range h.dag.nodes {
+		if deleter, ok := node.operand.(operands.MultiObjectDeleter); ok {
+			eg.Go(func() error {
+				if err := deleter.EnsureDeleted(egCtx, req); err != nil {
+					req.Logger.Error(err, "Failed to delete the objects of an operand", "operand", node.name)
+					h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, ErrHCOUninstall, uninstallHCOErrorMsg)
+					return err
+				}
+				return nil
+			})
+		}
+	}
+
 	for _, o := range h.objects {
 		eg.Go(func() error {
 			deleted, err := hcoutil.EnsureDeleted(egCtx, h.client, o, req.Instance.Name, req.Logger, false, true, true)
@@ -301,7 +462,7 @@ func (h *OperandHandler) deleteSingleResource(ctx context.Context, req *common.H
 }
 
 func (h *OperandHandler) Reset() {
-	for _, op := range h.operands {
-		op.Reset()
+	for _, node := range h.dag.nodes {
+		node.operand.Reset()
 	}
 }
@@ -2,6 +2,7 @@ package operandhandler
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/fs"
 	"os"
@@ -15,6 +16,7 @@ import (
 	imagev1 "github.com/openshift/api/image/v1"
 	objectreferencesv1 "github.com/openshift/custom-resource-status/objectreferences/v1"
 	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/reference"
@@ -28,6 +30,8 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/dirtest"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	fakeownresources "github.com/kubevirt/hyperconverged-cluster-operator/pkg/ownresources/fake"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -79,7 +83,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -171,12 +175,12 @@ var _ = Describe("Test operandHandler", func() {
 
 		It("should handle errors on Ensure loop", func() {
 			hco := commontestutils.NewHco()
-			cli := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, hco, commontestutils.GetCSV()})
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 			ci := commontestutils.ClusterInfoMock{}
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -207,6 +211,67 @@ var _ = Describe("Test operandHandler", func() {
 				Expect(cdiList).ToNot(BeNil())
 				Expect(cdiList.Items).To(BeEmpty())
 			})
+
+			By("make sure the error did not block the unrelated operands", func() {
+				kvList := kubevirtcorev1.KubeVirtList{}
+				Expect(cli.List(req.Ctx, &kvList)).To(Succeed())
+				Expect(kvList.Items).To(HaveLen(1))
+
+				qsList := consolev1.ConsoleQuickStartList{}
+				Expect(cli.List(req.Ctx, &qsList)).To(Succeed())
+				Expect(qsList.Items).To(HaveLen(1))
+			})
+		})
+
+		It("should return all the errors of the Ensure loop", func() {
+			hco := commontestutils.NewHco()
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, hco, commontestutils.GetCSV()})
+
+			eventEmitter := commontestutils.NewEventEmitterMock()
+			ci := commontestutils.ClusterInfoMock{}
+
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
+
+			req := commontestutils.NewReq(hco)
+
+			cdiError := fmt.Errorf("fake create CDI error")
+			priorityClassError := fmt.Errorf("fake create PriorityClass error")
+			cli.InitiateCreateErrors(func(obj client.Object) error {
+				switch obj.(type) {
+				case *cdiv1beta1.CDI:
+					return cdiError
+				case *schedulingv1.PriorityClass:
+					return priorityClassError
+				}
+
+				return nil
+			})
+
+			err := handler.Ensure(req)
+			Expect(err).To(MatchError(cdiError))
+			Expect(err).To(MatchError(priorityClassError))
+
+			cond := req.Conditions[hcov1.ConditionReconcileComplete]
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Message).To(ContainSubstring(cdiError.Error()))
+			Expect(cond.Message).To(ContainSubstring(priorityClassError.Error()))
+
+			By("make sure KubeVirt is skipped, because it depends on the priority class", func() {
+				kvList := kubevirtcorev1.KubeVirtList{}
+				Expect(cli.List(req.Ctx, &kvList)).To(Succeed())
+				Expect(kvList.Items).To(BeEmpty())
+			})
+
+			By("make sure the independent operands are reconciled", func() {
+				cnaList := networkaddonsv1.NetworkAddonsConfigList{}
+				Expect(cli.List(req.Ctx, &cnaList)).To(Succeed())
+				Expect(cnaList.Items).To(HaveLen(1))
+			})
+
+			By("make sure the reconciliation duration is recorded for each operand", func() {
+				Expect(metrics.GetOperandReconcileCount("cna")).To(BeNumerically(">", 0))
+			})
 		})
 
 		It("make sure the all objects are deleted", func() {
@@ -216,7 +281,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -304,7 +369,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -353,7 +418,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -404,7 +469,7 @@ var _ = Describe("Test operandHandler", func() {
 			fakeError := fmt.Errorf("fake CNA deletion error")
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -453,7 +518,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -490,6 +555,315 @@ var _ = Describe("Test operandHandler", func() {
 		})
 	})
 
+	Context("test status.components", func() {
+		var (
+			hco          *hcov1.HyperConverged
+			cli          *commontestutils.HcoTestClient
+			handler      *OperandHandler
+			eventEmitter *commontestutils.EventEmitterMock
+		)
+
+		BeforeEach(func() {
+			hco = commontestutils.NewHco()
+			cli = commontestutils.InitClient([]client.Object{commontestutils.NewHcoNamespace(), hco, commontestutils.GetCSV()})
+			eventEmitter = commontestutils.NewEventEmitterMock()
+			ci := commontestutils.ClusterInfoMock{}
+
+			handler = NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
+		})
+
+		It("should report the status of each operand", func() {
+			req := commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(hco.Status.Components).To(ContainElements(
+				HaveField("Kind", "KubeVirt"),
+				HaveField("Kind", "CDI"),
+				HaveField("Kind", "NetworkAddonsConfig"),
+				HaveField("Kind", "SSP"),
+			))
+
+			kv := handlers.NewKubeVirtWithNameOnly()
+			Expect(cli.Get(req.Ctx, client.ObjectKeyFromObject(kv), kv)).To(Succeed())
+			kv.Status.ObservedKubeVirtVersion = "1.2.3"
+			kv.Status.Conditions = []kubevirtcorev1.KubeVirtCondition{
+				{Type: kubevirtcorev1.KubeVirtConditionAvailable, Status: corev1.ConditionTrue, Reason: "AllComponentsReady"},
+				{Type: kubevirtcorev1.KubeVirtConditionDegraded, Status: corev1.ConditionFalse},
+			}
+			Expect(cli.Update(req.Ctx, kv)).To(Succeed())
+
+			req = commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+			Expect(req.StatusDirty).To(BeTrue())
+
+			kvComponent := findComponent(hco.Status.Components, "KubeVirt")
+			Expect(kvComponent).ToNot(BeNil())
+			Expect(kvComponent.Name).To(Equal("kubevirt-kubevirt-hyperconverged"))
+			Expect(kvComponent.ObservedVersion).To(Equal("1.2.3"))
+			Expect(kvComponent.LastAppliedGeneration).To(Equal(kv.Generation))
+			Expect(kvComponent.LastReconcileError).To(BeEmpty())
+			Expect(kvComponent.Conditions).To(HaveLen(2))
+			Expect(kvComponent.Conditions[0].Reason).To(Equal("AllComponentsReady"))
+			Expect(kvComponent.Conditions[0].LastTransitionTime.IsZero()).To(BeFalse())
+			Expect(kvComponent.Conditions[1].Reason).To(Equal(noReason))
+
+			By("should not modify the status if nothing was changed")
+			req = commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+			Expect(req.StatusDirty).To(BeFalse())
+		})
+
+		It("should report the reconcile error of the failing operand", func() {
+			req := commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+			components := len(hco.Status.Components)
+
+			cdi := handlers.NewCDIWithNameOnly()
+			Expect(cli.Get(req.Ctx, client.ObjectKeyFromObject(cdi), cdi)).To(Succeed())
+			cdi.Spec.Infra.NodeSelector = map[string]string{"modified": "true"}
+			Expect(cli.Update(req.Ctx, cdi)).To(Succeed())
+
+			fakeError := fmt.Errorf("fake update CDI error")
+			cli.InitiateUpdateErrors(func(obj client.Object) error {
+				if _, ok := obj.(*cdiv1beta1.CDI); ok {
+					return fakeError
+				}
+				return nil
+			})
+
+			req = commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(MatchError(fakeError))
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(hco.Status.Components).To(HaveLen(components))
+
+			cdiComponent := findComponent(hco.Status.Components, "CDI")
+			Expect(cdiComponent).ToNot(BeNil())
+			Expect(cdiComponent.Name).To(Equal("cdi-kubevirt-hyperconverged"))
+			Expect(cdiComponent.LastReconcileError).To(Equal(fakeError.Error()))
+
+			By("should clear the error after a successful reconciliation")
+			cli.InitiateUpdateErrors(nil)
+			req = commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			cdiComponent = findComponent(hco.Status.Components, "CDI")
+			Expect(cdiComponent).ToNot(BeNil())
+			Expect(cdiComponent.LastReconcileError).To(BeEmpty())
+		})
+	})
+
+	Context("test paused operands", func() {
+		It("should keep the manual modifications while paused, and report the overwritten ones when resumed", func() {
+			hco := commontestutils.NewHco()
+			cli := commontestutils.InitClient([]client.Object{commontestutils.NewHcoNamespace(), hco, commontestutils.GetCSV()})
+			eventEmitter := commontestutils.NewEventEmitterMock()
+			ci := commontestutils.ClusterInfoMock{}
+
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
+
+			req := commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			By("pause KubeVirt and modify it")
+			hco.Spec.Deployment.PausedOperands = []hcov1.OperandKind{hcov1.OperandKindKubeVirt}
+			kv := handlers.NewKubeVirtWithNameOnly()
+			Expect(cli.Get(req.Ctx, client.ObjectKeyFromObject(kv), kv)).To(Succeed())
+			kv.Spec.Configuration.MigrationConfiguration.AllowPostCopy = new(true)
+			Expect(cli.Update(req.Ctx, kv)).To(Succeed())
+
+			handler.Reset()
+			eventEmitter.Reset()
+			req = commontestutils.NewReq(hco)
+			req.HCOTriggered = false
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			Expect(findComponent(hco.Status.Components, "KubeVirt")).To(HaveField("Paused", BeTrue()))
+			Expect(findComponent(hco.Status.Components, "CDI")).To(HaveField("Paused", BeFalse()))
+			Expect(cli.Get(req.Ctx, client.ObjectKeyFromObject(kv), kv)).To(Succeed())
+			Expect(kv.Spec.Configuration.MigrationConfiguration.AllowPostCopy).To(HaveValue(BeTrue()))
+
+			By("resume KubeVirt")
+			hco.Spec.Deployment.PausedOperands = nil
+			handler.Reset()
+			eventEmitter.Reset()
+			req = commontestutils.NewReq(hco)
+			req.HCOTriggered = false
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			Expect(findComponent(hco.Status.Components, "KubeVirt")).To(HaveField("Paused", BeFalse()))
+			Expect(cli.Get(req.Ctx, client.ObjectKeyFromObject(kv), kv)).To(Succeed())
+			Expect(kv.Spec.Configuration.MigrationConfiguration.AllowPostCopy).To(HaveValue(BeFalse()))
+
+			Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeNormal,
+					Reason:    "Resumed",
+					Msg:       "Resumed the reconciliation of KubeVirt kubevirt-kubevirt-hyperconverged; overwritten modifications: /spec/configuration/migrations/allowPostCopy",
+				},
+			})).To(BeTrue())
+			Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    "Overwritten",
+					Msg:       "Overwritten KubeVirt kubevirt-kubevirt-hyperconverged; modified fields: /spec/configuration/migrations/allowPostCopy",
+				},
+			})).To(BeFalse())
+
+			cm := &corev1.ConfigMap{}
+			Expect(cli.Get(req.Ctx, client.ObjectKey{Name: DriftReportConfigMapName, Namespace: commontestutils.Namespace}, cm)).To(Succeed())
+			Expect(cm.Data).To(HaveKey("KubeVirt.kubevirt-kubevirt-hyperconverged"))
+
+			var records []operands.DriftRecord
+			Expect(json.Unmarshal([]byte(cm.Data["KubeVirt.kubevirt-kubevirt-hyperconverged"]), &records)).To(Succeed())
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].Changes).To(Equal([]operands.FieldDrift{
+				{Path: "/spec/configuration/migrations/allowPostCopy", Found: true, Required: false},
+			}))
+		})
+	})
+
+	Context("test drift report", func() {
+		var (
+			hco          *hcov1.HyperConverged
+			cli          *commontestutils.HcoTestClient
+			eventEmitter *commontestutils.EventEmitterMock
+			handler      *OperandHandler
+		)
+
+		BeforeEach(func() {
+			hco = commontestutils.NewHco()
+			cli = commontestutils.InitClient([]client.Object{commontestutils.NewHcoNamespace(), hco, commontestutils.GetCSV()})
+			eventEmitter = commontestutils.NewEventEmitterMock()
+			ci := commontestutils.ClusterInfoMock{}
+
+			handler = NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
+
+			req := commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+		})
+
+		modifyKV := func(ctx context.Context) {
+			GinkgoHelper()
+			kv := handlers.NewKubeVirtWithNameOnly()
+			Expect(cli.Get(ctx, client.ObjectKeyFromObject(kv), kv)).To(Succeed())
+			kv.Spec.Configuration.MigrationConfiguration.AllowPostCopy = new(true)
+			Expect(cli.Update(ctx, kv)).To(Succeed())
+		}
+
+		reconcile := func() *corev1.ConfigMap {
+			GinkgoHelper()
+			handler.Reset()
+			eventEmitter.Reset()
+			req := commontestutils.NewReq(hco)
+			req.HCOTriggered = false
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			cm := &corev1.ConfigMap{}
+			Expect(cli.Get(req.Ctx, client.ObjectKey{Name: DriftReportConfigMapName, Namespace: commontestutils.Namespace}, cm)).To(Succeed())
+			return cm
+		}
+
+		getRecords := func(cm *corev1.ConfigMap) []operands.DriftRecord {
+			GinkgoHelper()
+			Expect(cm.Data).To(HaveKey("KubeVirt.kubevirt-kubevirt-hyperconverged"))
+
+			var records []operands.DriftRecord
+			Expect(json.Unmarshal([]byte(cm.Data["KubeVirt.kubevirt-kubevirt-hyperconverged"]), &records)).To(Succeed())
+			return records
+		}
+
+		It("should record the overwritten modifications, and report them in the event", func() {
+			modifyKV(context.Background())
+			cm := reconcile()
+
+			Expect(cm.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hco.Name))
+			Expect(cm.OwnerReferences).To(HaveLen(1))
+			Expect(cm.OwnerReferences[0].Name).To(Equal(hco.Name))
+
+			records := getRecords(cm)
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].Changes).To(Equal([]operands.FieldDrift{
+				{Path: "/spec/configuration/migrations/allowPostCopy", Found: true, Required: false},
+			}))
+
+			Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    "Overwritten",
+					Msg:       "Overwritten KubeVirt kubevirt-kubevirt-hyperconverged; modified fields: /spec/configuration/migrations/allowPostCopy",
+				},
+			})).To(BeTrue())
+		})
+
+		It("should keep only the last records", func() {
+			for range maxDriftRecords + 2 {
+				modifyKV(context.Background())
+				reconcile()
+			}
+
+			records := getRecords(reconcile())
+			Expect(records).To(HaveLen(maxDriftRecords))
+		})
+
+		It("should not fail the reconciliation if the drift report is corrupted", func() {
+			modifyKV(context.Background())
+			cm := reconcile()
+			cm.Data["KubeVirt.kubevirt-kubevirt-hyperconverged"] = "not a json"
+			Expect(cli.Update(context.Background(), cm)).To(Succeed())
+
+			modifyKV(context.Background())
+			Expect(getRecords(reconcile())).To(HaveLen(1))
+		})
+	})
+
+	Context("test mergeComponentStatus", func() {
+		lastTransitionTime := metav1.NewTime(time.Now().Add(-time.Hour))
+
+		existing := &hcov1.ComponentStatus{
+			Kind: "KubeVirt",
+			Name: "kubevirt-kubevirt-hyperconverged",
+			Conditions: []metav1.Condition{
+				{Type: hcov1.ConditionAvailable, Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: lastTransitionTime},
+				{Type: hcov1.ConditionProgressing, Status: metav1.ConditionFalse, Reason: "Ready", LastTransitionTime: lastTransitionTime},
+				{Type: hcov1.ConditionDegraded, Status: metav1.ConditionFalse, Reason: "Ready", LastTransitionTime: lastTransitionTime},
+			},
+		}
+
+		It("should keep the transition time of unchanged conditions, and drop the missing ones", func() {
+			merged := mergeComponentStatus(existing, hcov1.ComponentStatus{
+				Kind: "KubeVirt",
+				Name: "kubevirt-kubevirt-hyperconverged",
+				Conditions: []metav1.Condition{
+					{Type: hcov1.ConditionAvailable, Status: metav1.ConditionTrue, Reason: "Ready"},
+					{Type: hcov1.ConditionProgressing, Status: metav1.ConditionTrue, Reason: "Deploying"},
+				},
+			})
+
+			Expect(merged.Conditions).To(HaveLen(2))
+
+			available := merged.Conditions[0]
+			Expect(available.Type).To(Equal(hcov1.ConditionAvailable))
+			Expect(available.LastTransitionTime).To(Equal(lastTransitionTime))
+
+			progressing := merged.Conditions[1]
+			Expect(progressing.Type).To(Equal(hcov1.ConditionProgressing))
+			Expect(progressing.Status).To(Equal(metav1.ConditionTrue))
+			Expect(progressing.Reason).To(Equal("Deploying"))
+			Expect(progressing.LastTransitionTime.After(lastTransitionTime.Time)).To(BeTrue())
+
+			Expect(existing.Conditions).To(HaveLen(3))
+		})
+
+		It("should drop all the conditions if the operand does not report any", func() {
+			merged := mergeComponentStatus(existing, hcov1.ComponentStatus{Kind: "KubeVirt"})
+			Expect(merged.Conditions).To(BeNil())
+		})
+	})
+
 	Context("test imageStream deletion", func() {
 		It("should delete the ImageStream resource if the FG is not set, and emit event", func() {
 			hcoNamespace := commontestutils.NewHcoNamespace()
@@ -499,7 +873,7 @@ var _ = Describe("Test operandHandler", func() {
 			ci := commontestutils.ClusterInfoMock{}
 			cli := commontestutils.InitClient([]client.Object{hcoNamespace, hco, commontestutils.GetCSV()})
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -554,7 +928,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco, pwdFS)
 
 			req := commontestutils.NewReq(hco)
@@ -0,0 +1,67 @@
+package operands
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+)
+
+// GetComponentsConfig returns the configuration of the workloads that HCO deploys directly. It never returns nil.
+func GetComponentsConfig(hc *hcov1.HyperConverged) *hcov1.ComponentsConfig {
+	if hc.Spec.Deployment.Components != nil {
+		return hc.Spec.Deployment.Components
+	}
+
+	return &hcov1.ComponentsConfig{}
+}
+
+// ApplyDeploymentConfig replaces the default resource requirements, priority class and number of replicas of a
+// Deployment with the ones that are set in the HyperConverged CR.
+func ApplyDeploymentConfig(dep *appsv1.Deployment, cfg *hcov1.ComponentDeploymentConfig) {
+	if cfg == nil {
+		return
+	}
+
+	ApplyPodConfig(&dep.Spec.Template.Spec, &cfg.ComponentPodConfig)
+
+	if cfg.Replicas != nil {
+		dep.Spec.Replicas = new(*cfg.Replicas)
+	}
+}
+
+// ApplyPodConfig replaces the default resource requirements of the containers, and the default priority class, of a
+// pod spec with the ones that are set in the HyperConverged CR.
+func ApplyPodConfig(podSpec *corev1.PodSpec, cfg *hcov1.ComponentPodConfig) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.Resources != nil {
+		for i := range podSpec.Containers {
+			podSpec.Containers[i].Resources = canonicalResources(cfg.Resources)
+		}
+	}
+
+	if cfg.PriorityClassName != "" {
+		podSpec.PriorityClassName = cfg.PriorityClassName
+	}
+}
+
+// canonicalResources returns a copy of the resource requirements, with all the quantities in their canonical form, as
+// they are read back from the API server; e.g. "0.5" cpu becomes "500m". Otherwise, the deployed objects are never
+// equal to the required ones, and they are updated on every reconciliation.
+func canonicalResources(resources *corev1.ResourceRequirements) corev1.ResourceRequirements {
+	res := *resources.DeepCopy()
+	canonicalResourceList(res.Limits)
+	canonicalResourceList(res.Requests)
+
+	return res
+}
+
+func canonicalResourceList(list corev1.ResourceList) {
+	for name, q := range list {
+		list[name] = resource.MustParse(q.String())
+	}
+}
@@ -0,0 +1,157 @@
+package operands
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("Components configuration", func() {
+	var dep *appsv1.Deployment
+
+	defaultResources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("10m"),
+			corev1.ResourceMemory: resource.MustParse("100Mi"),
+		},
+	}
+
+	BeforeEach(func() {
+		dep = &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{
+				Replicas: new(int32(2)),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						PriorityClassName: "kubevirt-cluster-critical",
+						Containers: []corev1.Container{
+							{Name: "first", Resources: *defaultResources.DeepCopy()},
+							{Name: "second", Resources: *defaultResources.DeepCopy()},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Context("GetComponentsConfig", func() {
+		It("should return an empty configuration if the components field is not set", func() {
+			hco := commontestutils.NewHco()
+			Expect(GetComponentsConfig(hco)).To(Equal(&hcov1.ComponentsConfig{}))
+		})
+	})
+
+	Context("ApplyDeploymentConfig", func() {
+		It("should keep the default values if there is no configuration", func() {
+			ApplyDeploymentConfig(dep, nil)
+			ApplyDeploymentConfig(dep, &hcov1.ComponentDeploymentConfig{})
+
+			Expect(dep.Spec.Replicas).To(HaveValue(Equal(int32(2))))
+			Expect(dep.Spec.Template.Spec.PriorityClassName).To(Equal("kubevirt-cluster-critical"))
+			for _, container := range dep.Spec.Template.Spec.Containers {
+				Expect(container.Resources).To(Equal(defaultResources))
+			}
+		})
+
+		It("should replace the default values with the configured ones", func() {
+			resources := &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("200Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("400Mi"),
+				},
+			}
+
+			cfg := &hcov1.ComponentDeploymentConfig{
+				ComponentPodConfig: hcov1.ComponentPodConfig{
+					Resources:         resources,
+					PriorityClassName: "system-cluster-critical",
+				},
+				Replicas: new(int32(3)),
+			}
+			ApplyDeploymentConfig(dep, cfg)
+
+			Expect(dep.Spec.Replicas).To(HaveValue(Equal(int32(3))))
+			Expect(dep.Spec.Replicas).ToNot(BeIdenticalTo(cfg.Replicas))
+			Expect(dep.Spec.Template.Spec.PriorityClassName).To(Equal("system-cluster-critical"))
+			for _, container := range dep.Spec.Template.Spec.Containers {
+				Expect(container.Resources).To(Equal(*resources))
+			}
+		})
+	})
+
+	Context("ApplyPodConfig", func() {
+		It("should only replace the resources, if only the resources are set", func() {
+			resources := &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("50m"),
+				},
+			}
+
+			ApplyPodConfig(&dep.Spec.Template.Spec, &hcov1.ComponentPodConfig{Resources: resources})
+
+			Expect(dep.Spec.Template.Spec.PriorityClassName).To(Equal("kubevirt-cluster-critical"))
+			for _, container := range dep.Spec.Template.Spec.Containers {
+				Expect(container.Resources).To(Equal(*resources))
+			}
+		})
+
+		It("should use the canonical form of the quantities", func() {
+			resources := &corev1.ResourceRequirements{}
+			Expect(yaml.Unmarshal([]byte("requests: {cpu: 0.5, memory: 1048576}\nlimits: {cpu: 1.0}"), resources)).To(Succeed())
+
+			ApplyPodConfig(&dep.Spec.Template.Spec, &hcov1.ComponentPodConfig{Resources: resources})
+
+			for _, container := range dep.Spec.Template.Spec.Containers {
+				Expect(container.Resources.Requests).To(HaveKeyWithValue(corev1.ResourceCPU, resource.MustParse("500m")))
+				Expect(container.Resources.Requests).To(HaveKeyWithValue(corev1.ResourceMemory, resource.MustParse("1048576")))
+				Expect(container.Resources.Limits).To(HaveKeyWithValue(corev1.ResourceCPU, resource.MustParse("1")))
+			}
+		})
+
+		It("should not update the Deployment again, when the quantities are not in their canonical form", func(ctx context.Context) {
+			resources := &corev1.ResourceRequirements{}
+			Expect(yaml.Unmarshal([]byte("requests: {cpu: 0.5}"), resources)).To(Succeed())
+
+			hco := commontestutils.NewHco()
+			hco.Spec.Deployment.Components = &hcov1.ComponentsConfig{
+				ConsolePlugin: &hcov1.ComponentDeploymentConfig{
+					ComponentPodConfig: hcov1.ComponentPodConfig{Resources: resources},
+				},
+			}
+			req := commontestutils.NewReq(hco)
+
+			getDeployment := func(hc *hcov1.HyperConverged) *appsv1.Deployment {
+				required := NewExpectedDeployment(hc)
+				required.Namespace = hc.Namespace
+				required.Spec.Template.Spec.Containers = []corev1.Container{{Name: "first", Resources: *defaultResources.DeepCopy()}}
+				ApplyDeploymentConfig(required, GetComponentsConfig(hc).ConsolePlugin)
+				return required
+			}
+
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := NewDeploymentHandler(cl, commontestutils.GetScheme(), getDeployment)
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			found := &appsv1.Deployment{}
+			Expect(cl.Get(ctx, client.ObjectKeyFromObject(getDeployment(hco)), found)).To(Succeed())
+			Expect(found.Spec.Template.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("500m"))
+
+			res = handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+		})
+	})
+})
@@ -2,6 +2,7 @@ package operands
 
 import (
 	objectreferencesv1 "github.com/openshift/custom-resource-status/objectreferences/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/reference"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -70,21 +71,32 @@ func (ch *ConditionalHandler) ensureDeleted(req *common.HcoRequest) *EnsureResul
 		return res.Error(err)
 	}
 
+	if err = removeRelatedObject(req, objectRef); err != nil {
+		return res.Error(err)
+	}
+
+	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+}
+
+func (ch *ConditionalHandler) GetFullCr(hc *hcov1.HyperConverged) (client.Object, error) {
+	return ch.operand.GetFullCr(hc)
+}
+
+func removeRelatedObject(req *common.HcoRequest, objectRef *corev1.ObjectReference) error {
+	req.Lock()
+	defer req.Unlock()
+
 	ref, err := objectreferencesv1.FindObjectReference(req.Instance.Status.RelatedObjects, *objectRef)
 	if err != nil {
-		return res.Error(err)
+		return err
 	}
 	if ref != nil {
 		if err = objectreferencesv1.RemoveObjectReference(&req.Instance.Status.RelatedObjects, *objectRef); err != nil {
-			return res.Error(err)
+			return err
 		}
 
 		req.StatusDirty = true
 	}
 
-	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
-}
-
-func (ch *ConditionalHandler) GetFullCr(hc *hcov1.HyperConverged) (client.Object, error) {
-	return ch.operand.GetFullCr(hc)
+	return nil
 }
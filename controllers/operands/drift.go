@@ -0,0 +1,174 @@
+package operands
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FieldDrift is a single field of an operand CR that was modified, and then overwritten by HCO
+type FieldDrift struct {
+	// Path is the JSON pointer of the modified field
+	Path string `json:"path"`
+	// Found is the modified value; empty if the field was removed
+	Found any `json:"found,omitempty"`
+	// Required is the value that HCO restored; empty if HCO removed the field
+	Required any `json:"required,omitempty"`
+}
+
+// DriftRecord describes a modification of an operand CR, that was overwritten by HCO
+type DriftRecord struct {
+	// Time is when HCO overwrote the modification
+	Time metav1.Time `json:"time"`
+	// ModifiedBy is the field manager that modified the operand CR, as found in its managedFields
+	ModifiedBy string `json:"modifiedBy,omitempty"`
+	// ModifiedAt is when the operand CR was modified, as found in its managedFields
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+	// Changes is the list of the overwritten fields
+	Changes []FieldDrift `json:"changes"`
+}
+
+// Paths returns the JSON pointers of the overwritten fields
+func (d *DriftRecord) Paths() []string {
+	paths := make([]string, len(d.Changes))
+	for i, change := range d.Changes {
+		paths[i] = change.Path
+	}
+	return paths
+}
+
+// GetDrift compares the modified object, as it was before HCO overwrote it, with the overwritten object. It returns
+// nil if there is no difference.
+func GetDrift(modified, overwritten client.Object) (*DriftRecord, error) {
+	changes, err := GetObjectDiff(modified, overwritten)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+
+	modifiedBytes, err := json.Marshal(modified)
+	if err != nil {
+		return nil, err
+	}
+
+	// decode numbers as json.Number, as in the required values
+	dec := json.NewDecoder(bytes.NewReader(modifiedBytes))
+	dec.UseNumber()
+
+	var modifiedDoc any
+	if err = dec.Decode(&modifiedDoc); err != nil {
+		return nil, err
+	}
+
+	drift := &DriftRecord{
+		Time:    metav1.Now(),
+		Changes: make([]FieldDrift, len(changes)),
+	}
+
+	for i, change := range changes {
+		found, _ := getJSONPointerValue(modifiedDoc, change.Path)
+		drift.Changes[i] = FieldDrift{
+			Path:     change.Path,
+			Found:    found,
+			Required: change.Value,
+		}
+	}
+
+	drift.ModifiedBy, drift.ModifiedAt = getLastModifier(modified.GetManagedFields(), drift.Paths())
+
+	return drift, nil
+}
+
+func getJSONPointerValue(doc any, path string) (any, bool) {
+	current := doc
+	for _, token := range splitJSONPointer(path) {
+		switch node := current.(type) {
+		case map[string]any:
+			value, ok := node[token]
+			if !ok {
+				return nil, false
+			}
+			current = value
+
+		case []any:
+			idx, err := strconv.Atoi(token)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, false
+			}
+			current = node[idx]
+
+		default:
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+func splitJSONPointer(path string) []string {
+	if path == "" || path == "/" {
+		return nil
+	}
+
+	tokens := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens
+}
+
+// getLastModifier finds the most recent managedFields entry that owns any of the given paths
+func getLastModifier(managedFields []metav1.ManagedFieldsEntry, paths []string) (string, *metav1.Time) {
+	var (
+		manager string
+		time    *metav1.Time
+	)
+
+	for _, entry := range managedFields {
+		if entry.FieldsV1 == nil || (time != nil && (entry.Time == nil || !time.Before(entry.Time))) {
+			continue
+		}
+
+		var fields map[string]any
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+
+		for _, path := range paths {
+			if ownsPath(fields, splitJSONPointer(path)) {
+				manager = entry.Manager
+				time = entry.Time
+				break
+			}
+		}
+	}
+
+	return manager, time
+}
+
+// ownsPath checks if a FieldsV1 set contains the path, or one of its parents or children. List items are identified by
+// their keys in FieldsV1, rather than by their index, so the list itself is considered as the owned field.
+func ownsPath(fields map[string]any, tokens []string) bool {
+	current := fields
+	for _, token := range tokens {
+		if _, err := strconv.Atoi(token); err == nil || token == "-" {
+			return true
+		}
+
+		next, ok := current["f:"+token]
+		if !ok {
+			return false
+		}
+
+		current, ok = next.(map[string]any)
+		if !ok || len(current) == 0 {
+			return true
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,134 @@
+package operands
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("Drift", func() {
+	var (
+		hco                *hcov1.HyperConverged
+		req                *common.HcoRequest
+		expectedDeployment *appsv1.Deployment
+		modifiedDeployment *appsv1.Deployment
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+
+		expectedDeployment = NewExpectedDeployment(hco)
+		modifiedDeployment = expectedDeployment.DeepCopy()
+		modifiedDeployment.Labels["key1"] = "wrongValue1"
+		modifiedDeployment.Labels["key2"] = "value2"
+	})
+
+	Context("GenericOperand", func() {
+		It("should report the drift of an overwritten object", func() {
+			req.HCOTriggered = false
+
+			cl := commontestutils.InitClient([]client.Object{modifiedDeployment})
+			handler := NewDeploymentHandler(cl, commontestutils.GetScheme(), NewExpectedDeployment)
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Overwritten).To(BeTrue())
+			Expect(res.Drift).ToNot(BeNil())
+			Expect(res.Drift.Time.IsZero()).To(BeFalse())
+			// user labels are not overwritten
+			Expect(res.Drift.Changes).To(Equal([]FieldDrift{
+				{Path: "/metadata/labels/key1", Found: "wrongValue1", Required: "value1"},
+			}))
+			Expect(res.Drift.Paths()).To(Equal([]string{"/metadata/labels/key1"}))
+		})
+
+		It("should not report a drift if the update was triggered by HCO", func() {
+			cl := commontestutils.InitClient([]client.Object{modifiedDeployment})
+			handler := NewDeploymentHandler(cl, commontestutils.GetScheme(), NewExpectedDeployment)
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeFalse())
+			Expect(res.Drift).To(BeNil())
+		})
+	})
+
+	Context("GetDrift", func() {
+		It("should return nil if there is no difference", func() {
+			drift, err := GetDrift(expectedDeployment, expectedDeployment.DeepCopy())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(drift).To(BeNil())
+		})
+
+		It("should find the last modifier of the overwritten fields in managedFields", func() {
+			hcoTime := metav1.NewTime(time.Now().Add(-3 * time.Hour).Truncate(time.Second))
+			userTime := metav1.NewTime(time.Now().Add(-2 * time.Hour).Truncate(time.Second))
+			otherTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+
+			modifiedDeployment.ManagedFields = []metav1.ManagedFieldsEntry{
+				{
+					Manager:   "hyperconverged-cluster-operator",
+					Operation: metav1.ManagedFieldsOperationUpdate,
+					Time:      &hcoTime,
+					FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{".":{},"f:key1":{}}},"f:spec":{"f:selector":{}}}`)},
+				},
+				{
+					Manager:   "kubectl-edit",
+					Operation: metav1.ManagedFieldsOperationUpdate,
+					Time:      &userTime,
+					FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:key2":{}}}}`)},
+				},
+				{
+					Manager:   "other-manager",
+					Operation: metav1.ManagedFieldsOperationUpdate,
+					Time:      &otherTime,
+					FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:paused":{}}}`)},
+				},
+			}
+
+			drift, err := GetDrift(modifiedDeployment, expectedDeployment)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(drift).ToNot(BeNil())
+			Expect(drift.ModifiedBy).To(Equal("kubectl-edit"))
+			Expect(drift.ModifiedAt).To(HaveValue(Equal(userTime)))
+		})
+
+		It("should leave the modifier empty if managedFields are missing", func() {
+			drift, err := GetDrift(modifiedDeployment, expectedDeployment)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(drift).ToNot(BeNil())
+			Expect(drift.ModifiedBy).To(BeEmpty())
+			Expect(drift.ModifiedAt).To(BeNil())
+		})
+	})
+
+	DescribeTable("ownsPath", func(fields string, path string, expected bool) {
+		managedFields := []metav1.ManagedFieldsEntry{
+			{Manager: "manager", FieldsV1: &metav1.FieldsV1{Raw: []byte(fields)}},
+		}
+
+		manager, _ := getLastModifier(managedFields, []string{path})
+		if expected {
+			Expect(manager).To(Equal("manager"))
+		} else {
+			Expect(manager).To(BeEmpty())
+		}
+	},
+		Entry("exact field", `{"f:spec":{"f:a":{"f:b":{}}}}`, "/spec/a/b", true),
+		Entry("parent field", `{"f:spec":{"f:a":{}}}`, "/spec/a/b", true),
+		Entry("child field", `{"f:spec":{"f:a":{"f:b":{}}}}`, "/spec/a", true),
+		Entry("list item", `{"f:spec":{"f:list":{"k:{\"name\":\"x\"}":{}}}}`, "/spec/list/0/name", true),
+		Entry("escaped field", `{"f:metadata":{"f:labels":{"f:app.kubernetes.io/name":{}}}}`, "/metadata/labels/app.kubernetes.io~1name", true),
+		Entry("other field", `{"f:spec":{"f:a":{"f:c":{}}}}`, "/spec/a/b", false),
+	)
+})
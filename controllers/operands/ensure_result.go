@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 )
 
 type EnsureResult struct {
@@ -16,12 +18,23 @@ type EnsureResult struct {
 	Err         error
 	Type        string
 	Name        string
+	// Resumed is set when the operand was paused in the previous reconciliation, and is not paused anymore
+	Resumed bool
+	// Drift describes the modifications of the operand CR that were overwritten by HCO. It is only set for overwritten
+	// or resumed operands.
+	Drift *DriftRecord
+	// Component is the reconciliation state of the operand CR. It is only set for operands (see HCOOperandHooks).
+	Component *hcov1.ComponentStatus
 }
 
 func NewEnsureResult(resource runtime.Object) *EnsureResult {
+	return &EnsureResult{Type: getTypeName(resource)}
+}
+
+func getTypeName(resource runtime.Object) string {
 	t := fmt.Sprintf("%T", resource)
 	p := strings.LastIndex(t, ".")
-	return &EnsureResult{Type: t[p+1:]}
+	return t[p+1:]
 }
 
 func (r *EnsureResult) Error(err error) *EnsureResult {
@@ -58,3 +71,18 @@ func (r *EnsureResult) SetDeleted() *EnsureResult {
 	r.Deleted = true
 	return r
 }
+
+func (r *EnsureResult) SetComponent(component *hcov1.ComponentStatus) *EnsureResult {
+	r.Component = component
+	return r
+}
+
+func (r *EnsureResult) SetResumed() *EnsureResult {
+	r.Resumed = true
+	return r
+}
+
+func (r *EnsureResult) SetDrift(drift *DriftRecord) *EnsureResult {
+	r.Drift = drift
+	return r
+}
@@ -2,6 +2,7 @@ package operands
 
 import (
 	"fmt"
+	"slices"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,6 +42,85 @@ func NewGenericOperand(client client.Client, scheme *runtime.Scheme, crType stri
 }
 
 func (h *GenericOperand) Ensure(req *common.HcoRequest) *EnsureResult {
+	opr, isHCOOperand := h.hooks.(HCOOperandHooks)
+	if !isHCOOperand {
+		return h.ensure(req)
+	}
+
+	paused := h.isPaused(req.Instance)
+
+	var res *EnsureResult
+	if paused {
+		res = h.ensurePaused(req, opr)
+	} else {
+		res = h.ensure(req)
+	}
+
+	if res.Err != nil {
+		h.setComponentError(res)
+	}
+
+	if res.Component != nil {
+		res.Component.Paused = paused
+	}
+
+	return res
+}
+
+func (h *GenericOperand) kind() string {
+	return getTypeName(h.hooks.GetEmptyCr())
+}
+
+// isPaused checks if the operand is listed in spec.deployment.pausedOperands
+func (h *GenericOperand) isPaused(hc *hcov1.HyperConverged) bool {
+	return slices.Contains(hc.Spec.Deployment.PausedOperands, hcov1.OperandKind(h.kind()))
+}
+
+// isResumed checks if the operand was paused in the previous reconciliation, but is not paused anymore
+func (h *GenericOperand) isResumed(hc *hcov1.HyperConverged) bool {
+	if h.isPaused(hc) {
+		return false
+	}
+
+	kind := h.kind()
+	return slices.ContainsFunc(hc.Status.Components, func(component hcov1.ComponentStatus) bool {
+		return component.Kind == kind && component.Paused
+	})
+}
+
+// ensurePaused does not modify the operand CR, but only reads it, to keep reporting its conditions. If the operand CR
+// does not exist, it is created as usual, as there are no manual modifications to preserve.
+func (h *GenericOperand) ensurePaused(req *common.HcoRequest, opr HCOOperandHooks) *EnsureResult {
+	cr, err := h.hooks.GetFullCr(req.Instance)
+	if err != nil {
+		return &EnsureResult{
+			Err: err,
+		}
+	}
+
+	res := NewEnsureResult(cr)
+
+	key := client.ObjectKeyFromObject(cr)
+	res.SetName(key.Name)
+	found := h.hooks.GetEmptyCr()
+	err = h.Get(req.Ctx, key, found)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return h.ensure(req)
+		}
+		return res.Error(err)
+	}
+
+	req.Logger.Info(h.crType+" reconciliation is paused", h.crType+".Namespace", key.Namespace, h.crType+".Name", key.Name)
+
+	if err = h.addCrToTheRelatedObjectList(req, found); err != nil {
+		return res.Error(err)
+	}
+
+	return h.completeEnsureOperands(req, opr, found, res)
+}
+
+func (h *GenericOperand) ensure(req *common.HcoRequest) *EnsureResult {
 	cr, err := h.hooks.GetFullCr(req.Instance)
 	if err != nil {
 		return &EnsureResult{
@@ -69,10 +149,15 @@ func (h *GenericOperand) Ensure(req *common.HcoRequest) *EnsureResult {
 				return h.handleExistingCrSkipCache(req, key, found, cr, res)
 			}
 
-			if _, isHCOOperand := h.hooks.(HCOOperandHooks); !isHCOOperand {
+			opr, isHCOOperand := h.hooks.(HCOOperandHooks)
+			if !isHCOOperand {
 				return res.SetUpgradeDone(true)
 			}
 
+			if res.Err == nil {
+				res.SetComponent(newComponentStatus(opr, cr, res.Type))
+			}
+
 		} else {
 			return res.Error(err)
 		}
@@ -86,6 +171,17 @@ func (h *GenericOperand) Ensure(req *common.HcoRequest) *EnsureResult {
 func (h *GenericOperand) handleExistingCr(req *common.HcoRequest, key client.ObjectKey, found client.Object, cr client.Object, res *EnsureResult) *EnsureResult {
 	req.Logger.Info(h.crType+" already exists", h.crType+".Namespace", key.Namespace, h.crType+".Name", key.Name)
 
+	if h.isResumed(req.Instance) {
+		res.SetResumed()
+	}
+
+	// keep the modified object, to report the modifications that are about to be overwritten. Modifications are only
+	// overwritten if the reconciliation was not triggered by HCO, or if the operand was just resumed.
+	var modified client.Object
+	if !req.HCOTriggered || res.Resumed {
+		modified, _ = found.DeepCopyObject().(client.Object)
+	}
+
 	updated, overwritten, err := h.hooks.UpdateCR(req, h.Client, found, cr)
 	if err != nil {
 		return res.Error(err)
@@ -105,6 +201,16 @@ func (h *GenericOperand) handleExistingCr(req *common.HcoRequest, key client.Obj
 
 	if updated {
 		req.StatusDirty = true
+		if modified != nil && (overwritten || res.Resumed) {
+			drift, err := GetDrift(modified, found)
+			if err != nil {
+				return res.Error(err)
+			}
+			res.SetDrift(drift)
+		}
+		if opr, ok := h.hooks.(HCOOperandHooks); ok {
+			res.SetComponent(newComponentStatus(opr, found, res.Type))
+		}
 		return res.SetUpdated().SetOverwritten(overwritten)
 	}
 
@@ -159,10 +265,36 @@ func (h *GenericOperand) completeEnsureOperands(req *common.HcoRequest, opr HCOO
 	}
 
 	upgradeDone := req.UpgradeMode && isReady && versionUpdated
-	return res.SetUpgradeDone(upgradeDone)
+	return res.SetUpgradeDone(upgradeDone).SetComponent(newComponentStatus(opr, found, res.Type))
+}
+
+// setComponentError reports the reconciliation error in the component status of the operand
+func (h *GenericOperand) setComponentError(res *EnsureResult) {
+	component := res.Component
+	if component == nil {
+		component = &hcov1.ComponentStatus{
+			Name: res.Name,
+			Kind: getTypeName(h.hooks.GetEmptyCr()),
+		}
+	}
+
+	component.LastReconcileError = res.Err.Error()
+	res.SetComponent(component)
+}
+
+func newComponentStatus(opr HCOOperandHooks, found client.Object, kind string) *hcov1.ComponentStatus {
+	return &hcov1.ComponentStatus{
+		Name:                  found.GetName(),
+		Kind:                  kind,
+		ObservedVersion:       opr.GetObservedVersion(found),
+		LastAppliedGeneration: found.GetGeneration(),
+		Conditions:            opr.GetConditions(found),
+	}
 }
 
 func (h *GenericOperand) addCrToTheRelatedObjectList(req *common.HcoRequest, found client.Object) error {
+	req.Lock()
+	defer req.Unlock()
 
 	changed, err := hcoutil.AddCrToTheRelatedObjectList(&req.Instance.Status.RelatedObjects, found, h.Scheme)
 	if err != nil {
@@ -257,21 +389,21 @@ func getConditionsForNewCr(req *common.HcoRequest, component string) {
 	reason := fmt.Sprintf("%sConditions", component)
 	message := fmt.Sprintf("%s resource has no conditions", component)
 	req.Logger.Info(fmt.Sprintf("%s's resource is not reporting Conditions on it's Status", component))
-	req.Conditions.SetStatusCondition(metav1.Condition{
+	req.SetStatusCondition(metav1.Condition{
 		Type:               hcov1.ConditionAvailable,
 		Status:             metav1.ConditionFalse,
 		Reason:             reason,
 		Message:            message,
 		ObservedGeneration: req.Instance.Generation,
 	})
-	req.Conditions.SetStatusCondition(metav1.Condition{
+	req.SetStatusCondition(metav1.Condition{
 		Type:               hcov1.ConditionProgressing,
 		Status:             metav1.ConditionTrue,
 		Reason:             reason,
 		Message:            message,
 		ObservedGeneration: req.Instance.Generation,
 	})
-	req.Conditions.SetStatusCondition(metav1.Condition{
+	req.SetStatusCondition(metav1.Condition{
 		Type:               hcov1.ConditionUpgradeable,
 		Status:             metav1.ConditionFalse,
 		Reason:             reason,
@@ -291,7 +423,7 @@ func handleOperandAvailableCond(req *common.HcoRequest, component string, condit
 
 func componentNotAvailable(req *common.HcoRequest, component string, msg string) {
 	req.Logger.Info(fmt.Sprintf("%s is not 'Available'", component))
-	req.Conditions.SetStatusCondition(metav1.Condition{
+	req.SetStatusCondition(metav1.Condition{
 		Type:               hcov1.ConditionAvailable,
 		Status:             metav1.ConditionFalse,
 		Reason:             fmt.Sprintf("%sNotAvailable", component),
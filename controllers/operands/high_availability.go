@@ -0,0 +1,177 @@
+package operands
+
+import (
+	"errors"
+	"maps"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const pdbNameSuffix = "-pdb"
+
+// SetTopologySpreadConstraints sets the topology spread constraints of the Deployment pods, according to
+// spec.deployment.highAvailability and to the cluster nodes. The constraints are soft (ScheduleAnyway), so they never
+// prevent the pods from being scheduled.
+func SetTopologySpreadConstraints(hc *hcov1.HyperConverged, dep *appsv1.Deployment) {
+	zoneSpread := hcov1.TopologySpreadPolicyAuto
+	hostnameSpread := hcov1.TopologySpreadPolicyAuto
+	if ha := hc.Spec.Deployment.HighAvailability; ha != nil {
+		if ha.ZoneSpread != "" {
+			zoneSpread = ha.ZoneSpread
+		}
+		if ha.HostnameSpread != "" {
+			hostnameSpread = ha.HostnameSpread
+		}
+	}
+
+	var constraints []corev1.TopologySpreadConstraint
+	if shouldSpread(zoneSpread, nodeinfo.IsInfrastructureMultiZone) {
+		constraints = append(constraints, newTopologySpreadConstraint(corev1.LabelTopologyZone, dep.Spec.Selector))
+	}
+
+	if shouldSpread(hostnameSpread, nodeinfo.IsInfrastructureHighlyAvailable) {
+		constraints = append(constraints, newTopologySpreadConstraint(corev1.LabelHostname, dep.Spec.Selector))
+	}
+
+	dep.Spec.Template.Spec.TopologySpreadConstraints = constraints
+}
+
+func shouldSpread(policy hcov1.TopologySpreadPolicy, auto func() bool) bool {
+	switch policy {
+	case hcov1.TopologySpreadPolicyEnabled:
+		return true
+	case hcov1.TopologySpreadPolicyDisabled:
+		return false
+	default:
+		return auto()
+	}
+}
+
+func newTopologySpreadConstraint(topologyKey string, selector *metav1.LabelSelector) corev1.TopologySpreadConstraint {
+	return corev1.TopologySpreadConstraint{
+		MaxSkew:           1,
+		TopologyKey:       topologyKey,
+		WhenUnsatisfiable: corev1.ScheduleAnyway,
+		LabelSelector:     selector.DeepCopy(),
+	}
+}
+
+// NewDeploymentPDBHandler returns a handler for the PodDisruptionBudget of a Deployment that HCO deploys directly.
+// The PodDisruptionBudget is only deployed if shouldDeployDeployment returns true (or is nil), if the
+// PodDisruptionBudgets are enabled in spec.deployment.highAvailability, and if the Deployment has more than one
+// replica; otherwise, it is removed.
+func NewDeploymentPDBHandler(cli client.Client, scheme *runtime.Scheme, deploymentGenerator newDeploymentFunc, shouldDeployDeployment ConditionFunc) *ConditionalHandler {
+	return NewConditionalHandler(
+		NewGenericOperand(cli, scheme, "PodDisruptionBudget", &deploymentPDBHooks{deploymentGenerator: deploymentGenerator}, true),
+		func(hc *hcov1.HyperConverged) bool {
+			if shouldDeployDeployment != nil && !shouldDeployDeployment(hc) {
+				return false
+			}
+
+			return shouldDeployPDB(hc, deploymentGenerator(hc))
+		},
+		func(hc *hcov1.HyperConverged) client.Object {
+			return newPDBWithNameOnly(deploymentGenerator(hc))
+		},
+	)
+}
+
+func shouldDeployPDB(hc *hcov1.HyperConverged, dep *appsv1.Deployment) bool {
+	if !IsPDBEnabled(hc) {
+		return false
+	}
+
+	// a PodDisruptionBudget for a single replica would block the node drain
+	return ptr.Deref(dep.Spec.Replicas, 1) > 1
+}
+
+// IsPDBEnabled returns false if the PodDisruptionBudgets are disabled in spec.deployment.highAvailability
+func IsPDBEnabled(hc *hcov1.HyperConverged) bool {
+	ha := hc.Spec.Deployment.HighAvailability
+	return ha == nil || ha.PodDisruptionBudget == nil || ptr.Deref(ha.PodDisruptionBudget.Enable, true)
+}
+
+// GetPDBMinAvailable returns the minAvailable of the PodDisruptionBudgets, from spec.deployment.highAvailability
+func GetPDBMinAvailable(hc *hcov1.HyperConverged) intstr.IntOrString {
+	if ha := hc.Spec.Deployment.HighAvailability; ha != nil && ha.PodDisruptionBudget != nil && ha.PodDisruptionBudget.MinAvailable != nil {
+		return *ha.PodDisruptionBudget.MinAvailable
+	}
+
+	return intstr.FromInt32(1)
+}
+
+func newPDBWithNameOnly(dep *appsv1.Deployment) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: policyv1.SchemeGroupVersion.String(),
+			Kind:       "PodDisruptionBudget",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      dep.Name + pdbNameSuffix,
+			Namespace: dep.Namespace,
+			Labels:    maps.Clone(dep.Labels),
+		},
+	}
+}
+
+type deploymentPDBHooks struct {
+	deploymentGenerator newDeploymentFunc
+}
+
+func (h *deploymentPDBHooks) GetFullCr(hc *hcov1.HyperConverged) (client.Object, error) {
+	dep := h.deploymentGenerator(hc)
+
+	pdb := newPDBWithNameOnly(dep)
+	pdb.Spec = policyv1.PodDisruptionBudgetSpec{
+		MinAvailable: new(GetPDBMinAvailable(hc)),
+		Selector:     dep.Spec.Selector.DeepCopy(),
+	}
+
+	return pdb, nil
+}
+
+func (*deploymentPDBHooks) GetEmptyCr() client.Object {
+	return &policyv1.PodDisruptionBudget{}
+}
+
+func (*deploymentPDBHooks) UpdateCR(req *common.HcoRequest, cli client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
+	pdb, ok1 := required.(*policyv1.PodDisruptionBudget)
+	found, ok2 := exists.(*policyv1.PodDisruptionBudget)
+	if !ok1 || !ok2 {
+		return false, false, errors.New("can't convert to PodDisruptionBudget")
+	}
+
+	if hcoutil.CompareLabels(pdb, found) &&
+		reflect.DeepEqual(pdb.Spec.Selector, found.Spec.Selector) &&
+		reflect.DeepEqual(pdb.Spec.MinAvailable, found.Spec.MinAvailable) &&
+		reflect.DeepEqual(pdb.Spec.MaxUnavailable, found.Spec.MaxUnavailable) {
+		return false, false, nil
+	}
+
+	if req.HCOTriggered {
+		req.Logger.Info("Updating existing PodDisruptionBudget to new opinionated values", "name", pdb.Name)
+	} else {
+		req.Logger.Info("Reconciling an externally updated PodDisruptionBudget to its opinionated values", "name", pdb.Name)
+	}
+
+	hcoutil.MergeLabels(&pdb.ObjectMeta, &found.ObjectMeta)
+	pdb.Spec.DeepCopyInto(&found.Spec)
+	if err := cli.Update(req.Ctx, found); err != nil {
+		return false, false, err
+	}
+
+	return true, !req.HCOTriggered, nil
+}
@@ -0,0 +1,208 @@
+package operands
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("High availability", func() {
+	var (
+		hco                 *hcov1.HyperConverged
+		req                 *common.HcoRequest
+		replicas            int32
+		newHADeploymentFunc newDeploymentFunc
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+		replicas = 2
+
+		newHADeploymentFunc = func(hc *hcov1.HyperConverged) *appsv1.Deployment {
+			return &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-deployment",
+					Namespace: hc.Namespace,
+					Labels:    map[string]string{hcoutil.AppLabel: hcoutil.HyperConvergedName},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: new(replicas),
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{hcoutil.AppLabel: hcoutil.HyperConvergedName},
+					},
+				},
+			}
+		}
+
+		origMultiZone := nodeinfo.IsInfrastructureMultiZone
+		origHighlyAvailable := nodeinfo.IsInfrastructureHighlyAvailable
+		DeferCleanup(func() {
+			nodeinfo.IsInfrastructureMultiZone = origMultiZone
+			nodeinfo.IsInfrastructureHighlyAvailable = origHighlyAvailable
+		})
+	})
+
+	Context("SetTopologySpreadConstraints", func() {
+		DescribeTable("should set the topology spread constraints according to the policies and to the nodes",
+			func(zoneSpread, hostnameSpread hcov1.TopologySpreadPolicy, multiZone, highlyAvailable bool, expectedKeys []string) {
+				nodeinfo.IsInfrastructureMultiZone = func() bool { return multiZone }
+				nodeinfo.IsInfrastructureHighlyAvailable = func() bool { return highlyAvailable }
+
+				hco.Spec.Deployment.HighAvailability = &hcov1.HighAvailabilityConfig{
+					ZoneSpread:     zoneSpread,
+					HostnameSpread: hostnameSpread,
+				}
+
+				dep := newHADeploymentFunc(hco)
+				SetTopologySpreadConstraints(hco, dep)
+
+				constraints := dep.Spec.Template.Spec.TopologySpreadConstraints
+				Expect(constraints).To(HaveLen(len(expectedKeys)))
+				for i, key := range expectedKeys {
+					Expect(constraints[i].TopologyKey).To(Equal(key))
+					Expect(constraints[i].MaxSkew).To(Equal(int32(1)))
+					Expect(constraints[i].WhenUnsatisfiable).To(Equal(corev1.ScheduleAnyway))
+					Expect(constraints[i].LabelSelector).To(Equal(dep.Spec.Selector))
+				}
+			},
+			Entry("auto; multi-zone, highly available", hcov1.TopologySpreadPolicyAuto, hcov1.TopologySpreadPolicyAuto, true, true,
+				[]string{corev1.LabelTopologyZone, corev1.LabelHostname}),
+			Entry("auto; single zone, highly available", hcov1.TopologySpreadPolicyAuto, hcov1.TopologySpreadPolicyAuto, false, true,
+				[]string{corev1.LabelHostname}),
+			Entry("auto; SNO", hcov1.TopologySpreadPolicyAuto, hcov1.TopologySpreadPolicyAuto, false, false,
+				nil),
+			Entry("not set; multi-zone, highly available", hcov1.TopologySpreadPolicy(""), hcov1.TopologySpreadPolicy(""), true, true,
+				[]string{corev1.LabelTopologyZone, corev1.LabelHostname}),
+			Entry("enabled; SNO", hcov1.TopologySpreadPolicyEnabled, hcov1.TopologySpreadPolicyEnabled, false, false,
+				[]string{corev1.LabelTopologyZone, corev1.LabelHostname}),
+			Entry("disabled; multi-zone, highly available", hcov1.TopologySpreadPolicyDisabled, hcov1.TopologySpreadPolicyDisabled, true, true,
+				nil),
+			Entry("zone spread disabled; multi-zone, highly available", hcov1.TopologySpreadPolicyDisabled, hcov1.TopologySpreadPolicyAuto, true, true,
+				[]string{corev1.LabelHostname}),
+		)
+
+		It("should use the default policies if the highAvailability field is not set", func() {
+			nodeinfo.IsInfrastructureMultiZone = func() bool { return false }
+			nodeinfo.IsInfrastructureHighlyAvailable = func() bool { return true }
+
+			hco.Spec.Deployment.HighAvailability = nil
+
+			dep := newHADeploymentFunc(hco)
+			SetTopologySpreadConstraints(hco, dep)
+
+			Expect(dep.Spec.Template.Spec.TopologySpreadConstraints).To(HaveLen(1))
+			Expect(dep.Spec.Template.Spec.TopologySpreadConstraints[0].TopologyKey).To(Equal(corev1.LabelHostname))
+		})
+	})
+
+	Context("Deployment PodDisruptionBudget handler", func() {
+		getPDB := func(cl client.Client) (*policyv1.PodDisruptionBudget, error) {
+			pdb := &policyv1.PodDisruptionBudget{}
+			err := cl.Get(context.Background(), client.ObjectKey{Name: "test-deployment-pdb", Namespace: hco.Namespace}, pdb)
+			return pdb, err
+		}
+
+		It("should create the PodDisruptionBudget with the default minAvailable", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+
+			handler := NewDeploymentPDBHandler(cl, commontestutils.GetScheme(), newHADeploymentFunc, nil)
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			pdb, err := getPDB(cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pdb.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hcoutil.HyperConvergedName))
+			Expect(pdb.Spec.Selector.MatchLabels).To(HaveKeyWithValue(hcoutil.AppLabel, hcoutil.HyperConvergedName))
+			Expect(pdb.Spec.MinAvailable).To(HaveValue(Equal(intstr.FromInt32(1))))
+		})
+
+		It("should use the minAvailable from the HyperConverged CR", func() {
+			hco.Spec.Deployment.HighAvailability = &hcov1.HighAvailabilityConfig{
+				PodDisruptionBudget: &hcov1.PodDisruptionBudgetConfig{
+					MinAvailable: new(intstr.FromString("50%")),
+				},
+			}
+			cl := commontestutils.InitClient([]client.Object{hco})
+
+			handler := NewDeploymentPDBHandler(cl, commontestutils.GetScheme(), newHADeploymentFunc, nil)
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			pdb, err := getPDB(cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pdb.Spec.MinAvailable).To(HaveValue(Equal(intstr.FromString("50%"))))
+		})
+
+		It("should reconcile a modified PodDisruptionBudget", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+
+			handler := NewDeploymentPDBHandler(cl, commontestutils.GetScheme(), newHADeploymentFunc, nil)
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			pdb, err := getPDB(cl)
+			Expect(err).ToNot(HaveOccurred())
+			pdb.Spec.MinAvailable = new(intstr.FromInt32(2))
+			Expect(cl.Update(context.Background(), pdb)).To(Succeed())
+
+			res = handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			pdb, err = getPDB(cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pdb.Spec.MinAvailable).To(HaveValue(Equal(intstr.FromInt32(1))))
+		})
+
+		DescribeTable("should remove the PodDisruptionBudget",
+			func(setup func()) {
+				cl := commontestutils.InitClient([]client.Object{hco})
+
+				handler := NewDeploymentPDBHandler(cl, commontestutils.GetScheme(), newHADeploymentFunc, func(*hcov1.HyperConverged) bool {
+					return hco.Annotations["deploy"] != "false"
+				})
+				res := handler.Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+				Expect(res.Created).To(BeTrue())
+
+				setup()
+
+				res = handler.Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+				Expect(res.Deleted).To(BeTrue())
+
+				_, err := getPDB(cl)
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			},
+			Entry("if the PodDisruptionBudgets are disabled", func() {
+				hco.Spec.Deployment.HighAvailability = &hcov1.HighAvailabilityConfig{
+					PodDisruptionBudget: &hcov1.PodDisruptionBudgetConfig{
+						Enable: new(false),
+					},
+				}
+			}),
+			Entry("if the Deployment has a single replica", func() {
+				replicas = 1
+			}),
+			Entry("if the Deployment is not deployed", func() {
+				hco.Annotations = map[string]string{"deploy": "false"}
+			}),
+		)
+	})
+})
@@ -1,9 +1,14 @@
 package operands
 
 import (
+	"maps"
+	"slices"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
 
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -34,3 +39,75 @@ func GetPodAntiAffinity(componentLabel string, infrastructureHighlyAvailable boo
 
 	return nil
 }
+
+// GetComponentNodePlacement returns the node placement override of the component, or nil if the component node
+// placement is not overridden.
+func GetComponentNodePlacement(hc *hcov1.HyperConverged, component hcov1.NodePlacementComponent) *sdkapi.NodePlacement {
+	np := hc.Spec.Deployment.NodePlacements
+	if np == nil {
+		return nil
+	}
+
+	idx := slices.IndexFunc(np.Components, func(c hcov1.ComponentNodePlacement) bool {
+		return c.Component == component
+	})
+	if idx < 0 {
+		return nil
+	}
+
+	return &np.Components[idx].NodePlacement
+}
+
+// GetNodePlacements returns the infra and the workload node placements of a component, with the infra and the workload
+// component overrides merged on top of the spec.deployment.nodePlacements infra and workload node placements.
+func GetNodePlacements(hc *hcov1.HyperConverged, infraComponent, workloadComponent hcov1.NodePlacementComponent) (*sdkapi.NodePlacement, *sdkapi.NodePlacement) {
+	var infra, workload *sdkapi.NodePlacement
+	if np := hc.Spec.Deployment.NodePlacements; np != nil {
+		infra = np.Infra
+		workload = np.Workload
+	}
+
+	return MergeNodePlacement(infra, GetComponentNodePlacement(hc, infraComponent)),
+		MergeNodePlacement(workload, GetComponentNodePlacement(hc, workloadComponent))
+}
+
+// GetInfraNodePlacement returns the infra node placement of a component that has no workload entities, with the
+// component override merged on top of the spec.deployment.nodePlacements infra node placement.
+func GetInfraNodePlacement(hc *hcov1.HyperConverged, component hcov1.NodePlacementComponent) *sdkapi.NodePlacement {
+	var infra *sdkapi.NodePlacement
+	if np := hc.Spec.Deployment.NodePlacements; np != nil {
+		infra = np.Infra
+	}
+
+	return MergeNodePlacement(infra, GetComponentNodePlacement(hc, component))
+}
+
+// MergeNodePlacement returns a copy of the base node placement, where each field that is set in the override replaces
+// the same field in the base node placement.
+func MergeNodePlacement(base, override *sdkapi.NodePlacement) *sdkapi.NodePlacement {
+	if override == nil {
+		return base.DeepCopy()
+	}
+
+	merged := base.DeepCopy()
+	if merged == nil {
+		merged = &sdkapi.NodePlacement{}
+	}
+
+	if override.NodeSelector != nil {
+		merged.NodeSelector = maps.Clone(override.NodeSelector)
+	}
+
+	if override.Affinity != nil {
+		merged.Affinity = override.Affinity.DeepCopy()
+	}
+
+	if override.Tolerations != nil {
+		merged.Tolerations = make([]corev1.Toleration, len(override.Tolerations))
+		for i := range override.Tolerations {
+			override.Tolerations[i].DeepCopyInto(&merged.Tolerations[i])
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,154 @@
+package operands
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("Node placement", func() {
+	var (
+		hco      *hcov1.HyperConverged
+		infra    *sdkapi.NodePlacement
+		workload *sdkapi.NodePlacement
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+
+		infra = &sdkapi.NodePlacement{
+			NodeSelector: map[string]string{"pool": "infra"},
+			Tolerations: []corev1.Toleration{
+				{Key: "infra", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+			},
+		}
+		workload = &sdkapi.NodePlacement{
+			NodeSelector: map[string]string{"pool": "workload"},
+		}
+
+		hco.Spec.Deployment.NodePlacements = &hcov1.NodePlacements{
+			Infra:    infra,
+			Workload: workload,
+		}
+	})
+
+	Context("MergeNodePlacement", func() {
+		It("should return a copy of the base node placement if there is no override", func() {
+			merged := MergeNodePlacement(infra, nil)
+			Expect(merged).To(Equal(infra))
+			Expect(merged).ToNot(BeIdenticalTo(infra))
+		})
+
+		It("should return nil if both the base and the override are nil", func() {
+			Expect(MergeNodePlacement(nil, nil)).To(BeNil())
+		})
+
+		It("should return a copy of the override if there is no base node placement", func() {
+			override := &sdkapi.NodePlacement{NodeSelector: map[string]string{"pool": "other"}}
+			Expect(MergeNodePlacement(nil, override)).To(Equal(override))
+		})
+
+		It("should only replace the fields that are set in the override", func() {
+			affinity := &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{Key: "pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"other"}},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			merged := MergeNodePlacement(infra, &sdkapi.NodePlacement{Affinity: affinity})
+			Expect(merged.NodeSelector).To(Equal(infra.NodeSelector))
+			Expect(merged.Tolerations).To(Equal(infra.Tolerations))
+			Expect(merged.Affinity).To(Equal(affinity))
+
+			merged = MergeNodePlacement(infra, &sdkapi.NodePlacement{NodeSelector: map[string]string{"pool": "other"}})
+			Expect(merged.NodeSelector).To(Equal(map[string]string{"pool": "other"}))
+			Expect(merged.Tolerations).To(Equal(infra.Tolerations))
+			Expect(merged.Affinity).To(BeNil())
+		})
+
+		It("should allow removing the base tolerations with an empty list", func() {
+			merged := MergeNodePlacement(infra, &sdkapi.NodePlacement{Tolerations: []corev1.Toleration{}})
+			Expect(merged.NodeSelector).To(Equal(infra.NodeSelector))
+			Expect(merged.Tolerations).To(BeEmpty())
+		})
+
+		It("should not modify the base node placement", func() {
+			merged := MergeNodePlacement(infra, &sdkapi.NodePlacement{Tolerations: []corev1.Toleration{}})
+			merged.NodeSelector["pool"] = "modified"
+
+			Expect(infra.NodeSelector).To(HaveKeyWithValue("pool", "infra"))
+			Expect(infra.Tolerations).To(HaveLen(1))
+		})
+	})
+
+	Context("GetNodePlacements", func() {
+		It("should return the infra and the workload node placements if there is no override", func() {
+			i, w := GetNodePlacements(hco, hcov1.NodePlacementComponentCDIInfra, hcov1.NodePlacementComponentCDIWorkload)
+			Expect(i).To(Equal(infra))
+			Expect(w).To(Equal(workload))
+		})
+
+		It("should return nil if the node placements are not set", func() {
+			hco.Spec.Deployment.NodePlacements = nil
+			i, w := GetNodePlacements(hco, hcov1.NodePlacementComponentCDIInfra, hcov1.NodePlacementComponentCDIWorkload)
+			Expect(i).To(BeNil())
+			Expect(w).To(BeNil())
+		})
+
+		It("should merge the component override on top of the infra and the workload node placements", func() {
+			hco.Spec.Deployment.NodePlacements.Components = []hcov1.ComponentNodePlacement{
+				{
+					Component:     hcov1.NodePlacementComponentCDIInfra,
+					NodePlacement: sdkapi.NodePlacement{NodeSelector: map[string]string{"pool": "cdi"}},
+				},
+				{
+					Component:     hcov1.NodePlacementComponentKubeVirtWorkload,
+					NodePlacement: sdkapi.NodePlacement{NodeSelector: map[string]string{"pool": "virt"}},
+				},
+			}
+
+			i, w := GetNodePlacements(hco, hcov1.NodePlacementComponentCDIInfra, hcov1.NodePlacementComponentCDIWorkload)
+			Expect(i.NodeSelector).To(Equal(map[string]string{"pool": "cdi"}))
+			Expect(i.Tolerations).To(Equal(infra.Tolerations))
+			Expect(w).To(Equal(workload))
+
+			i, w = GetNodePlacements(hco, hcov1.NodePlacementComponentKubeVirtInfra, hcov1.NodePlacementComponentKubeVirtWorkload)
+			Expect(i).To(Equal(infra))
+			Expect(w.NodeSelector).To(Equal(map[string]string{"pool": "virt"}))
+		})
+	})
+
+	Context("GetInfraNodePlacement", func() {
+		It("should return the infra node placement if there is no override", func() {
+			Expect(GetInfraNodePlacement(hco, hcov1.NodePlacementComponentConsolePlugin)).To(Equal(infra))
+		})
+
+		It("should merge the component override on top of the infra node placement", func() {
+			hco.Spec.Deployment.NodePlacements = &hcov1.NodePlacements{
+				Components: []hcov1.ComponentNodePlacement{
+					{
+						Component:     hcov1.NodePlacementComponentConsolePlugin,
+						NodePlacement: sdkapi.NodePlacement{NodeSelector: map[string]string{"pool": "console"}},
+					},
+				},
+			}
+
+			Expect(GetInfraNodePlacement(hco, hcov1.NodePlacementComponentConsolePlugin)).To(Equal(&sdkapi.NodePlacement{
+				NodeSelector: map[string]string{"pool": "console"},
+			}))
+			Expect(GetInfraNodePlacement(hco, hcov1.NodePlacementComponentSSP)).To(BeNil())
+		})
+	})
+})
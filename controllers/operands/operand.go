@@ -1,6 +1,7 @@
 package operands
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -47,6 +48,8 @@ type HCOOperandHooks interface {
 	GetConditions(runtime.Object) []metav1.Condition
 	// CheckComponentVersion on upgrade mode, check if the CR is already with the expected version
 	CheckComponentVersion(runtime.Object) bool
+	// GetObservedVersion get the version of the component, as reported in the CR status
+	GetObservedVersion(runtime.Object) string
 }
 
 type Reseter interface {
@@ -60,13 +63,19 @@ type ManualDeletionMarker interface {
 	ManualDeletionMark()
 }
 
+// MultiObjectDeleter is implemented by operands that manage a dynamic set of objects, that can't be returned by
+// CRGetter. Their objects are removed on uninstall by calling EnsureDeleted.
+type MultiObjectDeleter interface {
+	EnsureDeleted(ctx context.Context, req *common.HcoRequest) error
+}
+
 type GetHandler func(log.Logger, client.Client, *runtime.Scheme, *hcov1.HyperConverged) (Operand, error)
 type GetHandlers func(log.Logger, client.Client, *runtime.Scheme, *hcov1.HyperConverged, fs.FS) ([]Operand, error)
 
 func handleOperandDegradedCond(req *common.HcoRequest, component string, condition metav1.Condition) bool {
 	if condition.Status == metav1.ConditionTrue {
 		req.Logger.Info(fmt.Sprintf("%s is 'Degraded'", component))
-		req.Conditions.SetStatusCondition(metav1.Condition{
+		req.SetStatusCondition(metav1.Condition{
 			Type:               hcov1.ConditionDegraded,
 			Status:             metav1.ConditionTrue,
 			Reason:             fmt.Sprintf("%sDegraded", component),
@@ -82,14 +91,14 @@ func handleOperandDegradedCond(req *common.HcoRequest, component string, conditi
 func handleOperandProgressingCond(req *common.HcoRequest, component string, condition metav1.Condition) bool {
 	if condition.Status == metav1.ConditionTrue {
 		req.Logger.Info(fmt.Sprintf("%s is 'Progressing'", component))
-		req.Conditions.SetStatusCondition(metav1.Condition{
+		req.SetStatusCondition(metav1.Condition{
 			Type:               hcov1.ConditionProgressing,
 			Status:             metav1.ConditionTrue,
 			Reason:             fmt.Sprintf("%sProgressing", component),
 			Message:            fmt.Sprintf("%s is progressing: %v", component, condition.Message),
 			ObservedGeneration: req.Instance.Generation,
 		})
-		req.Conditions.SetStatusConditionIfUnset(metav1.Condition{
+		req.SetStatusConditionIfUnset(metav1.Condition{
 			Type:               hcov1.ConditionUpgradeable,
 			Status:             metav1.ConditionFalse,
 			Reason:             fmt.Sprintf("%sProgressing", component),
@@ -106,7 +115,7 @@ func handleOperandUpgradeableCond(req *common.HcoRequest, component string, cond
 	if condition.Status == metav1.ConditionFalse {
 		req.Upgradeable = false
 		req.Logger.Info(fmt.Sprintf("%s is 'Progressing'", component))
-		req.Conditions.SetStatusCondition(metav1.Condition{
+		req.SetStatusCondition(metav1.Condition{
 			Type:               hcov1.ConditionUpgradeable,
 			Status:             metav1.ConditionFalse,
 			Reason:             fmt.Sprintf("%sNotUpgradeable", component),
@@ -0,0 +1,164 @@
+package operands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+)
+
+// appliedOverrides records, for each target, the indexes of the spec.overrides entries that modified the operand CR,
+// the last time the CR was built
+var appliedOverrides = struct {
+	sync.RWMutex
+	indexes map[hcov1.OperandOverrideTarget][]int
+}{
+	indexes: make(map[hcov1.OperandOverrideTarget][]int),
+}
+
+// ApplyOverrides applies the spec.overrides entries of the given target to obj, in the list order. obj must be a
+// pointer to the typed operand CR, so the strategic merge patch could use its patch strategies, and so any unknown
+// field, added by a patch, is detected.
+//
+// The indexes of the overrides that modified obj are recorded, and can be read by GetAppliedOverrides.
+func ApplyOverrides(hc *hcov1.HyperConverged, target hcov1.OperandOverrideTarget, obj runtime.Object) error {
+	var applied []int
+	defer func() {
+		appliedOverrides.Lock()
+		defer appliedOverrides.Unlock()
+		appliedOverrides.indexes[target] = applied
+	}()
+
+	for i, override := range hc.Spec.Overrides {
+		if override.Target != target {
+			continue
+		}
+
+		modified, err := applyOverride(override, obj)
+		if err != nil {
+			applied = nil
+			return fmt.Errorf("invalid spec.overrides[%d] for %s: %w", i, target, err)
+		}
+
+		if modified {
+			applied = append(applied, i)
+		}
+	}
+
+	return nil
+}
+
+// GetAppliedOverrides returns the indexes of the spec.overrides entries of the given target, that modified the
+// operand CR the last time it was built
+func GetAppliedOverrides(target hcov1.OperandOverrideTarget) []int {
+	appliedOverrides.RLock()
+	defer appliedOverrides.RUnlock()
+
+	return slices.Clone(appliedOverrides.indexes[target])
+}
+
+// applyOverride applies a single override to obj, and returns true if the override modified obj
+func applyOverride(override hcov1.OperandOverride, obj runtime.Object) (bool, error) {
+	objBytes, err := json.Marshal(obj)
+	if err != nil {
+		return false, err
+	}
+
+	var patchedBytes []byte
+	switch override.Type {
+	case hcov1.OperandOverridePatchTypeJSONPatch, "":
+		patchedBytes, err = applyOverrideJSONPatch(override.JSONPatch, objBytes)
+	case hcov1.OperandOverridePatchTypeStrategicMerge:
+		patchedBytes, err = applyOverrideStrategicMergePatch(override.StrategicMergePatch, objBytes, obj)
+	default:
+		err = fmt.Errorf("unknown patch type %q", override.Type)
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if err = decodeStrict(patchedBytes, obj); err != nil {
+		return false, err
+	}
+
+	modifiedBytes, err := json.Marshal(obj)
+	if err != nil {
+		return false, err
+	}
+
+	return !bytes.Equal(objBytes, modifiedBytes), nil
+}
+
+func applyOverrideJSONPatch(ops []hcov1.JSONPatchOperation, objBytes []byte) ([]byte, error) {
+	if len(ops) == 0 {
+		return nil, errors.New("jsonPatch is empty")
+	}
+
+	for _, op := range ops {
+		if !strings.HasPrefix(op.Path, "/spec/") || (op.From != "" && !strings.HasPrefix(op.From, "/spec/")) {
+			return nil, errors.New("can only modify spec fields")
+		}
+	}
+
+	opsBytes, err := json.Marshal(ops)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := jsonpatch.DecodePatch(opsBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return patch.Apply(objBytes)
+}
+
+func applyOverrideStrategicMergePatch(patch *apiextensionsv1.JSON, objBytes []byte, obj runtime.Object) ([]byte, error) {
+	if patch == nil || len(patch.Raw) == 0 {
+		return nil, errors.New("strategicMergePatch is empty")
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(patch.Raw, &fields); err != nil {
+		return nil, err
+	}
+
+	for field := range fields {
+		if field != "spec" {
+			return nil, errors.New("can only modify spec fields")
+		}
+	}
+
+	return strategicpatch.StrategicMergePatch(objBytes, patch.Raw, obj)
+}
+
+// decodeStrict replaces the content of obj with the patched object, and fails if the patch added unknown fields.
+func decodeStrict(patchedBytes []byte, obj runtime.Object) error {
+	objValue := reflect.ValueOf(obj)
+	if objValue.Kind() != reflect.Pointer || objValue.IsNil() {
+		return fmt.Errorf("can't patch %T; expected a pointer", obj)
+	}
+
+	patched := reflect.New(objValue.Elem().Type())
+
+	dec := json.NewDecoder(bytes.NewReader(patchedBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(patched.Interface()); err != nil {
+		return err
+	}
+
+	objValue.Elem().Set(patched.Elem())
+	return nil
+}
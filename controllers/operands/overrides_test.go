@@ -0,0 +1,216 @@
+package operands
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("ApplyOverrides", func() {
+	var (
+		hco *hcov1.HyperConverged
+		kv  *kubevirtcorev1.KubeVirt
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		kv = &kubevirtcorev1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt-kubevirt-hyperconverged",
+				Namespace: commontestutils.Namespace,
+			},
+			Spec: kubevirtcorev1.KubeVirtSpec{
+				Configuration: kubevirtcorev1.KubeVirtConfiguration{
+					DeveloperConfiguration: &kubevirtcorev1.DeveloperConfiguration{
+						FeatureGates: []string{"fg1"},
+					},
+					MigrationConfiguration: &kubevirtcorev1.MigrationConfiguration{
+						AllowPostCopy:                ptr.To(false),
+						ParallelMigrationsPerCluster: ptr.To[uint32](5),
+					},
+				},
+			},
+		}
+	})
+
+	rawJSON := func(s string) *apiextensionsv1.JSON {
+		return &apiextensionsv1.JSON{Raw: []byte(s)}
+	}
+
+	It("should do nothing if there are no overrides", func() {
+		expected := kv.DeepCopy()
+		Expect(ApplyOverrides(hco, hcov1.OperandOverrideTargetKubeVirt, kv)).To(Succeed())
+		Expect(kv).To(Equal(expected))
+	})
+
+	It("should apply JSON patch overrides, in the list order", func() {
+		hco.Spec.Overrides = []hcov1.OperandOverride{
+			{
+				Target: hcov1.OperandOverrideTargetKubeVirt,
+				Type:   hcov1.OperandOverridePatchTypeJSONPatch,
+				JSONPatch: []hcov1.JSONPatchOperation{
+					{Op: "add", Path: "/spec/configuration/developerConfiguration/featureGates/-", Value: rawJSON(`"fg2"`)},
+					{Op: "remove", Path: "/spec/configuration/migrations/parallelMigrationsPerCluster"},
+				},
+			},
+			{
+				Target: hcov1.OperandOverrideTargetKubeVirt,
+				JSONPatch: []hcov1.JSONPatchOperation{
+					{Op: "add", Path: "/spec/configuration/developerConfiguration/featureGates/-", Value: rawJSON(`"fg3"`)},
+				},
+			},
+		}
+
+		Expect(ApplyOverrides(hco, hcov1.OperandOverrideTargetKubeVirt, kv)).To(Succeed())
+		Expect(kv.Spec.Configuration.DeveloperConfiguration.FeatureGates).To(Equal([]string{"fg1", "fg2", "fg3"}))
+		Expect(kv.Spec.Configuration.MigrationConfiguration.ParallelMigrationsPerCluster).To(BeNil())
+		Expect(kv.Name).To(Equal("kubevirt-kubevirt-hyperconverged"))
+	})
+
+	It("should apply strategic merge overrides", func() {
+		hco.Spec.Overrides = []hcov1.OperandOverride{
+			{
+				Target:              hcov1.OperandOverrideTargetKubeVirt,
+				Type:                hcov1.OperandOverridePatchTypeStrategicMerge,
+				StrategicMergePatch: rawJSON(`{"spec": {"configuration": {"migrations": {"allowPostCopy": true, "parallelMigrationsPerCluster": null}}}}`),
+			},
+		}
+
+		Expect(ApplyOverrides(hco, hcov1.OperandOverrideTargetKubeVirt, kv)).To(Succeed())
+		Expect(kv.Spec.Configuration.MigrationConfiguration.AllowPostCopy).To(HaveValue(BeTrue()))
+		Expect(kv.Spec.Configuration.MigrationConfiguration.ParallelMigrationsPerCluster).To(BeNil())
+		Expect(kv.Spec.Configuration.DeveloperConfiguration.FeatureGates).To(Equal([]string{"fg1"}))
+	})
+
+	It("should only apply the overrides of the requested target", func() {
+		hco.Spec.Overrides = []hcov1.OperandOverride{
+			{
+				Target:              hcov1.OperandOverrideTargetCDI,
+				Type:                hcov1.OperandOverridePatchTypeStrategicMerge,
+				StrategicMergePatch: rawJSON(`{"spec": {"configuration": {"migrations": {"allowPostCopy": true}}}}`),
+			},
+		}
+
+		Expect(ApplyOverrides(hco, hcov1.OperandOverrideTargetKubeVirt, kv)).To(Succeed())
+		Expect(kv.Spec.Configuration.MigrationConfiguration.AllowPostCopy).To(HaveValue(BeFalse()))
+	})
+
+	It("should record the overrides that modified the CR", func() {
+		hco.Spec.Overrides = []hcov1.OperandOverride{
+			{
+				Target:              hcov1.OperandOverrideTargetKubeVirt,
+				Type:                hcov1.OperandOverridePatchTypeStrategicMerge,
+				StrategicMergePatch: rawJSON(`{"spec": {"configuration": {"migrations": {"allowPostCopy": true}}}}`),
+			},
+			{
+				Target:    hcov1.OperandOverrideTargetCDI,
+				JSONPatch: []hcov1.JSONPatchOperation{{Op: "add", Path: "/spec/imagePullPolicy", Value: rawJSON(`"Always"`)}},
+			},
+			{
+				Target:              hcov1.OperandOverrideTargetKubeVirt,
+				Type:                hcov1.OperandOverridePatchTypeStrategicMerge,
+				StrategicMergePatch: rawJSON(`{"spec": {"configuration": {"migrations": {"allowPostCopy": true}}}}`),
+			},
+		}
+
+		Expect(ApplyOverrides(hco, hcov1.OperandOverrideTargetKubeVirt, kv)).To(Succeed())
+		By("ignoring the second KubeVirt override, that does not modify the CR")
+		Expect(GetAppliedOverrides(hcov1.OperandOverrideTargetKubeVirt)).To(Equal([]int{0}))
+
+		By("clearing the record if an override fails")
+		hco.Spec.Overrides = append(hco.Spec.Overrides, hcov1.OperandOverride{Target: hcov1.OperandOverrideTargetKubeVirt})
+		Expect(ApplyOverrides(hco, hcov1.OperandOverrideTargetKubeVirt, kv.DeepCopy())).ToNot(Succeed())
+		Expect(GetAppliedOverrides(hcov1.OperandOverrideTargetKubeVirt)).To(BeEmpty())
+	})
+
+	DescribeTable("should reject invalid overrides", func(override hcov1.OperandOverride, errMsg string) {
+		hco.Spec.Overrides = []hcov1.OperandOverride{
+			{
+				Target: hcov1.OperandOverrideTargetKubeVirt,
+				JSONPatch: []hcov1.JSONPatchOperation{
+					{Op: "add", Path: "/spec/configuration/developerConfiguration/featureGates/-", Value: rawJSON(`"fg2"`)},
+				},
+			},
+			override,
+		}
+
+		expected := kv.DeepCopy()
+		err := ApplyOverrides(hco, hcov1.OperandOverrideTargetKubeVirt, kv)
+		Expect(err).To(MatchError(And(
+			ContainSubstring("invalid spec.overrides[1] for KubeVirt"),
+			ContainSubstring(errMsg),
+		)))
+		Expect(kv.Spec.Configuration.MigrationConfiguration).To(Equal(expected.Spec.Configuration.MigrationConfiguration))
+	},
+		Entry("empty JSON patch",
+			hcov1.OperandOverride{Target: hcov1.OperandOverrideTargetKubeVirt},
+			"jsonPatch is empty",
+		),
+		Entry("JSON patch of a non-spec field",
+			hcov1.OperandOverride{
+				Target:    hcov1.OperandOverrideTargetKubeVirt,
+				JSONPatch: []hcov1.JSONPatchOperation{{Op: "add", Path: "/metadata/labels", Value: rawJSON(`{"a": "b"}`)}},
+			},
+			"can only modify spec fields",
+		),
+		Entry("JSON patch move from a non-spec field",
+			hcov1.OperandOverride{
+				Target:    hcov1.OperandOverrideTargetKubeVirt,
+				JSONPatch: []hcov1.JSONPatchOperation{{Op: "move", From: "/metadata/name", Path: "/spec/productName"}},
+			},
+			"can only modify spec fields",
+		),
+		Entry("JSON patch of a missing path",
+			hcov1.OperandOverride{
+				Target:    hcov1.OperandOverrideTargetKubeVirt,
+				JSONPatch: []hcov1.JSONPatchOperation{{Op: "replace", Path: "/spec/configuration/cpuRequest", Value: rawJSON(`"12m"`)}},
+			},
+			"missing",
+		),
+		Entry("JSON patch that adds an unknown field",
+			hcov1.OperandOverride{
+				Target:    hcov1.OperandOverrideTargetKubeVirt,
+				JSONPatch: []hcov1.JSONPatchOperation{{Op: "add", Path: "/spec/configuration/notAField", Value: rawJSON(`true`)}},
+			},
+			`unknown field "notAField"`,
+		),
+		Entry("JSON patch with a wrong value type",
+			hcov1.OperandOverride{
+				Target:    hcov1.OperandOverrideTargetKubeVirt,
+				JSONPatch: []hcov1.JSONPatchOperation{{Op: "replace", Path: "/spec/configuration/migrations/allowPostCopy", Value: rawJSON(`"yes"`)}},
+			},
+			"cannot unmarshal",
+		),
+		Entry("empty strategic merge patch",
+			hcov1.OperandOverride{Target: hcov1.OperandOverrideTargetKubeVirt, Type: hcov1.OperandOverridePatchTypeStrategicMerge},
+			"strategicMergePatch is empty",
+		),
+		Entry("strategic merge patch of a non-spec field",
+			hcov1.OperandOverride{
+				Target:              hcov1.OperandOverrideTargetKubeVirt,
+				Type:                hcov1.OperandOverridePatchTypeStrategicMerge,
+				StrategicMergePatch: rawJSON(`{"metadata": {"labels": {"a": "b"}}}`),
+			},
+			"can only modify spec fields",
+		),
+		Entry("strategic merge patch that adds an unknown field",
+			hcov1.OperandOverride{
+				Target:              hcov1.OperandOverrideTargetKubeVirt,
+				Type:                hcov1.OperandOverridePatchTypeStrategicMerge,
+				StrategicMergePatch: rawJSON(`{"spec": {"notAField": true}}`),
+			},
+			`unknown field "notAField"`,
+		),
+		Entry("unknown patch type",
+			hcov1.OperandOverride{Target: hcov1.OperandOverrideTargetKubeVirt, Type: "Unknown"},
+			`unknown patch type "Unknown"`,
+		),
+	)
+})
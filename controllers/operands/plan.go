@@ -0,0 +1,192 @@
+package operands
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gomodules.xyz/jsonpatch/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// PlanAction is the action that the reconciliation of an operand would do
+type PlanAction string
+
+const (
+	PlanActionNone     PlanAction = "None"
+	PlanActionCreate   PlanAction = "Create"
+	PlanActionUpdate   PlanAction = "Update"
+	PlanActionRecreate PlanAction = "Recreate"
+	PlanActionDelete   PlanAction = "Delete"
+)
+
+// ignoredDiffPaths are fields that are managed by the API server or by the operand itself, and so are not part of the
+// planned changes
+var ignoredDiffPaths = []string{
+	"/apiVersion",
+	"/kind",
+	"/status",
+	"/metadata/managedFields",
+	"/metadata/resourceVersion",
+	"/metadata/generation",
+	"/metadata/creationTimestamp",
+	"/metadata/uid",
+}
+
+// PlanResult describes what the reconciliation of a single operand would do, without actually doing it
+type PlanResult struct {
+	Type      string                `json:"type"`
+	Name      string                `json:"name"`
+	Namespace string                `json:"namespace,omitempty"`
+	Action    PlanAction            `json:"action"`
+	Changes   []jsonpatch.Operation `json:"changes,omitempty"`
+	Err       string                `json:"error,omitempty"`
+}
+
+func newPlanResult(cr client.Object) *PlanResult {
+	res := NewEnsureResult(cr)
+	return &PlanResult{
+		Type:      res.Type,
+		Name:      cr.GetName(),
+		Namespace: cr.GetNamespace(),
+		Action:    PlanActionNone,
+	}
+}
+
+func (r *PlanResult) Error(err error) *PlanResult {
+	r.Err = err.Error()
+	return r
+}
+
+// Planner is implemented by operands that can report the changes that reconciling them would do
+type Planner interface {
+	Plan(req *common.HcoRequest) *PlanResult
+}
+
+// Plan runs the GetFullCr and the UpdateCR hooks against a copy of the live object, using a dry-run client, and
+// reports the difference between the live object and the object that UpdateCR would write.
+func (h *GenericOperand) Plan(req *common.HcoRequest) *PlanResult {
+	cr, err := h.hooks.GetFullCr(req.Instance)
+	if err != nil {
+		return &PlanResult{Action: PlanActionNone, Err: err.Error()}
+	}
+
+	res := newPlanResult(cr)
+
+	found := h.hooks.GetEmptyCr()
+	err = h.Get(req.Ctx, client.ObjectKeyFromObject(cr), found)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return res.Error(err)
+		}
+
+		res.Action = PlanActionCreate
+		res.Changes, err = GetObjectDiff(h.hooks.GetEmptyCr(), cr)
+		if err != nil {
+			return res.Error(err)
+		}
+		return res
+	}
+
+	planned, ok := found.DeepCopyObject().(client.Object)
+	if !ok {
+		return res.Error(fmt.Errorf("can't copy %T", found))
+	}
+
+	updated, _, err := h.hooks.UpdateCR(req, client.NewDryRunClient(h.Client), planned, cr)
+	if err != nil {
+		return res.Error(err)
+	}
+
+	if !updated {
+		return res
+	}
+
+	res.Action = PlanActionUpdate
+	res.Changes, err = GetObjectDiff(found, planned)
+	if err != nil {
+		return res.Error(err)
+	}
+
+	if len(res.Changes) == 0 {
+		// some hooks delete the existing object and create the required one, instead of updating it
+		res.Action = PlanActionRecreate
+		res.Changes, err = GetObjectDiff(found, cr)
+		if err != nil {
+			return res.Error(err)
+		}
+	}
+
+	return res
+}
+
+// Plan reports the deletion of the CR, if it exists and should not be deployed
+func (ch *ConditionalHandler) Plan(req *common.HcoRequest) *PlanResult {
+	if ch.shouldDeploy(req.Instance) {
+		return ch.operand.Plan(req)
+	}
+
+	cr := ch.getCRWithName(req.Instance)
+	res := newPlanResult(cr)
+
+	err := ch.operand.Get(req.Ctx, client.ObjectKeyFromObject(cr), cr)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return res.Error(err)
+		}
+		return res
+	}
+
+	res.Action = PlanActionDelete
+	return res
+}
+
+// GetObjectDiff returns the field-level changes between two objects, as a list of JSON patch operations, ignoring
+// the fields that are managed by the API server.
+func GetObjectDiff(orig, modified client.Object) ([]jsonpatch.Operation, error) {
+	origBytes, err := json.Marshal(orig)
+	if err != nil {
+		return nil, err
+	}
+
+	modifiedBytes, err := json.Marshal(modified)
+	if err != nil {
+		return nil, err
+	}
+
+	ops, err := jsonpatch.CreatePatch(origBytes, modifiedBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]jsonpatch.Operation, 0, len(ops))
+	for _, op := range ops {
+		if !isIgnoredDiffPath(op.Path) {
+			changes = append(changes, op)
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	slices.SortFunc(changes, func(a, b jsonpatch.Operation) int {
+		return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Operation, b.Operation))
+	})
+
+	return changes, nil
+}
+
+func isIgnoredDiffPath(path string) bool {
+	for _, ignored := range ignoredDiffPaths {
+		if path == ignored || strings.HasPrefix(path, ignored+"/") {
+			return true
+		}
+	}
+	return false
+}
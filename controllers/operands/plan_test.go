@@ -0,0 +1,126 @@
+package operands
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("Plan", func() {
+	var (
+		hco                *hcov1.HyperConverged
+		req                *common.HcoRequest
+		expectedDeployment *appsv1.Deployment
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+
+		expectedDeployment = NewExpectedDeployment(hco)
+	})
+
+	Context("GenericOperand", func() {
+		It("should plan to create a missing object", func() {
+			cl := commontestutils.InitClient([]client.Object{})
+			handler := NewDeploymentHandler(cl, commontestutils.GetScheme(), NewExpectedDeployment)
+
+			res := handler.Plan(req)
+			Expect(res.Err).To(BeEmpty())
+			Expect(res.Type).To(Equal("Deployment"))
+			Expect(res.Name).To(Equal(expectedDeployment.Name))
+			Expect(res.Action).To(Equal(PlanActionCreate))
+			Expect(res.Changes).ToNot(BeEmpty())
+
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(expectedDeployment), &appsv1.Deployment{})).ToNot(Succeed())
+		})
+
+		It("should plan nothing if the object is already in its required state", func() {
+			cl := commontestutils.InitClient([]client.Object{expectedDeployment})
+			handler := NewDeploymentHandler(cl, commontestutils.GetScheme(), NewExpectedDeployment)
+
+			res := handler.Plan(req)
+			Expect(res.Err).To(BeEmpty())
+			Expect(res.Action).To(Equal(PlanActionNone))
+			Expect(res.Changes).To(BeEmpty())
+		})
+
+		It("should plan to update a modified object, without updating it", func() {
+			modifiedDeployment := expectedDeployment.DeepCopy()
+			modifiedDeployment.Labels["key1"] = "wrongValue1"
+
+			cl := commontestutils.InitClient([]client.Object{modifiedDeployment})
+			handler := NewDeploymentHandler(cl, commontestutils.GetScheme(), NewExpectedDeployment)
+
+			res := handler.Plan(req)
+			Expect(res.Err).To(BeEmpty())
+			Expect(res.Action).To(Equal(PlanActionUpdate))
+			Expect(res.Changes).To(HaveLen(1))
+			Expect(res.Changes[0].Operation).To(Equal("replace"))
+			Expect(res.Changes[0].Path).To(Equal("/metadata/labels/key1"))
+			Expect(res.Changes[0].Value).To(Equal("value1"))
+
+			foundResource := &appsv1.Deployment{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(modifiedDeployment), foundResource)).To(Succeed())
+			Expect(foundResource.Labels).To(HaveKeyWithValue("key1", "wrongValue1"))
+		})
+
+		It("should plan to recreate the object, if the operand recreates it, without deleting it", func() {
+			modifiedDeployment := expectedDeployment.DeepCopy()
+			modifiedDeployment.Spec.Selector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{"key2": "value2"},
+			}
+			modifiedDeployment.UID = "oldObjectUID"
+
+			cl := commontestutils.InitClient([]client.Object{modifiedDeployment})
+			handler := NewDeploymentHandler(cl, commontestutils.GetScheme(), NewExpectedDeployment)
+
+			res := handler.Plan(req)
+			Expect(res.Err).To(BeEmpty())
+			Expect(res.Action).To(Equal(PlanActionRecreate))
+			Expect(res.Changes).ToNot(BeEmpty())
+			for _, change := range res.Changes {
+				Expect(change.Path).To(HavePrefix("/spec/selector/matchLabels"))
+			}
+
+			foundResource := &appsv1.Deployment{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(modifiedDeployment), foundResource)).To(Succeed())
+			Expect(foundResource.UID).To(BeEquivalentTo("oldObjectUID"))
+			Expect(foundResource.Spec.Selector.MatchLabels).To(HaveKeyWithValue("key2", "value2"))
+		})
+	})
+
+	Context("GetObjectDiff", func() {
+		It("should ignore fields that are managed by the API server", func() {
+			modifiedDeployment := expectedDeployment.DeepCopy()
+			modifiedDeployment.ResourceVersion = "12345"
+			modifiedDeployment.UID = "newUID"
+			modifiedDeployment.Generation = 5
+			modifiedDeployment.Status.Replicas = 3
+
+			changes, err := GetObjectDiff(expectedDeployment, modifiedDeployment)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(BeNil())
+		})
+
+		It("should return the changes sorted by path", func() {
+			modifiedDeployment := expectedDeployment.DeepCopy()
+			modifiedDeployment.Labels["key2"] = "value2"
+			modifiedDeployment.Annotations = map[string]string{"key3": "value3"}
+
+			changes, err := GetObjectDiff(expectedDeployment, modifiedDeployment)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(HaveLen(2))
+			Expect(changes[0].Path).To(Equal("/metadata/annotations"))
+			Expect(changes[1].Path).To(Equal("/metadata/labels/key2"))
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	persesv1alpha1 "github.com/rhobs/perses-operator/api/v1alpha1"
@@ -19,9 +20,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -32,6 +35,9 @@ const (
 	datasourceReqSufix = "-" + datasourceReqType
 	startupReqType     = "startup"
 	unknownReqType     = "unknown"
+
+	// the ConfigMaps of the additional dashboards are not watched, so they are read again periodically
+	additionalDashboardsResyncPeriod = 10 * time.Minute
 )
 
 var (
@@ -45,6 +51,9 @@ var checkPersesAvailable = hcoutil.IsPersesAvailable
 type PersesReconciler struct {
 	client.Client
 
+	// apiReader reads directly from the apiserver, for the ConfigMaps of the additional dashboards
+	apiReader client.Reader
+
 	namespace string
 	events    chan event.GenericEvent
 	owner     metav1.OwnerReference
@@ -63,14 +72,23 @@ func (r *PersesReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 
 	persesLog.Info("Reconciling Perses", "Request.Namespace", req.Namespace, "Request.Name", reqName)
 
-	var err error
+	cfg, err := r.getDashboardsConfig(ctx)
+	if err != nil {
+		reqLog.Error(err, "failed to read the dashboards configuration")
+		return reconcile.Result{}, err
+	}
+
+	result := reconcile.Result{}
 	switch reqType {
 	case dashboardReqType:
-		err = r.reconcileDashboard(ctx, reqName, req.Namespace, reqLog)
+		err = r.reconcileDashboard(ctx, cfg, reqName, req.Namespace, reqLog)
 	case datasourceReqType:
 		err = r.reconcileDataSource(ctx, reqName, req.Namespace, reqLog)
 	case startupReqType:
-		err = r.reconcileAll(ctx, reqLog)
+		err = r.reconcileAll(ctx, cfg, reqLog)
+		if cfg != nil && len(cfg.AdditionalDashboardConfigMaps) > 0 {
+			result.RequeueAfter = additionalDashboardsResyncPeriod
+		}
 	default:
 		reqLog.Info("unknow request; ignoring.", "Request.Namespace", req.Namespace, "Request.Name", req.Name, reqType, "type")
 		return reconcile.Result{}, nil
@@ -79,7 +97,7 @@ func (r *PersesReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 	if err != nil {
 		reqLog.Error(err, "failed to reconcile Perses", "Request.Namespace", req.Namespace, "Request.Name", req.Name, "requestType", reqType)
 	}
-	return reconcile.Result{}, err
+	return result, err
 }
 
 func SetupPersesWithManager(ctx context.Context, mgr manager.Manager, nonCachedClient client.Client, ownerRef metav1.OwnerReference) error {
@@ -146,18 +164,24 @@ func SetupPersesWithManager(ctx context.Context, mgr manager.Manager, nonCachedC
 
 	if err = c.Watch(
 		source.Channel(r.events, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, _ client.Object) []reconcile.Request {
-			return []reconcile.Request{
-				{
-					NamespacedName: types.NamespacedName{
-						Name: fmt.Sprintf("%s%s", startupReqType, randomSufix),
-					},
-				},
-			}
+			return newStartupRequests()
 		})),
 	); err != nil {
 		return err
 	}
 
+	// reconcile all the dashboards when the dashboards configuration is modified in the HyperConverged CR
+	if err = c.Watch(
+		source.Kind[*hcov1.HyperConverged](mgr.GetCache(), &hcov1.HyperConverged{},
+			handler.TypedEnqueueRequestsFromMapFunc[*hcov1.HyperConverged, reconcile.Request](func(ctx context.Context, _ *hcov1.HyperConverged) []reconcile.Request {
+				return newStartupRequests()
+			}),
+			predicate.TypedGenerationChangedPredicate[*hcov1.HyperConverged]{},
+		),
+	); err != nil {
+		return err
+	}
+
 	r.forceFirstRequest()
 	return nil
 }
@@ -171,6 +195,7 @@ func newPersesReconciler(
 ) *PersesReconciler {
 	return &PersesReconciler{
 		Client:           mgr.GetClient(),
+		apiReader:        mgr.GetAPIReader(),
 		namespace:        namespace,
 		events:           make(chan event.GenericEvent, 1),
 		owner:            ownerRef,
@@ -179,14 +204,21 @@ func newPersesReconciler(
 	}
 }
 
+func (r *PersesReconciler) isOwned(obj client.Object) bool {
+	for _, or := range obj.GetOwnerReferences() {
+		if or.UID == r.owner.UID {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *PersesReconciler) ensureOwnerReference(obj client.Object) bool {
 	// Avoid duplicate owner refs
-	ors := obj.GetOwnerReferences()
-	for i := range ors {
-		if ors[i].UID == r.owner.UID {
-			return false
-		}
+	if r.isOwned(obj) {
+		return false
 	}
+	ors := obj.GetOwnerReferences()
 	// Some client.Object implementations may not preserve APIVersion/Kind on OwnerReference
 	// Ensure r.owner has the necessary fields (assumed to be pre-filled by ownresources.GetDeploymentRef()).
 	ors = append(ors, r.owner)
@@ -228,8 +260,14 @@ func (r *PersesReconciler) syncDatasource(found *persesv1alpha1.PersesDatasource
 	return modified
 }
 
-func (r *PersesReconciler) reconcileDashboard(ctx context.Context, name string, namespace string, logger logr.Logger) error {
-	db, ok := r.cachedDashboards[name]
+func (r *PersesReconciler) reconcileDashboard(ctx context.Context, cfg *hcov1.DashboardsConfig, name string, namespace string, logger logr.Logger) error {
+	// errors of other additional dashboards are reported when reconciling all the dashboards
+	desired, _ := r.getDesiredDashboards(ctx, cfg)
+	return r.reconcileDesiredDashboard(ctx, desired, name, namespace, logger)
+}
+
+func (r *PersesReconciler) reconcileDesiredDashboard(ctx context.Context, desired map[string]persesv1alpha1.PersesDashboard, name string, namespace string, logger logr.Logger) error {
+	db, ok := desired[name]
 	if !ok || db.Namespace != namespace {
 		logger.Info("Not a managed dashboard; ignoring", "namespace", namespace, "name", name)
 		return nil
@@ -273,13 +311,26 @@ func (r *PersesReconciler) reconcileDataSource(ctx context.Context, name string,
 	return nil
 }
 
-func (r *PersesReconciler) reconcileAll(ctx context.Context, logger logr.Logger) error {
+func (r *PersesReconciler) reconcileAll(ctx context.Context, cfg *hcov1.DashboardsConfig, logger logr.Logger) error {
 	var errs []error
-	for name := range r.cachedDashboards {
-		if err := r.reconcileDashboard(ctx, name, r.namespace, logger); err != nil {
+	desired, err := r.getDesiredDashboards(ctx, cfg)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	for name := range desired {
+		if err := r.reconcileDesiredDashboard(ctx, desired, name, r.namespace, logger); err != nil {
 			errs = append(errs, err)
 		}
 	}
+
+	// keep the dashboards of a ConfigMap that can't be read, or that is invalid, until the error is fixed
+	if len(errs) == 0 {
+		if err := r.deleteUndesiredDashboards(ctx, desired); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	if err := r.reconcileDataSource(ctx, datasourceName, r.namespace, logger); err != nil {
 		errs = append(errs, err)
 	}
@@ -291,6 +342,16 @@ func (r *PersesReconciler) forceFirstRequest() {
 	close(r.events)
 }
 
+func newStartupRequests() []reconcile.Request {
+	return []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Name: fmt.Sprintf("%s%s", startupReqType, randomSufix),
+			},
+		},
+	}
+}
+
 func resolveRequest(req reconcile.Request) (reqType, resourceName string) {
 	if !strings.HasSuffix(req.Name, randomSufix) {
 		return unknownReqType, ""
@@ -0,0 +1,207 @@
+package perses
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	persesv1alpha1 "github.com/rhobs/perses-operator/api/v1alpha1"
+	"github.com/rhobs/perses/pkg/model/api/v1/common"
+	persesdashboard "github.com/rhobs/perses/pkg/model/api/v1/dashboard"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// the prefix of the kinds of the Prometheus plugins; e.g. PrometheusTimeSeriesQuery, PrometheusLabelValuesVariable
+	prometheusPluginPrefix = "Prometheus"
+	prometheusDatasource   = "PrometheusDatasource"
+)
+
+// getDashboardsConfig returns the dashboards configuration from the HyperConverged CR, or nil if it is not set, or
+// if the HyperConverged CR does not exist
+func (r *PersesReconciler) getDashboardsConfig(ctx context.Context) (*hcov1.DashboardsConfig, error) {
+	hc := &hcov1.HyperConverged{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: r.namespace, Name: hcoutil.HyperConvergedName}, hc)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if hc.Spec.Observability == nil {
+		return nil, nil
+	}
+
+	return hc.Spec.Observability.Dashboards, nil
+}
+
+// getDesiredDashboards returns the dashboards to deploy: the built-in dashboards that are not disabled, with the
+// configured datasource, and the additional dashboards from the ConfigMaps. The returned error aggregates the
+// ConfigMaps that can't be read or that contain invalid dashboards; the dashboards from these ConfigMaps are missing
+// in the returned map.
+func (r *PersesReconciler) getDesiredDashboards(ctx context.Context, cfg *hcov1.DashboardsConfig) (map[string]persesv1alpha1.PersesDashboard, error) {
+	if cfg == nil {
+		return r.cachedDashboards, nil
+	}
+
+	desired := make(map[string]persesv1alpha1.PersesDashboard, len(r.cachedDashboards))
+	for name, db := range r.cachedDashboards {
+		if slices.Contains(cfg.DisabledDashboards, hcov1.BuiltInDashboard(name)) {
+			continue
+		}
+
+		if cfg.DatasourceName != "" {
+			db = *db.DeepCopy()
+			setDatasource(&db, cfg.DatasourceName)
+		}
+		desired[name] = db
+	}
+
+	var errs []error
+	for _, cmName := range cfg.AdditionalDashboardConfigMaps {
+		dashboards, err := r.getAdditionalDashboards(ctx, cmName)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, db := range dashboards {
+			if _, exists := desired[db.Name]; exists {
+				errs = append(errs, fmt.Errorf("the %s dashboard in the %s ConfigMap already exists", db.Name, cmName))
+				continue
+			}
+			desired[db.Name] = db
+		}
+	}
+
+	return desired, errors.Join(errs...)
+}
+
+// getAdditionalDashboards reads the ConfigMap directly from the API server, because the ConfigMaps of the users are
+// not in the cache of the manager
+func (r *PersesReconciler) getAdditionalDashboards(ctx context.Context, cmName string) ([]persesv1alpha1.PersesDashboard, error) {
+	cm := &corev1.ConfigMap{}
+	if err := r.apiReader.Get(ctx, types.NamespacedName{Namespace: r.namespace, Name: cmName}, cm); err != nil {
+		return nil, fmt.Errorf("failed to read the %s ConfigMap: %w", cmName, err)
+	}
+
+	return parseAdditionalDashboards(cm, r.namespace)
+}
+
+// parseAdditionalDashboards parses and validates the PersesDashboard manifests in the ConfigMap data
+func parseAdditionalDashboards(cm *corev1.ConfigMap, namespace string) ([]persesv1alpha1.PersesDashboard, error) {
+	var dashboards []persesv1alpha1.PersesDashboard
+	for _, key := range slices.Sorted(maps.Keys(cm.Data)) {
+		db, err := parseAdditionalDashboard(cm.Data[key], namespace)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dashboard in the %s key of the %s ConfigMap: %w", key, cm.Name, err)
+		}
+		dashboards = append(dashboards, *db)
+	}
+
+	return dashboards, nil
+}
+
+func parseAdditionalDashboard(manifest string, namespace string) (*persesv1alpha1.PersesDashboard, error) {
+	db := &persesv1alpha1.PersesDashboard{}
+	if err := yaml.NewYAMLToJSONDecoder(bytes.NewBufferString(manifest)).Decode(db); err != nil {
+		return nil, err
+	}
+
+	if gvk := persesv1alpha1.GroupVersion.WithKind("PersesDashboard"); db.GroupVersionKind() != gvk {
+		return nil, fmt.Errorf("expected %s, but got %q", gvk, db.GroupVersionKind())
+	}
+
+	if errs := validation.IsDNS1123Subdomain(db.Name); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid name %q: %s", db.Name, strings.Join(errs, ", "))
+	}
+
+	if db.Namespace != "" && db.Namespace != namespace {
+		return nil, fmt.Errorf("the dashboard must be in the %s namespace", namespace)
+	}
+
+	db.Namespace = namespace
+	if db.Labels == nil {
+		db.Labels = make(map[string]string)
+	}
+	maps.Copy(db.Labels, hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring))
+
+	return db, nil
+}
+
+// setDatasource sets the datasource of the Prometheus queries and variables of the dashboard. The built-in dashboards
+// do not set a datasource, and so they query the default datasource.
+func setDatasource(db *persesv1alpha1.PersesDashboard, datasourceName string) {
+	for _, panel := range db.Spec.Panels {
+		if panel == nil {
+			continue
+		}
+
+		for i := range panel.Spec.Queries {
+			setPluginDatasource(&panel.Spec.Queries[i].Spec.Plugin, datasourceName)
+		}
+	}
+
+	for _, variable := range db.Spec.Variables {
+		if listVariable, ok := variable.Spec.(*persesdashboard.ListVariableSpec); ok {
+			setPluginDatasource(&listVariable.Plugin, datasourceName)
+		}
+	}
+}
+
+func setPluginDatasource(plugin *common.Plugin, datasourceName string) {
+	if !strings.HasPrefix(plugin.Kind, prometheusPluginPrefix) {
+		return
+	}
+
+	spec, ok := plugin.Spec.(map[string]any)
+	if !ok {
+		if plugin.Spec != nil {
+			return
+		}
+		spec = make(map[string]any)
+	}
+
+	spec["datasource"] = map[string]any{
+		"kind": prometheusDatasource,
+		"name": datasourceName,
+	}
+	plugin.Spec = spec
+}
+
+// deleteUndesiredDashboards deletes the dashboards that HCO deployed, and that are no longer desired; e.g. disabled
+// built-in dashboards, and additional dashboards that were removed from the ConfigMaps. The dashboards that are not
+// owned by HCO are never deleted.
+func (r *PersesReconciler) deleteUndesiredDashboards(ctx context.Context, desired map[string]persesv1alpha1.PersesDashboard) error {
+	dashboards := &persesv1alpha1.PersesDashboardList{}
+	if err := r.List(ctx, dashboards, client.InNamespace(r.namespace), client.MatchingLabels{hcoutil.AppLabel: hcoutil.HyperConvergedName}); err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range dashboards.Items {
+		db := &dashboards.Items[i]
+		if _, ok := desired[db.Name]; ok || !r.isOwned(db) {
+			continue
+		}
+
+		if err := r.Delete(ctx, db); err != nil && !k8serrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
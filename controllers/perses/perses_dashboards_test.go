@@ -0,0 +1,265 @@
+package perses
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	persesv1alpha1 "github.com/rhobs/perses-operator/api/v1alpha1"
+	persesdashboard "github.com/rhobs/perses/pkg/model/api/v1/dashboard"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const additionalDashboardYAML = `apiVersion: perses.dev/v1alpha1
+kind: PersesDashboard
+metadata:
+  name: my-dashboard
+  labels:
+    team: virt
+spec:
+  display:
+    name: My Dashboard
+  duration: 1h
+  panels: {}
+  layouts: []
+`
+
+var _ = Describe("Perses dashboards configuration", func() {
+	var (
+		s          *runtime.Scheme
+		cl         client.Client
+		r          *PersesReconciler
+		hco        *hcov1.HyperConverged
+		startupReq reconcile.Request
+	)
+
+	owner := metav1.OwnerReference{UID: types.UID("hco-uid")}
+
+	BeforeEach(func() {
+		s = scheme.Scheme
+		Expect(apiextensionsv1.AddToScheme(s)).To(Succeed())
+		Expect(persesv1alpha1.AddToScheme(s)).To(Succeed())
+		Expect(hcov1.AddToScheme(s)).To(Succeed())
+
+		dashboards, err := initDashboards(commontestutils.Namespace, GinkgoLogr)
+		Expect(err).ToNot(HaveOccurred())
+		datasource, err := initDatasource(commontestutils.Namespace)
+		Expect(err).ToNot(HaveOccurred())
+
+		hco = commontestutils.NewHco()
+		hco.Spec.Observability = &hcov1.ObservabilityConfig{Dashboards: &hcov1.DashboardsConfig{}}
+
+		startupReq = reconcile.Request{NamespacedName: types.NamespacedName{Name: startupReqType + randomSufix}}
+
+		r = &PersesReconciler{
+			namespace:        commontestutils.Namespace,
+			cachedDashboards: dashboards,
+			cachedDatasource: datasource,
+			owner:            owner,
+		}
+	})
+
+	initClient := func(objs ...client.Object) {
+		tracker := testing.NewObjectTracker(s, serializer.NewCodecFactory(s).UniversalDecoder())
+		Expect(tracker.Add(&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "persesdashboards.perses.dev"}})).To(Succeed())
+		Expect(tracker.Add(&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "persesdatasources.perses.dev"}})).To(Succeed())
+
+		cl = fake.NewClientBuilder().WithScheme(s).WithObjectTracker(tracker).WithObjects(objs...).Build()
+		r.Client = cl
+		r.apiReader = cl
+	}
+
+	getDashboard := func(ctx context.Context, name string) (*persesv1alpha1.PersesDashboard, error) {
+		db := &persesv1alpha1.PersesDashboard{}
+		err := cl.Get(ctx, client.ObjectKey{Namespace: commontestutils.Namespace, Name: name}, db)
+		return db, err
+	}
+
+	newConfigMap := func(name string, data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: commontestutils.Namespace},
+			Data:       data,
+		}
+	}
+
+	It("should not deploy the disabled dashboards, and should remove them if they were deployed", func(ctx context.Context) {
+		ctx = logr.NewContext(ctx, GinkgoLogr)
+		initClient(hco)
+
+		_, err := r.Reconcile(ctx, startupReq)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = getDashboard(ctx, "cnv-virtual-machines-inventory")
+		Expect(err).ToNot(HaveOccurred())
+
+		hco.Spec.Observability.Dashboards.DisabledDashboards = []hcov1.BuiltInDashboard{"cnv-virtual-machines-inventory"}
+		Expect(cl.Update(ctx, hco)).To(Succeed())
+
+		_, err = r.Reconcile(ctx, startupReq)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = getDashboard(ctx, "cnv-virtual-machines-inventory")
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		for name := range r.cachedDashboards {
+			if name != "cnv-virtual-machines-inventory" {
+				_, err = getDashboard(ctx, name)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		}
+	})
+
+	It("should set the datasource of the built-in dashboards", func(ctx context.Context) {
+		ctx = logr.NewContext(ctx, GinkgoLogr)
+		hco.Spec.Observability.Dashboards.DatasourceName = "my-thanos"
+		initClient(hco)
+
+		_, err := r.Reconcile(ctx, startupReq)
+		Expect(err).ToNot(HaveOccurred())
+
+		db, err := getDashboard(ctx, "cnv-virtual-machines-utilization")
+		Expect(err).ToNot(HaveOccurred())
+
+		expectedDatasource := map[string]any{"kind": "PrometheusDatasource", "name": "my-thanos"}
+		queries := 0
+		for _, panel := range db.Spec.Panels {
+			for _, query := range panel.Spec.Queries {
+				Expect(query.Spec.Plugin.Spec).To(HaveKeyWithValue("datasource", expectedDatasource))
+				queries++
+			}
+		}
+		Expect(queries).To(BeNumerically(">", 0))
+
+		variables := 0
+		for _, variable := range db.Spec.Variables {
+			if listVariable, ok := variable.Spec.(*persesdashboard.ListVariableSpec); ok && strings.HasPrefix(listVariable.Plugin.Kind, "Prometheus") {
+				Expect(listVariable.Plugin.Spec).To(HaveKeyWithValue("datasource", expectedDatasource))
+				variables++
+			} else if ok {
+				Expect(listVariable.Plugin.Spec).ToNot(HaveKey("datasource"))
+			}
+		}
+		Expect(variables).To(BeNumerically(">", 0))
+
+		By("not modifying the cached built-in dashboards")
+		for _, panel := range r.cachedDashboards["cnv-virtual-machines-utilization"].Spec.Panels {
+			for _, query := range panel.Spec.Queries {
+				Expect(query.Spec.Plugin.Spec).ToNot(HaveKey("datasource"))
+			}
+		}
+	})
+
+	It("should deploy the additional dashboards, and remove them when their ConfigMap is removed from the configuration", func(ctx context.Context) {
+		ctx = logr.NewContext(ctx, GinkgoLogr)
+		hco.Spec.Observability.Dashboards.AdditionalDashboardConfigMaps = []string{"my-dashboards"}
+		notOwned := &persesv1alpha1.PersesDashboard{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "not-owned",
+				Namespace: commontestutils.Namespace,
+				Labels:    map[string]string{hcoutil.AppLabel: hcoutil.HyperConvergedName},
+			},
+		}
+		initClient(hco, notOwned, newConfigMap("my-dashboards", map[string]string{"my-dashboard.yaml": additionalDashboardYAML}))
+
+		res, err := r.Reconcile(ctx, startupReq)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).To(Equal(additionalDashboardsResyncPeriod))
+
+		db, err := getDashboard(ctx, "my-dashboard")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Spec.Display.Name).To(Equal("My Dashboard"))
+		Expect(db.Labels).To(SatisfyAll(
+			HaveKeyWithValue("team", "virt"),
+			HaveKeyWithValue(hcoutil.AppLabel, hcoutil.HyperConvergedName),
+		))
+		Expect(db.OwnerReferences).To(ContainElement(owner))
+
+		By("restoring a modified additional dashboard")
+		db.Spec.Display.Name = "Modified"
+		Expect(cl.Update(ctx, db)).To(Succeed())
+		_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{
+			Namespace: commontestutils.Namespace,
+			Name:      "my-dashboard" + dashboardReqSufix + randomSufix,
+		}})
+		Expect(err).ToNot(HaveOccurred())
+		db, err = getDashboard(ctx, "my-dashboard")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Spec.Display.Name).To(Equal("My Dashboard"))
+
+		By("removing the ConfigMap from the configuration")
+		hco.Spec.Observability.Dashboards.AdditionalDashboardConfigMaps = nil
+		Expect(cl.Update(ctx, hco)).To(Succeed())
+
+		res, err = r.Reconcile(ctx, startupReq)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).To(BeZero())
+
+		_, err = getDashboard(ctx, "my-dashboard")
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		_, err = getDashboard(ctx, "not-owned")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should report an invalid ConfigMap, and keep deploying the other dashboards", func(ctx context.Context) {
+		ctx = logr.NewContext(ctx, GinkgoLogr)
+		hco.Spec.Observability.Dashboards.AdditionalDashboardConfigMaps = []string{"my-dashboards", "bad-dashboards", "missing"}
+		initClient(hco,
+			newConfigMap("my-dashboards", map[string]string{"my-dashboard.yaml": additionalDashboardYAML}),
+			newConfigMap("bad-dashboards", map[string]string{"bad.yaml": "kind: ConfigMap"}),
+		)
+
+		_, err := r.Reconcile(ctx, startupReq)
+		Expect(err).To(MatchError(SatisfyAll(
+			ContainSubstring("invalid dashboard in the bad.yaml key of the bad-dashboards ConfigMap"),
+			ContainSubstring("failed to read the missing ConfigMap"),
+		)))
+
+		_, err = getDashboard(ctx, "my-dashboard")
+		Expect(err).ToNot(HaveOccurred())
+		for name := range r.cachedDashboards {
+			_, err = getDashboard(ctx, name)
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	It("should reject an additional dashboard with the name of a built-in dashboard", func(ctx context.Context) {
+		hco.Spec.Observability.Dashboards.AdditionalDashboardConfigMaps = []string{"my-dashboards"}
+		initClient(hco, newConfigMap("my-dashboards", map[string]string{
+			"dashboard.yaml": `apiVersion: perses.dev/v1alpha1
+kind: PersesDashboard
+metadata:
+  name: cnv-virtual-machines-inventory
+`,
+		}))
+
+		desired, err := r.getDesiredDashboards(ctx, hco.Spec.Observability.Dashboards)
+		Expect(err).To(MatchError("the cnv-virtual-machines-inventory dashboard in the my-dashboards ConfigMap already exists"))
+		Expect(desired).To(HaveKeyWithValue("cnv-virtual-machines-inventory", r.cachedDashboards["cnv-virtual-machines-inventory"]))
+	})
+
+	DescribeTable("parseAdditionalDashboard should reject invalid dashboards", func(manifest, reason string) {
+		_, err := parseAdditionalDashboard(manifest, commontestutils.Namespace)
+		Expect(err).To(MatchError(ContainSubstring(reason)))
+	},
+		Entry("wrong kind", "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\n", "expected perses.dev/v1alpha1, Kind=PersesDashboard"),
+		Entry("invalid name", "apiVersion: perses.dev/v1alpha1\nkind: PersesDashboard\nmetadata:\n  name: Test\n", `invalid name "Test"`),
+		Entry("other namespace", "apiVersion: perses.dev/v1alpha1\nkind: PersesDashboard\nmetadata:\n  name: test\n  namespace: other\n",
+			"the dashboard must be in the "+commontestutils.Namespace+" namespace"),
+		Entry("invalid yaml", "not: [yaml", "yaml"),
+	)
+})
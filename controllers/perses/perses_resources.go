@@ -85,7 +85,8 @@ var (
 		if d.Namespace != hcoutil.GetOperatorNamespaceFromEnv() {
 			return false
 		}
-		return slices.Contains(managedDashboards, d.Name)
+		// the built-in dashboards, and the additional dashboards from the ConfigMaps
+		return slices.Contains(managedDashboards, d.Name) || d.Labels[hcoutil.AppLabel] == hcoutil.HyperConvergedName
 	})
 
 	datasourcePredicate = predicate.NewTypedPredicateFuncs[*persesv1alpha1.PersesDatasource](func(ds *persesv1alpha1.PersesDatasource) bool {
@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -68,6 +69,7 @@ var _ = Describe("Perses controller", func() {
 		s = scheme.Scheme
 		Expect(apiextensionsv1.AddToScheme(s)).To(Succeed())
 		Expect(persesv1alpha1.AddToScheme(s)).To(Succeed())
+		Expect(hcov1.AddToScheme(s)).To(Succeed())
 
 		var err error
 		dashboards, err = initDashboards(commontestutils.Namespace, GinkgoLogr)
@@ -293,7 +295,7 @@ metadata:
 				obj.Labels = map[string]string{}
 				Expect(base.Create(ctx, &obj)).To(Succeed())
 			}
-			err := r.reconcileAll(ctx, GinkgoLogr)
+			err := r.reconcileAll(ctx, nil, GinkgoLogr)
 			Expect(err).To(HaveOccurred())
 		})
 	})
@@ -15,7 +15,7 @@ fi
 kubectl create ns $hco_namespace --dry-run=client -o yaml | kubectl apply -f -
 
 # Create additional namespaces needed for HCO components
-namespaces=("openshift")
+namespaces=("openshift" "kubevirt-os-images")
 for namespace in ${namespaces[@]}; do
     if [[ $(kubectl get ns ${namespace}) == "" ]]; then
         kubectl create ns ${namespace} --dry-run=client -o yaml | kubectl apply -f -
@@ -25,7 +25,7 @@ done
 # Exclude Openshift specific resources if not on OCP/OKD
 LABEL_SELECTOR_ARG=""
 if [ "$IS_OPENSHIFT" != "true" ]; then
-    LABEL_SELECTOR_ARG="-l name!=ssp-operator,name!=hyperconverged-cluster-cli-download"
+    LABEL_SELECTOR_ARG="-l name!=ssp-operator"
 fi
 
 # Launch all of the CRDs.
@@ -67,7 +67,7 @@ sed -i -r "s|: quay.io/kubevirt/hyperconverged-cluster-webhook(@sha256)?:.*$|: $
 "${CMD}" create ns "${HCO_NAMESPACE}" | true
 
 # Create additional namespaces needed for HCO components
-namespaces=("openshift")
+namespaces=("openshift" "kubevirt-os-images")
 for namespace in ${namespaces[@]}; do
     if [[ $(${CMD} get ns ${namespace}) == "" ]]; then
         ${CMD} create ns ${namespace}
@@ -131,7 +131,7 @@ function debug(){
 # Exclude Openshift specific resources
 LABEL_SELECTOR_ARG=""
 if [ "$IS_OPENSHIFT" != "true" ]; then
-    LABEL_SELECTOR_ARG="-l name!=ssp-operator,name!=hyperconverged-cluster-cli-download"
+    LABEL_SELECTOR_ARG="-l name!=ssp-operator"
 fi
 
 hack/deploy-cert-manager.sh
@@ -188,11 +188,11 @@ OPERATORS=(
     "cdi-operator"
     "cluster-network-addons-operator"
     "kubevirt-migration-operator"
+    "hyperconverged-cluster-cli-download"
 )
 
 if [ "$IS_OPENSHIFT" = "true" ]; then
     OPERATORS+=("ssp-operator")
-    OPERATORS+=("hyperconverged-cluster-cli-download")
     OPERATORS+=("virt-platform-autopilot")
     OPERATORS+=("inflightoperations")
 fi
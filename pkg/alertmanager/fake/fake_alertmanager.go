@@ -0,0 +1,182 @@
+package fakealertmanager
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+)
+
+// Alertmanager is a local fake of the silences API of Alertmanager. It keeps the silences in memory, and computes
+// their state from their start and end times, as Alertmanager does.
+type Alertmanager struct {
+	*httptest.Server
+
+	token    string
+	lock     sync.Mutex
+	silences []alertmanager.Silence
+	lastID   int
+}
+
+// NewAlertmanager starts a new fake Alertmanager, that requires the given bearer token. The caller should Close it.
+func NewAlertmanager(token string) *Alertmanager {
+	am := &Alertmanager{token: token}
+	am.Server = httptest.NewServer(http.HandlerFunc(am.serveHTTP))
+	return am
+}
+
+// Silences returns a copy of all the silences, including the expired ones
+func (am *Alertmanager) Silences() []alertmanager.Silence {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+
+	silences := make([]alertmanager.Silence, len(am.silences))
+	for i, silence := range am.silences {
+		silences[i] = withState(silence)
+	}
+	return silences
+}
+
+// ActiveSilences returns a copy of the active and pending silences
+func (am *Alertmanager) ActiveSilences() []alertmanager.Silence {
+	return slices.DeleteFunc(am.Silences(), func(silence alertmanager.Silence) bool {
+		return silence.Status.State == alertmanager.SilenceStateExpired
+	})
+}
+
+// AddSilence adds a silence, as if it was created by a user, and returns its ID
+func (am *Alertmanager) AddSilence(silence alertmanager.Silence) string {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+
+	return am.add(silence)
+}
+
+// ExpireSilence expires a silence, as if it was expired by a user
+func (am *Alertmanager) ExpireSilence(id string) bool {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+
+	return am.expire(id)
+}
+
+// RemoveSilence removes a silence, as if its retention time ended
+func (am *Alertmanager) RemoveSilence(id string) {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+
+	am.silences = slices.DeleteFunc(am.silences, func(silence alertmanager.Silence) bool {
+		return silence.ID == id
+	})
+}
+
+func (am *Alertmanager) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+am.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	am.lock.Lock()
+	defer am.lock.Unlock()
+
+	switch {
+	case r.URL.Path == "/api/v2/silences" && r.Method == http.MethodGet:
+		silences := make([]alertmanager.Silence, len(am.silences))
+		for i, silence := range am.silences {
+			silences[i] = withState(silence)
+		}
+		writeJSON(w, silences)
+
+	case r.URL.Path == "/api/v2/silences" && r.Method == http.MethodPost:
+		silence := alertmanager.Silence{}
+		if err := json.NewDecoder(r.Body).Decode(&silence); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		id := silence.ID
+		if id == "" {
+			id = am.add(silence)
+		} else if idx := am.find(id); idx < 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		} else {
+			am.silences[idx] = silence
+		}
+		writeJSON(w, map[string]string{"silenceID": id})
+
+	case strings.HasPrefix(r.URL.Path, "/api/v2/silence/"):
+		id := strings.TrimPrefix(r.URL.Path, "/api/v2/silence/")
+		idx := am.find(id)
+		if idx < 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, withState(am.silences[idx]))
+		case http.MethodDelete:
+			am.expire(id)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (am *Alertmanager) add(silence alertmanager.Silence) string {
+	am.lastID++
+	silence.ID = strconv.Itoa(am.lastID)
+	am.silences = append(am.silences, silence)
+	return silence.ID
+}
+
+func (am *Alertmanager) find(id string) int {
+	return slices.IndexFunc(am.silences, func(silence alertmanager.Silence) bool {
+		return silence.ID == id
+	})
+}
+
+func (am *Alertmanager) expire(id string) bool {
+	idx := am.find(id)
+	if idx < 0 {
+		return false
+	}
+
+	if withState(am.silences[idx]).Status.State != alertmanager.SilenceStateExpired {
+		am.silences[idx].EndsAt = time.Now().UTC().Format(time.RFC3339Nano)
+	}
+	return true
+}
+
+func withState(silence alertmanager.Silence) alertmanager.Silence {
+	now := time.Now()
+	startsAt, _ := time.Parse(time.RFC3339, silence.StartsAt)
+	endsAt, err := time.Parse(time.RFC3339, silence.EndsAt)
+
+	switch {
+	case err == nil && !endsAt.After(now):
+		silence.Status.State = alertmanager.SilenceStateExpired
+	case startsAt.After(now):
+		silence.Status.State = alertmanager.SilenceStatePending
+	default:
+		silence.Status.State = alertmanager.SilenceStateActive
+	}
+
+	silence.Matchers = slices.Clone(silence.Matchers)
+	return silence
+}
+
+func writeJSON(w http.ResponseWriter, obj any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(obj)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -11,6 +12,13 @@ import (
 
 var log = logf.Log.WithName("alertmanager")
 
+// The states of an Alertmanager silence
+const (
+	SilenceStateActive  = "active"
+	SilenceStatePending = "pending"
+	SilenceStateExpired = "expired"
+)
+
 type Api struct {
 	httpClient http.Client
 	host       string
@@ -18,7 +26,7 @@ type Api struct {
 }
 
 type Silence struct {
-	ID        string    `json:"id"`
+	ID        string    `json:"id,omitempty"`
 	Comment   string    `json:"comment"`
 	CreatedBy string    `json:"createdBy"`
 	EndsAt    string    `json:"endsAt"`
@@ -38,6 +46,10 @@ type Matcher struct {
 	Value   string `json:"value"`
 }
 
+type createSilenceResponse struct {
+	SilenceID string `json:"silenceID"`
+}
+
 func NewAPI(httpClient http.Client, host string, token string) *Api {
 	return &Api{
 		httpClient: httpClient,
@@ -47,21 +59,14 @@ func NewAPI(httpClient http.Client, host string, token string) *Api {
 }
 
 func (api *Api) ListSilences() ([]Silence, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v2/silences", api.host), nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Add("Authorization", "Bearer "+api.token)
-
-	resp, err := api.httpClient.Do(req)
+	resp, err := api.do(http.MethodGet, "/api/v2/silences", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list silences: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		log.V(1).Info("list silences http request", "req", req, "resp", resp)
+		log.V(1).Info("list silences http request", "resp", resp)
 		return nil, fmt.Errorf("failed to list silences: %s", resp.Status)
 	}
 
@@ -74,46 +79,96 @@ func (api *Api) ListSilences() ([]Silence, error) {
 	return amSilences, nil
 }
 
-func (api *Api) CreateSilence(s Silence) error {
+// GetSilence returns the silence with the given ID, or nil if there is no such silence
+func (api *Api) GetSilence(id string) (*Silence, error) {
+	resp, err := api.do(http.MethodGet, "/api/v2/silence/"+id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get silence: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get silence: %s", resp.Status)
+	}
+
+	silence := &Silence{}
+	if err = json.NewDecoder(resp.Body).Decode(silence); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return silence, nil
+}
+
+// CreateSilence creates a new silence, or updates an existing one if the ID of the silence is set. It returns the ID
+// of the silence; Alertmanager may return a new ID when updating a silence.
+func (api *Api) CreateSilence(s Silence) (string, error) {
 	body, err := json.Marshal(s)
 	if err != nil {
-		return fmt.Errorf("failed to marshal silence: %w", err)
+		return "", fmt.Errorf("failed to marshal silence: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v2/silences", api.host), bytes.NewReader(body))
+	resp, err := api.do(http.MethodPost, "/api/v2/silences", body)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return "", fmt.Errorf("failed to create silence: %w", err)
 	}
+	defer resp.Body.Close()
 
-	req.Header.Add("Authorization", "Bearer "+api.token)
-	req.Header.Add("Content-Type", "application/json")
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to create silence: %s", resp.Status)
+	}
+
+	created := createSilenceResponse{}
+	if err = json.NewDecoder(resp.Body).Decode(&created); err != nil && err != io.EOF {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
 
-	resp, err := api.httpClient.Do(req)
+	return created.SilenceID, nil
+}
+
+// ExpireSilence ends a silence immediately. Alertmanager keeps the expired silence until its retention time ends.
+func (api *Api) ExpireSilence(id string) error {
+	resp, err := api.do(http.MethodDelete, "/api/v2/silence/"+id, nil)
 	if err != nil {
-		return fmt.Errorf("failed to create silence: %w", err)
+		return fmt.Errorf("failed to expire silence: %w", err)
 	}
+	defer resp.Body.Close()
+
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed to create silence: %s", resp.Status)
+		return fmt.Errorf("failed to expire silence: %s", resp.Status)
 	}
 
 	return nil
 }
 
+// DeleteSilence removes a silence. Alertmanager does not support removing a silence before its retention time ends,
+// so the silence is expired.
 func (api *Api) DeleteSilence(id string) error {
-	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/api/v2/silence/%s", api.host, id), nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+	if err := api.ExpireSilence(id); err != nil {
+		return fmt.Errorf("failed to delete silence: %w", err)
 	}
 
-	req.Header.Add("Authorization", "Bearer "+api.token)
+	return nil
+}
 
-	resp, err := api.httpClient.Do(req)
+func (api *Api) do(method, path string, body []byte) (*http.Response, error) {
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequest(method, api.host+path, bodyReader)
 	if err != nil {
-		return fmt.Errorf("failed to delete silence: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed to delete silence: %s", resp.Status)
+
+	req.Header.Add("Authorization", "Bearer "+api.token)
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
 	}
 
-	return nil
+	return api.httpClient.Do(req)
 }
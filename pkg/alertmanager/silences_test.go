@@ -5,11 +5,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+	fakealertmanager "github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager/fake"
 )
 
 const listResp = `[{"id":"bb881d7f-3278-46fd-a638-d42c57f235b6","status":{"state":"active"},"updatedAt":"2024-07-16T11:46:30.653Z","comment":"test purposes","createdBy":"test_user","endsAt":"3000-01-01T00:00:00.000Z","matchers":[{"isEqual":true,"isRegex":false,"name":"alertname","value":"TestAlert"}],"startsAt":"2024-07-16T11:46:30.653Z"}]`
@@ -58,7 +60,7 @@ var _ = Describe("Silences", func() {
 	})
 
 	It("should successfully POST /api/v2/silences", func() {
-		err := api.CreateSilence(alertmanager.Silence{})
+		_, err := api.CreateSilence(alertmanager.Silence{})
 		Expect(err).ToNot(HaveOccurred())
 	})
 
@@ -66,4 +68,98 @@ var _ = Describe("Silences", func() {
 		err := api.DeleteSilence("bb881d7f-3278-46fd-a638-d42c57f235b6")
 		Expect(err).ToNot(HaveOccurred())
 	})
+
+	Context("with a fake Alertmanager", func() {
+		var fakeAM *fakealertmanager.Alertmanager
+
+		newSilence := func(endsIn time.Duration) alertmanager.Silence {
+			now := time.Now().UTC()
+			return alertmanager.Silence{
+				Comment:   "test purposes",
+				CreatedBy: "test_user",
+				StartsAt:  now.Format(time.RFC3339),
+				EndsAt:    now.Add(endsIn).Format(time.RFC3339),
+				Matchers: []alertmanager.Matcher{
+					{IsEqual: true, Name: "alertname", Value: "TestAlert"},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			fakeAM = fakealertmanager.NewAlertmanager("token")
+			api = alertmanager.NewAPI(http.Client{}, fakeAM.URL, "token")
+		})
+
+		AfterEach(func() {
+			fakeAM.Close()
+		})
+
+		It("should create a silence, and return its ID", func() {
+			id, err := api.CreateSilence(newSilence(time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(id).ToNot(BeEmpty())
+
+			silences, err := api.ListSilences()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(silences).To(HaveLen(1))
+			Expect(silences[0].ID).To(Equal(id))
+			Expect(silences[0].Status.State).To(Equal(alertmanager.SilenceStateActive))
+		})
+
+		It("should update an existing silence", func() {
+			id := fakeAM.AddSilence(newSilence(time.Hour))
+
+			silence := newSilence(2 * time.Hour)
+			silence.ID = id
+			silence.Comment = "updated"
+			updatedID, err := api.CreateSilence(silence)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedID).To(Equal(id))
+
+			Expect(fakeAM.Silences()).To(HaveLen(1))
+			Expect(fakeAM.Silences()[0].Comment).To(Equal("updated"))
+		})
+
+		It("should get a silence by its ID", func() {
+			id := fakeAM.AddSilence(newSilence(time.Hour))
+
+			silence, err := api.GetSilence(id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(silence).ToNot(BeNil())
+			Expect(silence.ID).To(Equal(id))
+			Expect(silence.Matchers).To(HaveLen(1))
+
+			silence, err = api.GetSilence("unknown")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(silence).To(BeNil())
+		})
+
+		It("should expire a silence", func() {
+			id := fakeAM.AddSilence(newSilence(time.Hour))
+
+			Expect(api.ExpireSilence(id)).To(Succeed())
+
+			silence, err := api.GetSilence(id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(silence.Status.State).To(Equal(alertmanager.SilenceStateExpired))
+			Expect(fakeAM.ActiveSilences()).To(BeEmpty())
+		})
+
+		It("should expire a silence when deleting it", func() {
+			id := fakeAM.AddSilence(newSilence(time.Hour))
+
+			Expect(api.DeleteSilence(id)).To(Succeed())
+			Expect(fakeAM.ActiveSilences()).To(BeEmpty())
+		})
+
+		It("should fail to expire an unknown silence", func() {
+			Expect(api.ExpireSilence("unknown")).To(MatchError(ContainSubstring("404")))
+		})
+
+		It("should fail if the token is wrong", func() {
+			api = alertmanager.NewAPI(http.Client{}, fakeAM.URL, "wrong")
+			_, err := api.ListSilences()
+			Expect(err).To(MatchError(ContainSubstring("401")))
+		})
+	})
 })
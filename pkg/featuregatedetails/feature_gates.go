@@ -34,6 +34,14 @@ func ListAlphaFeatureGates() []string {
 	return slices.Sorted(filterFGByPhase(maps.All(featureGatesDetails), featuregates.PhaseAlpha))
 }
 
+// ListFeatureGates returns all the supported feature gates, sorted by phase and name
+func ListFeatureGates() featuregates.FeatureGates {
+	fgs := featuregates.FeatureGates(slices.Collect(maps.Values(featureGatesDetails)))
+	fgs.Sort()
+
+	return fgs
+}
+
 func init() {
 	if err := setup(featureGateJson); err != nil {
 		panic("unable to setup v1 feature gates;" + err.Error())
@@ -135,4 +135,31 @@ var _ = Describe("Feature Gate Details", func() {
 			Expect(betaFGs).To(BeEmpty())
 		})
 	})
+
+	Context("ListFeatureGates", func() {
+		It("should return all the feature gates, sorted by phase and name", func() {
+			featureGatesDetails = map[string]featuregates.FeatureGate{
+				"beta2":  {Name: "beta2", Phase: featuregates.PhaseBeta},
+				"alpha1": {Name: "alpha1", Phase: featuregates.PhaseAlpha},
+				"fg1":    {Name: "fg1", Phase: featuregates.PhaseGA},
+				"beta1":  {Name: "beta1", Phase: featuregates.PhaseBeta},
+				"dep1":   {Name: "dep1", Phase: featuregates.PhaseDeprecated},
+			}
+
+			fgs := ListFeatureGates()
+			Expect(fgs).To(Equal(featuregates.FeatureGates{
+				{Name: "fg1", Phase: featuregates.PhaseGA},
+				{Name: "beta1", Phase: featuregates.PhaseBeta},
+				{Name: "beta2", Phase: featuregates.PhaseBeta},
+				{Name: "alpha1", Phase: featuregates.PhaseAlpha},
+				{Name: "dep1", Phase: featuregates.PhaseDeprecated},
+			}))
+		})
+
+		It("should return an empty list if no FG is defined", func() {
+			featureGatesDetails = nil
+
+			Expect(ListFeatureGates()).To(BeEmpty())
+		})
+	})
 })
@@ -0,0 +1,70 @@
+package featuregatepolicy
+
+import (
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	hcov1fg "github.com/kubevirt/hyperconverged-cluster-operator/api/v1/featuregates"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregatedetails"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregates"
+)
+
+// Result is the action that the feature gates policy requires for a specific feature gate
+type Result struct {
+	Name   string
+	Phase  featuregates.Phase
+	Action hcov1.FeatureGatePolicyAction
+}
+
+// GetAction returns the action for the feature gates in the phase. If the policy does not set the action, the default
+// action of the phase is returned.
+func GetAction(policy *hcov1.FeatureGatesPolicy, phase featuregates.Phase) hcov1.FeatureGatePolicyAction {
+	if policy == nil {
+		policy = &hcov1.FeatureGatesPolicy{}
+	}
+
+	var action, defaultAction hcov1.FeatureGatePolicyAction
+	switch phase {
+	case featuregates.PhaseAlpha:
+		if policy.NoAlphaFeatureGates {
+			return hcov1.FeatureGatePolicyDeny
+		}
+		action, defaultAction = policy.Alpha, hcov1.FeatureGatePolicyAllow
+	case featuregates.PhaseDeprecated:
+		action, defaultAction = policy.Deprecated, hcov1.FeatureGatePolicyWarn
+	case featuregates.PhaseDiscontinued:
+		action, defaultAction = policy.Discontinued, hcov1.FeatureGatePolicyWarn
+	default:
+		return hcov1.FeatureGatePolicyAllow
+	}
+
+	if action == "" {
+		return defaultAction
+	}
+
+	return action
+}
+
+// Evaluate returns the feature gates in the list that the policy does not simply allow, with the required action.
+// The alpha feature gates are evaluated only if they are enabled. The deprecated and the discontinued feature gates
+// are evaluated if they are in the list. Unknown feature gates are ignored.
+func Evaluate(policy *hcov1.FeatureGatesPolicy, fgs hcov1fg.HyperConvergedFeatureGates) []Result {
+	var results []Result
+
+	for _, fg := range fgs {
+		phase, exists := featuregatedetails.GetFeatureGatePhase(fg.Name)
+		if !exists {
+			continue
+		}
+
+		if phase == featuregates.PhaseAlpha {
+			if enabled, _ := fgs.IsExplicitlyEnabled(fg.Name); !enabled {
+				continue
+			}
+		}
+
+		if action := GetAction(policy, phase); action != hcov1.FeatureGatePolicyAllow {
+			results = append(results, Result{Name: fg.Name, Phase: phase, Action: action})
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,74 @@
+package featuregatepolicy
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	hcov1fg "github.com/kubevirt/hyperconverged-cluster-operator/api/v1/featuregates"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregates"
+)
+
+func TestFeatureGatePolicy(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Feature Gate Policy Suite")
+}
+
+var _ = Describe("Feature Gate Policy", func() {
+	Context("GetAction", func() {
+		DescribeTable("should return the action of the phase", func(policy *hcov1.FeatureGatesPolicy, phase featuregates.Phase, expected hcov1.FeatureGatePolicyAction) {
+			Expect(GetAction(policy, phase)).To(Equal(expected))
+		},
+			Entry("nil policy; alpha", nil, featuregates.PhaseAlpha, hcov1.FeatureGatePolicyAllow),
+			Entry("nil policy; deprecated", nil, featuregates.PhaseDeprecated, hcov1.FeatureGatePolicyWarn),
+			Entry("nil policy; discontinued", nil, featuregates.PhaseDiscontinued, hcov1.FeatureGatePolicyWarn),
+			Entry("nil policy; beta", nil, featuregates.PhaseBeta, hcov1.FeatureGatePolicyAllow),
+			Entry("empty policy; deprecated", &hcov1.FeatureGatesPolicy{}, featuregates.PhaseDeprecated, hcov1.FeatureGatePolicyWarn),
+			Entry("alpha action", &hcov1.FeatureGatesPolicy{Alpha: hcov1.FeatureGatePolicyWarn}, featuregates.PhaseAlpha, hcov1.FeatureGatePolicyWarn),
+			Entry("deprecated action", &hcov1.FeatureGatesPolicy{Deprecated: hcov1.FeatureGatePolicyRemove}, featuregates.PhaseDeprecated, hcov1.FeatureGatePolicyRemove),
+			Entry("discontinued action", &hcov1.FeatureGatesPolicy{Discontinued: hcov1.FeatureGatePolicyDeny}, featuregates.PhaseDiscontinued, hcov1.FeatureGatePolicyDeny),
+			Entry("no alpha feature gates", &hcov1.FeatureGatesPolicy{Alpha: hcov1.FeatureGatePolicyRemove, NoAlphaFeatureGates: true}, featuregates.PhaseAlpha, hcov1.FeatureGatePolicyDeny),
+			Entry("no alpha feature gates; beta", &hcov1.FeatureGatesPolicy{NoAlphaFeatureGates: true}, featuregates.PhaseBeta, hcov1.FeatureGatePolicyAllow),
+		)
+	})
+
+	Context("Evaluate", func() {
+		It("should return nothing for the default policy, if there are no deprecated feature gates", func() {
+			fgs := hcov1fg.HyperConvergedFeatureGates{
+				{Name: "alignCPUs"},
+				{Name: "decentralizedLiveMigration", State: new(hcov1fg.Disabled)},
+				{Name: "unknown"},
+			}
+
+			Expect(Evaluate(nil, fgs)).To(BeEmpty())
+		})
+
+		It("should evaluate only the enabled alpha feature gates", func() {
+			fgs := hcov1fg.HyperConvergedFeatureGates{
+				{Name: "alignCPUs"},
+				{Name: "downwardMetrics", State: new(hcov1fg.Disabled)},
+			}
+			policy := &hcov1.FeatureGatesPolicy{NoAlphaFeatureGates: true}
+
+			Expect(Evaluate(policy, fgs)).To(Equal([]Result{
+				{Name: "alignCPUs", Phase: featuregates.PhaseAlpha, Action: hcov1.FeatureGatePolicyDeny},
+			}))
+		})
+
+		It("should evaluate the deprecated feature gates, even if disabled", func() {
+			fgs := hcov1fg.HyperConvergedFeatureGates{
+				{Name: "disableMDevConfiguration", State: new(hcov1fg.Disabled)},
+				{Name: "persistentReservation"},
+				{Name: "alignCPUs"},
+			}
+			policy := &hcov1.FeatureGatesPolicy{Deprecated: hcov1.FeatureGatePolicyRemove}
+
+			Expect(Evaluate(policy, fgs)).To(Equal([]Result{
+				{Name: "disableMDevConfiguration", Phase: featuregates.PhaseDeprecated, Action: hcov1.FeatureGatePolicyRemove},
+				{Name: "persistentReservation", Phase: featuregates.PhaseDeprecated, Action: hcov1.FeatureGatePolicyRemove},
+			}))
+		})
+	})
+})
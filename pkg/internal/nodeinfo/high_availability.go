@@ -23,6 +23,8 @@ var (
 	controlPlaneMultiNode         atomic.Bool
 	controlPlaneNodeExist         atomic.Bool
 	infrastructureHighlyAvailable atomic.Bool
+	workloadsMultiNode            atomic.Bool
+	infrastructureMultiZone       atomic.Bool
 )
 
 func IsControlPlaneHighlyAvailable() bool {
@@ -44,3 +46,15 @@ func IsControlPlaneNodeExists() bool {
 func IsInfrastructureHighlyAvailable() bool {
 	return infrastructureHighlyAvailable.Load()
 }
+
+// IsWorkloadsMultiNode reports whether there is more than one node to run
+// workloads on, so that VMs can be live migrated out of a drained node.
+func IsWorkloadsMultiNode() bool {
+	return workloadsMultiNode.Load()
+}
+
+// IsInfrastructureMultiZone reports whether the worker nodes span more than
+// one zone, according to the topology.kubernetes.io/zone node label.
+func IsInfrastructureMultiZone() bool {
+	return infrastructureMultiZone.Load()
+}
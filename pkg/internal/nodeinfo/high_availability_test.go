@@ -55,6 +55,45 @@ var _ = Describe("HighAvailability", func() {
 		Entry("one control plane, two masters, and three worker nodes", genNodeList(1, 2, 3), BeTrue(), BeTrue(), BeTrue()),
 	)
 
+	DescribeTable("should determine if the workloads are on multiple nodes", func(ctx context.Context, nodes []client.Object, multiNode gomegatypes.GomegaMatcher) {
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(nodes...).Build()
+
+		_, err := nodeinfo.HandleNodeChanges(ctx, cli, nil, GinkgoLogr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nodeinfo.IsWorkloadsMultiNode()).To(multiNode)
+	},
+		Entry("no nodes", []client.Object{}, BeFalse()),
+		Entry("three control plane nodes", genNodeList(3, 0, 0), BeFalse()),
+		Entry("one control plane and one worker node", genNodeList(1, 0, 1), BeFalse()),
+		Entry("one control plane and two worker nodes", genNodeList(1, 0, 2), BeTrue()),
+	)
+
+	DescribeTable("should count the workload nodes", func(ctx context.Context, nodes []client.Object, count int32) {
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(nodes...).Build()
+
+		_, err := nodeinfo.HandleNodeChanges(ctx, cli, nil, GinkgoLogr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nodeinfo.GetWorkloadNodeCount()).To(Equal(count))
+	},
+		Entry("no nodes", []client.Object{}, int32(0)),
+		Entry("three control plane nodes", genNodeList(3, 0, 0), int32(0)),
+		Entry("three control plane and five worker nodes", genNodeList(3, 0, 5), int32(5)),
+	)
+
+	DescribeTable("should determine if the worker nodes span multiple zones", func(ctx context.Context, nodes []client.Object, multiZone gomegatypes.GomegaMatcher) {
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(nodes...).Build()
+
+		_, err := nodeinfo.HandleNodeChanges(ctx, cli, nil, GinkgoLogr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nodeinfo.IsInfrastructureMultiZone()).To(multiZone)
+	},
+		Entry("no nodes", []client.Object{}, BeFalse()),
+		Entry("worker nodes without zones", genNodeList(3, 0, 3), BeFalse()),
+		Entry("worker nodes in a single zone", withZones(genNodeList(0, 0, 3), "zone-a"), BeFalse()),
+		Entry("worker nodes in two zones", withZones(genNodeList(0, 0, 3), "zone-a", "zone-b"), BeTrue()),
+		Entry("control plane nodes in two zones", withZones(genNodeList(2, 0, 0), "zone-a", "zone-b"), BeFalse()),
+	)
+
 	Context("check if HandleNodeChanges returns 'changed' for high availability", func() {
 		BeforeEach(func() {
 			nodes := genNodeList(3, 0, 3)
@@ -273,3 +312,12 @@ func genNodeListWithArbiters(controlPlanes, masters, workers int) []client.Objec
 
 	return nodesArray
 }
+
+// withZones sets the zone label of the nodes, in a round-robin manner
+func withZones(nodes []client.Object, zones ...string) []client.Object {
+	for i, node := range nodes {
+		node.GetLabels()[corev1.LabelTopologyZone] = zones[i%len(zones)]
+	}
+
+	return nodes
+}
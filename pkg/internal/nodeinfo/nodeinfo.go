@@ -3,6 +3,7 @@ package nodeinfo
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -12,6 +13,14 @@ import (
 	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
 )
 
+// workloadNodeCount is not part of the change detection, because it does not affect the operand CRs directly
+var workloadNodes atomic.Int32
+
+// GetWorkloadNodeCount returns the number of the nodes that can run workloads
+func GetWorkloadNodeCount() int32 {
+	return workloadNodes.Load()
+}
+
 func HandleNodeChanges(ctx context.Context, cl client.Client, hc *hcov1.HyperConverged, logger logr.Logger) (bool, error) {
 	logger.Info("reading cluster nodes")
 	nodes, err := getNodes(ctx, cl)
@@ -34,11 +43,13 @@ func getNodes(ctx context.Context, cl client.Client) ([]corev1.Node, error) {
 
 func processNodeInfo(nodes []corev1.Node, hc *hcov1.HyperConverged) bool {
 	workerNodeCount := 0
+	workloadNodeCount := 0
 	cpNodeCount := 0
 	arbiterNodeCount := 0
 
 	workloadArchMap := map[string]int{}
 	cpArches := sets.New[string]()
+	workerZones := sets.New[string]()
 
 	isWorkloadNode := isWorkloadNodeFunc(hc)
 
@@ -46,9 +57,13 @@ func processNodeInfo(nodes []corev1.Node, hc *hcov1.HyperConverged) bool {
 		arch := node.Status.NodeInfo.Architecture
 		if isWorkerNode(node) {
 			workerNodeCount++
+			if zone := node.Labels[corev1.LabelTopologyZone]; zone != "" {
+				workerZones.Insert(zone)
+			}
 		}
 
 		if isWorkloadNode(node) {
+			workloadNodeCount++
 			workloadArchMap[arch]++
 		}
 
@@ -80,8 +95,16 @@ func processNodeInfo(nodes []corev1.Node, hc *hcov1.HyperConverged) bool {
 	newValue = workerNodeCount >= 2
 	changed = infrastructureHighlyAvailable.Swap(newValue) != newValue || changed
 
+	newValue = workloadNodeCount >= 2
+	changed = workloadsMultiNode.Swap(newValue) != newValue || changed
+
+	newValue = workerZones.Len() >= 2
+	changed = infrastructureMultiZone.Swap(newValue) != newValue || changed
+
 	changed = architectures.set(workloadArchMap, cpArches) || changed
 
+	workloadNodes.Store(int32(workloadNodeCount))
+
 	return changed
 }
 
@@ -0,0 +1,297 @@
+package alertoverrides
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	hcorules "github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules"
+	observabilityrules "github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
+)
+
+const (
+	severityLabel        = "severity"
+	runbookURLAnnotation = "runbook_url"
+)
+
+var (
+	// matches the comparison to a number, at the end of an alert expression; e.g. " >= 0.9" in
+	// "instance:node_cpu_utilisation:rate1m >= 0.9"
+	thresholdRegex = regexp.MustCompile(`((?:==|!=|>=|<=|>|<)\s*)-?[0-9]+(?:\.[0-9]+)?(?:[eE][-+]?[0-9]+)?(\s*)$`)
+
+	labelNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	getKnownRules = sync.OnceValues(buildKnownRules)
+)
+
+// GetConfig returns the observability configuration of the HyperConverged CR, that contains the alert overrides and
+// the alerting configuration
+func GetConfig(hc *hcov1.HyperConverged) *hcov1.ObservabilityConfig {
+	if hc == nil {
+		return nil
+	}
+
+	return hc.Spec.Observability
+}
+
+// Apply modifies the alerts of the PrometheusRule according to the alerting configuration, and then according to the
+// alert overrides. The overrides of alerts that are not in the PrometheusRule are ignored.
+func Apply(rule *promv1.PrometheusRule, cfg *hcov1.ObservabilityConfig) error {
+	if cfg == nil {
+		return nil
+	}
+
+	_, err := apply(rule, cfg.Alerting, cfg.AlertOverrides)
+	return err
+}
+
+// Validate checks the alerting configuration, and that the alert overrides refer to alerts that HCO deploys, and that
+// they can be applied to these alerts
+func Validate(cfg *hcov1.ObservabilityConfig) error {
+	if cfg == nil {
+		return nil
+	}
+
+	if err := validateAlertingConfig(cfg.Alerting); err != nil {
+		return fmt.Errorf("invalid alerting configuration: %w", err)
+	}
+
+	overrides := cfg.AlertOverrides
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	for _, override := range overrides {
+		if err := validateOverride(override); err != nil {
+			return fmt.Errorf("invalid alert override for %s: %w", override.Alert, err)
+		}
+	}
+
+	rules, err := getKnownRules()
+	if err != nil {
+		return err
+	}
+
+	matched := make([]bool, len(overrides))
+	for _, rule := range rules {
+		ruleMatched, err := apply(rule.DeepCopy(), cfg.Alerting, overrides)
+		if err != nil {
+			return err
+		}
+
+		for i, m := range ruleMatched {
+			matched[i] = matched[i] || m
+		}
+	}
+
+	for i, override := range overrides {
+		if !matched[i] {
+			if override.MatchSeverity != "" {
+				return fmt.Errorf("invalid alert override for %s: unknown alert, or no rule with the %s severity", override.Alert, override.MatchSeverity)
+			}
+			return fmt.Errorf("invalid alert override for %s: unknown alert", override.Alert)
+		}
+	}
+
+	return nil
+}
+
+func validateAlertingConfig(alerting *hcov1.AlertingConfig) error {
+	if alerting == nil {
+		return nil
+	}
+
+	if alerting.RunbookBaseURL != "" {
+		u, err := url.Parse(alerting.RunbookBaseURL)
+		if err != nil {
+			return fmt.Errorf("invalid runbookBaseURL: %w", err)
+		}
+
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid runbookBaseURL %q: must be an absolute http or https URL", alerting.RunbookBaseURL)
+		}
+	}
+
+	for name := range alerting.AdditionalLabels {
+		if !labelNameRegex.MatchString(name) {
+			return fmt.Errorf("invalid label name %q", name)
+		}
+
+		if name == severityLabel {
+			return errors.New("use the severityMapping field, instead of the severity label")
+		}
+	}
+
+	return nil
+}
+
+func validateOverride(override hcov1.AlertOverride) error {
+	if override.For != nil && override.For.Duration < 0 {
+		return errors.New("for must not be negative")
+	}
+
+	for name := range override.Labels {
+		if !labelNameRegex.MatchString(name) {
+			return fmt.Errorf("invalid label name %q", name)
+		}
+
+		if name == severityLabel {
+			return errors.New("use the severity field, instead of the severity label")
+		}
+	}
+
+	for name := range override.Annotations {
+		if !labelNameRegex.MatchString(name) {
+			return fmt.Errorf("invalid annotation name %q", name)
+		}
+	}
+
+	return nil
+}
+
+// apply modifies the alerts of the PrometheusRule, and returns which of the overrides matched at least one alert.
+// The overrides are matched against the original severities of the alerts, before the severity mapping.
+func apply(rule *promv1.PrometheusRule, alerting *hcov1.AlertingConfig, overrides []hcov1.AlertOverride) ([]bool, error) {
+	matched := make([]bool, len(overrides))
+	if alerting == nil && len(overrides) == 0 {
+		return matched, nil
+	}
+
+	for g := range rule.Spec.Groups {
+		for r := range rule.Spec.Groups[g].Rules {
+			alert := &rule.Spec.Groups[g].Rules[r]
+			if alert.Alert == "" {
+				continue
+			}
+
+			severity := alert.Labels[severityLabel]
+			applyAlertingConfig(alert, alerting)
+
+			for i, override := range overrides {
+				if !overrideMatches(override, alert.Alert, severity) {
+					continue
+				}
+
+				if err := applyOverride(alert, override); err != nil {
+					return nil, fmt.Errorf("failed to apply the alert override for %s: %w", override.Alert, err)
+				}
+				matched[i] = true
+			}
+		}
+	}
+
+	return matched, nil
+}
+
+func overrideMatches(override hcov1.AlertOverride, alertName, severity string) bool {
+	if override.Alert != alertName {
+		return false
+	}
+
+	return override.MatchSeverity == "" || string(override.MatchSeverity) == severity
+}
+
+func applyAlertingConfig(alert *promv1.Rule, alerting *hcov1.AlertingConfig) {
+	if alerting == nil {
+		return
+	}
+
+	if alerting.RunbookBaseURL != "" {
+		// only replace the runbooks that are located by the alert name; e.g. keep the links to specific documents
+		if runbookURL, ok := alert.Annotations[runbookURLAnnotation]; ok && strings.HasSuffix(runbookURL, "/"+alert.Alert) {
+			annotations := maps.Clone(alert.Annotations)
+			annotations[runbookURLAnnotation] = strings.TrimSuffix(alerting.RunbookBaseURL, "/") + "/" + alert.Alert
+			alert.Annotations = annotations
+		}
+	}
+
+	if len(alerting.AdditionalLabels) > 0 || len(alerting.SeverityMapping) > 0 {
+		labels := maps.Clone(alert.Labels)
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		maps.Copy(labels, alerting.AdditionalLabels)
+
+		for _, mapping := range alerting.SeverityMapping {
+			if severity, ok := labels[severityLabel]; ok && severity == string(mapping.From) {
+				labels[severityLabel] = string(mapping.To)
+				break
+			}
+		}
+		alert.Labels = labels
+	}
+}
+
+func applyOverride(alert *promv1.Rule, override hcov1.AlertOverride) error {
+	if override.Threshold != "" {
+		expr := alert.Expr.String()
+		if !thresholdRegex.MatchString(expr) {
+			return errors.New("the alert expression does not end with a comparison to a number, so its threshold can't be modified")
+		}
+		alert.Expr = intstr.FromString(thresholdRegex.ReplaceAllString(expr, "${1}"+override.Threshold+"${2}"))
+	}
+
+	if override.For != nil {
+		alert.For = new(toPrometheusDuration(*override.For))
+	}
+
+	if len(override.Labels) > 0 || override.Severity != "" {
+		labels := maps.Clone(alert.Labels)
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		maps.Copy(labels, override.Labels)
+		if override.Severity != "" {
+			labels[severityLabel] = string(override.Severity)
+		}
+		alert.Labels = labels
+	}
+
+	if len(override.Annotations) > 0 {
+		annotations := maps.Clone(alert.Annotations)
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		maps.Copy(annotations, override.Annotations)
+		alert.Annotations = annotations
+	}
+
+	return nil
+}
+
+func toPrometheusDuration(d metav1.Duration) promv1.Duration {
+	return promv1.Duration(model.Duration(d.Duration).String())
+}
+
+// buildKnownRules builds the PrometheusRules of HCO and of the observability controller, without the overrides
+func buildKnownRules() ([]*promv1.PrometheusRule, error) {
+	if err := hcorules.SetupRules(); err != nil {
+		return nil, err
+	}
+
+	hcoRule, err := hcorules.BuildPrometheusRule("", metav1.OwnerReference{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = observabilityrules.SetupRules(); err != nil {
+		return nil, err
+	}
+
+	observabilityRule, err := observabilityrules.BuildPrometheusRule("", metav1.OwnerReference{})
+	if err != nil {
+		return nil, err
+	}
+
+	return []*promv1.PrometheusRule{hcoRule, observabilityRule}, nil
+}
@@ -0,0 +1,242 @@
+package alertoverrides
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+)
+
+func TestAlertOverrides(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Alert Overrides Suite")
+}
+
+var _ = Describe("Alert overrides", func() {
+	var rule *promv1.PrometheusRule
+
+	BeforeEach(func() {
+		rule = &promv1.PrometheusRule{
+			Spec: promv1.PrometheusRuleSpec{
+				Groups: []promv1.RuleGroup{
+					{
+						Name: "alerts",
+						Rules: []promv1.Rule{
+							{
+								Alert:       "HighCPUWorkload",
+								Expr:        intstr.FromString("instance:node_cpu_utilisation:rate1m >= 0.9"),
+								For:         new(promv1.Duration("5m")),
+								Labels:      map[string]string{"severity": "warning", "operator_health_impact": "none"},
+								Annotations: map[string]string{"summary": "High CPU usage"},
+							},
+							{
+								Alert:  "HCOOperatorConditionsUnhealthy",
+								Expr:   intstr.FromString("kubevirt_hco_system_health_status == 1"),
+								Labels: map[string]string{"severity": "warning"},
+							},
+							{
+								Alert:  "HCOOperatorConditionsUnhealthy",
+								Expr:   intstr.FromString("kubevirt_hco_system_health_status == 2"),
+								Labels: map[string]string{"severity": "critical"},
+							},
+							{
+								Record: "kubevirt_hco_system_health_status:max",
+								Expr:   intstr.FromString("max(kubevirt_hco_system_health_status)"),
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Context("Apply", func() {
+		It("should modify the alert", func() {
+			Expect(Apply(rule, &hcov1.ObservabilityConfig{AlertOverrides: []hcov1.AlertOverride{
+				{
+					Alert:       "HighCPUWorkload",
+					Threshold:   "0.75",
+					For:         &metav1.Duration{Duration: 15 * time.Minute},
+					Severity:    hcov1.AlertSeverityCritical,
+					Labels:      map[string]string{"team": "sre"},
+					Annotations: map[string]string{"runbook_url": "https://runbooks.example.com/HighCPUWorkload"},
+				},
+			}})).To(Succeed())
+
+			alert := rule.Spec.Groups[0].Rules[0]
+			Expect(alert.Expr.String()).To(Equal("instance:node_cpu_utilisation:rate1m >= 0.75"))
+			Expect(alert.For).To(HaveValue(Equal(promv1.Duration("15m"))))
+			Expect(alert.Labels).To(Equal(map[string]string{"severity": "critical", "operator_health_impact": "none", "team": "sre"}))
+			Expect(alert.Annotations).To(Equal(map[string]string{
+				"summary":     "High CPU usage",
+				"runbook_url": "https://runbooks.example.com/HighCPUWorkload",
+			}))
+		})
+
+		It("should modify all the rules of the alert, if matchSeverity is not set", func() {
+			Expect(Apply(rule, &hcov1.ObservabilityConfig{AlertOverrides: []hcov1.AlertOverride{
+				{Alert: "HCOOperatorConditionsUnhealthy", Labels: map[string]string{"team": "sre"}},
+			}})).To(Succeed())
+
+			Expect(rule.Spec.Groups[0].Rules[1].Labels).To(HaveKeyWithValue("team", "sre"))
+			Expect(rule.Spec.Groups[0].Rules[2].Labels).To(HaveKeyWithValue("team", "sre"))
+			Expect(rule.Spec.Groups[0].Rules[0].Labels).ToNot(HaveKey("team"))
+		})
+
+		It("should only modify the rule with the matching severity", func() {
+			Expect(Apply(rule, &hcov1.ObservabilityConfig{AlertOverrides: []hcov1.AlertOverride{
+				{Alert: "HCOOperatorConditionsUnhealthy", MatchSeverity: hcov1.AlertSeverityWarning, Severity: hcov1.AlertSeverityInfo},
+			}})).To(Succeed())
+
+			Expect(rule.Spec.Groups[0].Rules[1].Labels).To(HaveKeyWithValue("severity", "info"))
+			Expect(rule.Spec.Groups[0].Rules[2].Labels).To(HaveKeyWithValue("severity", "critical"))
+		})
+
+		It("should ignore the overrides of alerts that are not in the PrometheusRule", func() {
+			orig := rule.DeepCopy()
+			Expect(Apply(rule, &hcov1.ObservabilityConfig{AlertOverrides: []hcov1.AlertOverride{
+				{Alert: "VMNonRecoverableOSPanic", Severity: hcov1.AlertSeverityCritical},
+			}})).To(Succeed())
+			Expect(rule).To(Equal(orig))
+		})
+
+		It("should fail to modify the threshold of an alert that has no threshold", func() {
+			rule.Spec.Groups[0].Rules[0].Expr = intstr.FromString("absent(up)")
+			Expect(Apply(rule, &hcov1.ObservabilityConfig{AlertOverrides: []hcov1.AlertOverride{
+				{Alert: "HighCPUWorkload", Threshold: "0.5"},
+			}})).To(MatchError(ContainSubstring("its threshold can't be modified")))
+		})
+	})
+
+	Context("Apply the alerting configuration", func() {
+		BeforeEach(func() {
+			rule.Spec.Groups[0].Rules[0].Annotations["runbook_url"] = "https://kubevirt.io/monitoring/runbooks/HighCPUWorkload"
+			rule.Spec.Groups[0].Rules[1].Annotations = map[string]string{"runbook_url": "https://docs.example.com/runbooks/HCOOperatorConditionsUnhealthy.md"}
+		})
+
+		It("should replace the runbook URLs that are located by the alert name", func() {
+			Expect(Apply(rule, &hcov1.ObservabilityConfig{
+				Alerting: &hcov1.AlertingConfig{RunbookBaseURL: "https://runbooks.example.com/kubevirt/"},
+			})).To(Succeed())
+
+			Expect(rule.Spec.Groups[0].Rules[0].Annotations).To(Equal(map[string]string{
+				"summary":     "High CPU usage",
+				"runbook_url": "https://runbooks.example.com/kubevirt/HighCPUWorkload",
+			}))
+			Expect(rule.Spec.Groups[0].Rules[1].Annotations).To(HaveKeyWithValue("runbook_url", "https://docs.example.com/runbooks/HCOOperatorConditionsUnhealthy.md"))
+			Expect(rule.Spec.Groups[0].Rules[2].Annotations).ToNot(HaveKey("runbook_url"))
+		})
+
+		It("should add the additional labels to all the alerts", func() {
+			Expect(Apply(rule, &hcov1.ObservabilityConfig{
+				Alerting: &hcov1.AlertingConfig{
+					AdditionalLabels: map[string]string{"team": "virt", "operator_health_impact": "warning"},
+				},
+			})).To(Succeed())
+
+			Expect(rule.Spec.Groups[0].Rules[0].Labels).To(Equal(map[string]string{"severity": "warning", "operator_health_impact": "warning", "team": "virt"}))
+			Expect(rule.Spec.Groups[0].Rules[1].Labels).To(HaveKeyWithValue("team", "virt"))
+			Expect(rule.Spec.Groups[0].Rules[2].Labels).To(HaveKeyWithValue("team", "virt"))
+			Expect(rule.Spec.Groups[0].Rules[3].Labels).To(BeEmpty())
+		})
+
+		It("should map the severities of the alerts", func() {
+			Expect(Apply(rule, &hcov1.ObservabilityConfig{
+				Alerting: &hcov1.AlertingConfig{
+					SeverityMapping: []hcov1.AlertSeverityMapping{
+						{From: hcov1.AlertSeverityCritical, To: hcov1.AlertSeverityWarning},
+						{From: hcov1.AlertSeverityWarning, To: hcov1.AlertSeverityInfo},
+					},
+				},
+			})).To(Succeed())
+
+			Expect(rule.Spec.Groups[0].Rules[0].Labels).To(HaveKeyWithValue("severity", "info"))
+			Expect(rule.Spec.Groups[0].Rules[1].Labels).To(HaveKeyWithValue("severity", "info"))
+			Expect(rule.Spec.Groups[0].Rules[2].Labels).To(HaveKeyWithValue("severity", "warning"))
+		})
+
+		It("should apply the alert overrides on top of the alerting configuration", func() {
+			Expect(Apply(rule, &hcov1.ObservabilityConfig{
+				Alerting: &hcov1.AlertingConfig{
+					AdditionalLabels: map[string]string{"team": "virt"},
+					SeverityMapping: []hcov1.AlertSeverityMapping{
+						{From: hcov1.AlertSeverityCritical, To: hcov1.AlertSeverityInfo},
+					},
+				},
+				AlertOverrides: []hcov1.AlertOverride{
+					{
+						Alert:         "HCOOperatorConditionsUnhealthy",
+						MatchSeverity: hcov1.AlertSeverityCritical,
+						Severity:      hcov1.AlertSeverityWarning,
+						Labels:        map[string]string{"team": "sre"},
+					},
+				},
+			})).To(Succeed())
+
+			Expect(rule.Spec.Groups[0].Rules[1].Labels).To(Equal(map[string]string{"severity": "warning", "team": "virt"}))
+			Expect(rule.Spec.Groups[0].Rules[2].Labels).To(Equal(map[string]string{"severity": "warning", "team": "sre"}))
+		})
+	})
+
+	Context("Validate", func() {
+		It("should accept overrides of the HCO and of the observability alerts", func() {
+			Expect(Validate(&hcov1.ObservabilityConfig{AlertOverrides: []hcov1.AlertOverride{
+				{Alert: "HCOOperatorConditionsUnhealthy", MatchSeverity: hcov1.AlertSeverityCritical, Labels: map[string]string{"team": "sre"}},
+				{Alert: "HighCPUWorkload", Threshold: "0.8"},
+			}})).To(Succeed())
+		})
+
+		DescribeTable("should reject invalid overrides", func(override hcov1.AlertOverride, reason string) {
+			Expect(Validate(&hcov1.ObservabilityConfig{AlertOverrides: []hcov1.AlertOverride{override}})).To(MatchError(ContainSubstring(reason)))
+		},
+			Entry("unknown alert", hcov1.AlertOverride{Alert: "NotAnAlert"}, "invalid alert override for NotAnAlert: unknown alert"),
+			Entry("no rule with the severity", hcov1.AlertOverride{Alert: "HighCPUWorkload", MatchSeverity: hcov1.AlertSeverityInfo},
+				"no rule with the info severity"),
+			Entry("severity label", hcov1.AlertOverride{Alert: "HighCPUWorkload", Labels: map[string]string{"severity": "info"}},
+				"use the severity field"),
+			Entry("invalid label name", hcov1.AlertOverride{Alert: "HighCPUWorkload", Labels: map[string]string{"team-name": "sre"}},
+				`invalid label name "team-name"`),
+			Entry("negative for", hcov1.AlertOverride{Alert: "HighCPUWorkload", For: &metav1.Duration{Duration: -time.Minute}},
+				"for must not be negative"),
+			Entry("threshold of an alert without a threshold", hcov1.AlertOverride{Alert: "DeprecatedMachineType", Threshold: "1"},
+				"its threshold can't be modified"),
+		)
+
+		DescribeTable("should reject invalid alerting configurations", func(alerting *hcov1.AlertingConfig, reason string) {
+			Expect(Validate(&hcov1.ObservabilityConfig{Alerting: alerting})).To(MatchError(ContainSubstring(reason)))
+		},
+			Entry("relative runbook URL", &hcov1.AlertingConfig{RunbookBaseURL: "runbooks/"}, "must be an absolute http or https URL"),
+			Entry("runbook URL without a host", &hcov1.AlertingConfig{RunbookBaseURL: "https:///runbooks/"}, "must be an absolute http or https URL"),
+			Entry("severity label", &hcov1.AlertingConfig{AdditionalLabels: map[string]string{"severity": "info"}}, "use the severityMapping field"),
+			Entry("invalid label name", &hcov1.AlertingConfig{AdditionalLabels: map[string]string{"team-name": "virt"}}, `invalid label name "team-name"`),
+		)
+
+		It("should accept a valid alerting configuration", func() {
+			Expect(Validate(&hcov1.ObservabilityConfig{
+				Alerting: &hcov1.AlertingConfig{
+					RunbookBaseURL:   "http://runbooks.example.com:8080/kubevirt",
+					AdditionalLabels: map[string]string{"team": "virt"},
+					SeverityMapping:  []hcov1.AlertSeverityMapping{{From: hcov1.AlertSeverityCritical, To: hcov1.AlertSeverityWarning}},
+				},
+			})).To(Succeed())
+		})
+
+		It("should match the overrides against the original severities", func() {
+			Expect(Validate(&hcov1.ObservabilityConfig{
+				Alerting: &hcov1.AlertingConfig{
+					SeverityMapping: []hcov1.AlertSeverityMapping{{From: hcov1.AlertSeverityCritical, To: hcov1.AlertSeverityWarning}},
+				},
+				AlertOverrides: []hcov1.AlertOverride{
+					{Alert: "HCOOperatorConditionsUnhealthy", MatchSeverity: hcov1.AlertSeverityCritical, Severity: hcov1.AlertSeverityInfo},
+				},
+			})).To(Succeed())
+		})
+	})
+})
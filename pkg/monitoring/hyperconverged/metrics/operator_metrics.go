@@ -2,7 +2,9 @@ package metrics
 
 import (
 	"strings"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	ioprometheusclient "github.com/prometheus/client_model/go"
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 )
@@ -32,6 +34,28 @@ const (
 	hasNoArchitectureAnnotation = float64(0)
 )
 
+const (
+	counterLabelCheckName = "check_name"
+
+	preflightCheckFailed = float64(1)
+	preflightCheckPassed = float64(0)
+)
+
+const (
+	counterLabelFGName  = "feature_gate"
+	counterLabelFGPhase = "phase"
+
+	featureGatePolicyViolated = float64(1)
+)
+
+const (
+	counterLabelOperand = "operand"
+)
+
+const (
+	counterLabelComponent = "component"
+)
+
 var (
 	operatorMetrics = []operatormetrics.Metric{
 		overwrittenModifications,
@@ -41,6 +65,15 @@ var (
 		dictWithSupportedArchitectures,
 		dictWithArchitectureAnnotation,
 		memoryOvercommitPercentage,
+		upgradePreflightCheckFailed,
+		featureGatePolicyViolation,
+		operandReconcileDuration,
+		kubevirtClientQPS,
+		kubevirtClientBurst,
+		recommendedKubevirtClientQPS,
+		recommendedKubevirtClientBurst,
+		recommendedParallelMigrationsPerCluster,
+		recommendedParallelOutboundMigrationsPerNode,
 	}
 
 	overwrittenModifications = operatormetrics.NewCounterVec(
@@ -95,6 +128,79 @@ var (
 			Help: "Indicates the cluster-wide configured VM memory overcommit percentage",
 		},
 	)
+
+	upgradePreflightCheckFailed = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_upgrade_preflight_check_failed",
+			Help: "Indicates whether the upgrade pre-flight check failed and blocks the upgrade (1) or not (0)",
+		},
+		[]string{counterLabelCheckName},
+	)
+
+	featureGatePolicyViolation = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_feature_gate_policy_violation",
+			Help: "Indicates that a feature gate that is denied by the feature gates policy, is enabled in the HyperConverged resource (1)",
+		},
+		[]string{counterLabelFGName, counterLabelFGPhase},
+	)
+
+	operandReconcileDuration = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_operand_reconcile_duration_seconds",
+			Help: "The duration of the reconciliation of a single operand by HCO, in seconds",
+		},
+		prometheus.HistogramOpts{
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{counterLabelOperand},
+	)
+
+	kubevirtClientQPS = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_kubevirt_client_qps",
+			Help: "The client queries per second rate limit that HCO sets for the KubeVirt component, according to the tuning policy",
+		},
+		[]string{counterLabelComponent},
+	)
+
+	kubevirtClientBurst = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_kubevirt_client_burst",
+			Help: "The client burst rate limit that HCO sets for the KubeVirt component, according to the tuning policy",
+		},
+		[]string{counterLabelComponent},
+	)
+
+	recommendedKubevirtClientQPS = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_recommended_kubevirt_client_qps",
+			Help: "The client queries per second rate limit that HCO recommends for the KubeVirt component, according to the cluster size",
+		},
+		[]string{counterLabelComponent},
+	)
+
+	recommendedKubevirtClientBurst = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_recommended_kubevirt_client_burst",
+			Help: "The client burst rate limit that HCO recommends for the KubeVirt component, according to the cluster size",
+		},
+		[]string{counterLabelComponent},
+	)
+
+	recommendedParallelMigrationsPerCluster = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_recommended_parallel_migrations_per_cluster",
+			Help: "The number of parallel live migrations in the cluster, that HCO recommends according to the cluster size",
+		},
+	)
+
+	recommendedParallelOutboundMigrationsPerNode = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_recommended_parallel_outbound_migrations_per_node",
+			Help: "The number of parallel outbound live migrations from a node, that HCO recommends according to the cluster size",
+		},
+	)
 )
 
 // IncOverwrittenModifications increments counter by 1
@@ -233,6 +339,144 @@ func IsDICTWithArchitectureAnnotation(dictName, dsName string) (bool, error) {
 	return value == hasArchitectureAnnotation, nil
 }
 
+// SetUpgradePreflightCheckFailed sets the gauge to 1 if the upgrade pre-flight check failed, or to 0 if it passed
+func SetUpgradePreflightCheckFailed(checkName string, failed bool) {
+	value := preflightCheckPassed
+	if failed {
+		value = preflightCheckFailed
+	}
+	upgradePreflightCheckFailed.WithLabelValues(checkName).Set(value)
+}
+
+// IsUpgradePreflightCheckFailed returns true if the upgrade pre-flight check failed. If error is not nil then value is
+// undefined
+func IsUpgradePreflightCheckFailed(checkName string) (bool, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := upgradePreflightCheckFailed.WithLabelValues(checkName).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return false, err
+	}
+
+	return value == preflightCheckFailed, nil
+}
+
+// SetFeatureGatePolicyViolation sets the gauge to 1 for a feature gate that is denied by the feature gates policy
+func SetFeatureGatePolicyViolation(fgName, phase string) {
+	featureGatePolicyViolation.WithLabelValues(fgName, phase).Set(featureGatePolicyViolated)
+}
+
+// ResetFeatureGatePolicyViolations removes all the feature gate policy violations
+func ResetFeatureGatePolicyViolations() {
+	featureGatePolicyViolation.Reset()
+}
+
+// IsFeatureGatePolicyViolated returns true if the feature gate is denied by the feature gates policy. If error is not
+// nil then value is undefined
+func IsFeatureGatePolicyViolated(fgName, phase string) (bool, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := featureGatePolicyViolation.WithLabelValues(fgName, phase).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return false, err
+	}
+
+	return value == featureGatePolicyViolated, nil
+}
+
+// ObserveOperandReconcileDuration records the duration of a single reconciliation of the operand
+func ObserveOperandReconcileDuration(operand string, duration time.Duration) {
+	operandReconcileDuration.WithLabelValues(operand).Observe(duration.Seconds())
+}
+
+// GetOperandReconcileCount returns the number of the recorded reconciliations of the operand. If error is not nil then
+// value is undefined
+func GetOperandReconcileCount(operand string) (uint64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := operandReconcileDuration.WithLabelValues(operand).(prometheus.Histogram).Write(dto)
+	value := dto.Histogram.GetSampleCount()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return value, nil
+}
+
+// SetKubeVirtClientRateLimit sets the effective client rate limits of the KubeVirt component
+func SetKubeVirtClientRateLimit(component string, qps, burst float64) {
+	kubevirtClientQPS.WithLabelValues(component).Set(qps)
+	kubevirtClientBurst.WithLabelValues(component).Set(burst)
+}
+
+// ResetKubeVirtClientRateLimits removes the effective client rate limits of all the KubeVirt components
+func ResetKubeVirtClientRateLimits() {
+	kubevirtClientQPS.Reset()
+	kubevirtClientBurst.Reset()
+}
+
+// GetKubeVirtClientRateLimit returns the effective client rate limits of the KubeVirt component. If error is not nil
+// then the values are undefined
+func GetKubeVirtClientRateLimit(component string) (float64, float64, error) {
+	qpsDto := &ioprometheusclient.Metric{}
+	if err := kubevirtClientQPS.WithLabelValues(component).Write(qpsDto); err != nil {
+		return 0, 0, err
+	}
+
+	burstDto := &ioprometheusclient.Metric{}
+	if err := kubevirtClientBurst.WithLabelValues(component).Write(burstDto); err != nil {
+		return 0, 0, err
+	}
+
+	return qpsDto.Gauge.GetValue(), burstDto.Gauge.GetValue(), nil
+}
+
+// SetRecommendedKubeVirtClientRateLimit sets the recommended client rate limits of the KubeVirt component
+func SetRecommendedKubeVirtClientRateLimit(component string, qps, burst float64) {
+	recommendedKubevirtClientQPS.WithLabelValues(component).Set(qps)
+	recommendedKubevirtClientBurst.WithLabelValues(component).Set(burst)
+}
+
+// GetRecommendedKubeVirtClientRateLimit returns the recommended client rate limits of the KubeVirt component. If
+// error is not nil then the values are undefined
+func GetRecommendedKubeVirtClientRateLimit(component string) (float64, float64, error) {
+	qpsDto := &ioprometheusclient.Metric{}
+	if err := recommendedKubevirtClientQPS.WithLabelValues(component).Write(qpsDto); err != nil {
+		return 0, 0, err
+	}
+
+	burstDto := &ioprometheusclient.Metric{}
+	if err := recommendedKubevirtClientBurst.WithLabelValues(component).Write(burstDto); err != nil {
+		return 0, 0, err
+	}
+
+	return qpsDto.Gauge.GetValue(), burstDto.Gauge.GetValue(), nil
+}
+
+// SetRecommendedMigrationParallelism sets the recommended live migration parallelism
+func SetRecommendedMigrationParallelism(perCluster, perNode float64) {
+	recommendedParallelMigrationsPerCluster.Set(perCluster)
+	recommendedParallelOutboundMigrationsPerNode.Set(perNode)
+}
+
+// GetRecommendedMigrationParallelism returns the recommended live migration parallelism. If error is not nil then the
+// values are undefined
+func GetRecommendedMigrationParallelism() (float64, float64, error) {
+	perClusterDto := &ioprometheusclient.Metric{}
+	if err := recommendedParallelMigrationsPerCluster.Write(perClusterDto); err != nil {
+		return 0, 0, err
+	}
+
+	perNodeDto := &ioprometheusclient.Metric{}
+	if err := recommendedParallelOutboundMigrationsPerNode.Write(perNodeDto); err != nil {
+		return 0, 0, err
+	}
+
+	return perClusterDto.Gauge.GetValue(), perNodeDto.Gauge.GetValue(), nil
+}
+
 func getLabelsForObj(kind string, name string) string {
 	return strings.ToLower(kind + "/" + name)
 }
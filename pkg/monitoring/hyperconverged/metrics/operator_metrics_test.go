@@ -1,6 +1,8 @@
 package metrics_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -21,4 +23,41 @@ var _ = Describe("Operator Metrics", func() {
 			Expect(v).To(Equal(metrics.SystemHealthStatusWarning))
 		})
 	})
+
+	Context("kubevirt_hco_upgrade_preflight_check_failed", func() {
+		It("should set the pre-flight check result per check", func() {
+			metrics.SetUpgradePreflightCheckFailed("Check1", true)
+			metrics.SetUpgradePreflightCheckFailed("Check2", false)
+
+			Expect(metrics.IsUpgradePreflightCheckFailed("Check1")).To(BeTrue())
+			Expect(metrics.IsUpgradePreflightCheckFailed("Check2")).To(BeFalse())
+
+			metrics.SetUpgradePreflightCheckFailed("Check1", false)
+			Expect(metrics.IsUpgradePreflightCheckFailed("Check1")).To(BeFalse())
+		})
+	})
+
+	Context("kubevirt_hco_feature_gate_policy_violation", func() {
+		It("should set and reset the feature gate policy violations", func() {
+			metrics.SetFeatureGatePolicyViolation("fg1", "alpha")
+
+			Expect(metrics.IsFeatureGatePolicyViolated("fg1", "alpha")).To(BeTrue())
+			Expect(metrics.IsFeatureGatePolicyViolated("fg2", "deprecated")).To(BeFalse())
+
+			metrics.ResetFeatureGatePolicyViolations()
+			Expect(metrics.IsFeatureGatePolicyViolated("fg1", "alpha")).To(BeFalse())
+		})
+	})
+
+	Context("kubevirt_hco_operand_reconcile_duration_seconds", func() {
+		It("should record the reconciliations of the operand", func() {
+			before, err := metrics.GetOperandReconcileCount("kubevirt")
+			Expect(err).ToNot(HaveOccurred())
+
+			metrics.ObserveOperandReconcileDuration("kubevirt", 200*time.Millisecond)
+			metrics.ObserveOperandReconcileDuration("kubevirt", time.Second)
+
+			Expect(metrics.GetOperandReconcileCount("kubevirt")).To(Equal(before + 2))
+		})
+	})
 })
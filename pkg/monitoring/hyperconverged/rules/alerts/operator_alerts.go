@@ -22,6 +22,7 @@ const (
 	unsupportedArchitecturesAlert    = "HCOGoldenImageWithNoSupportedArchitecture"
 	dictWithNoArchAnnotationAlert    = "HCOGoldenImageWithNoArchitectureAnnotation"
 	multiArchBootImagesDisabledAlert = "HCOMultiArchGoldenImagesDisabled"
+	featureGatePolicyViolationAlert  = "HCOFeatureGatePolicyViolation"
 
 	severityAlertLabelKey     = "severity"
 	healthImpactAlertLabelKey = "operator_health_impact"
@@ -114,6 +115,18 @@ func operatorAlerts() []promv1.Rule {
 				healthImpactAlertLabelKey: "none",
 			},
 		},
+		{
+			Alert: featureGatePolicyViolationAlert,
+			Expr:  intstr.FromString("kubevirt_hco_feature_gate_policy_violation == 1"),
+			Annotations: map[string]string{
+				"description": "The {{ $labels.feature_gate }} feature gate is {{ $labels.phase }}, and it is not allowed by the feature gates policy in the HyperConverged resource, but it is still enabled.",
+				"summary":     "A feature gate that is not allowed by the feature gates policy is enabled.",
+			},
+			Labels: map[string]string{
+				severityAlertLabelKey:     "warning",
+				healthImpactAlertLabelKey: "none",
+			},
+		},
 		{
 			Alert: "DeprecatedMachineType",
 			Expr: intstr.FromString(withVMLabel(`
@@ -24,6 +24,10 @@ var (
 	IsControlPlaneMultiNode         = internal.IsControlPlaneMultiNode
 	IsControlPlaneNodeExists        = internal.IsControlPlaneNodeExists
 	IsInfrastructureHighlyAvailable = internal.IsInfrastructureHighlyAvailable
+	IsInfrastructureMultiZone       = internal.IsInfrastructureMultiZone
+	IsWorkloadsMultiNode            = internal.IsWorkloadsMultiNode
+
+	GetWorkloadNodeCount = internal.GetWorkloadNodeCount
 
 	GetControlPlaneArchitectures = internal.GetControlPlaneArchitectures
 	GetWorkloadsArchitectures    = internal.GetWorkloadsArchitectures
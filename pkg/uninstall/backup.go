@@ -0,0 +1,286 @@
+package uninstall
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// WorkloadsBackupLabel is set on all the workloads backup objects. Its value is the UID of the removed
+	// HyperConverged CR.
+	WorkloadsBackupLabel = "hco.kubevirt.io/workloads-backup"
+
+	// BackupIndexName is the name of the backup index. The index lists the names of the backup bundles. It is written
+	// after all the bundles, so its existence means that the backup is completed.
+	BackupIndexName = "kubevirt-hyperconverged-workloads-backup"
+
+	// BackupIndexKey is the key of the bundle names list in the backup index
+	BackupIndexKey = "bundles"
+
+	// ConfigMaps and Secrets are limited to 1MiB; keep some space for the metadata
+	maxBundleSize = 900 * 1024
+
+	// compressedManifestSuffix is the key suffix of a manifest that is too large for a bundle, and so it is stored
+	// gzip-compressed
+	compressedManifestSuffix = ".gz"
+)
+
+var (
+	dataVolumeGroupVersionKind     = cdiv1beta1.SchemeGroupVersion.WithKind("DataVolume")
+	dataImportCronGroupVersionKind = cdiv1beta1.SchemeGroupVersion.WithKind("DataImportCron")
+
+	// the metadata fields that are only meaningful for the existing object, and must not be restored
+	runtimeMetadataFields = []string{
+		"uid",
+		"resourceVersion",
+		"generation",
+		"creationTimestamp",
+		"deletionTimestamp",
+		"deletionGracePeriodSeconds",
+		"managedFields",
+		"ownerReferences",
+		"finalizers",
+		"selfLink",
+	}
+)
+
+// IsBackupEnabled returns true if the workloads should be backed up before removing them on uninstall
+func IsBackupEnabled(hc *hcov1.HyperConverged) bool {
+	return hc.Spec.Deployment.UninstallStrategy == hcov1.HyperConvergedUninstallStrategyRemoveWorkloads &&
+		hc.Spec.Deployment.UninstallBackup != nil &&
+		ptr.Deref(hc.Spec.Deployment.UninstallBackup.Enable, false)
+}
+
+// BackupWorkloads stores the manifests of the workloads in backup bundles, in the HyperConverged namespace. Each
+// bundle holds the manifests of a single namespace; a namespace with many workloads is split to several bundles.
+//
+// The backup is done only once for each HyperConverged CR, because the workloads are removed right after it. If the
+// backup index of the HyperConverged CR already exists, BackupWorkloads does nothing, and returns false.
+func BackupWorkloads(ctx context.Context, cli client.Client, reader client.Reader, hc *hcov1.HyperConverged) (bool, error) {
+	kind := getStorageKind(hc)
+
+	index := newBackupObject(kind, BackupIndexName, hc, nil)
+	err := reader.Get(ctx, client.ObjectKeyFromObject(index), index)
+	if err == nil && index.GetLabels()[WorkloadsBackupLabel] == string(hc.UID) {
+		return false, nil
+	} else if err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+
+	workloads, err := ListWorkloads(ctx, reader, hc.Spec.WorkloadSources.CommonBootImageNamespace)
+	if err != nil {
+		return false, err
+	}
+
+	bundles, err := buildBundles(workloads)
+	if err != nil {
+		return false, err
+	}
+
+	bundleNames := make([]string, 0, len(bundles))
+	for _, b := range bundles {
+		if err = createOrUpdate(ctx, cli, reader, newBackupObject(kind, b.name, hc, b.data)); err != nil {
+			return false, fmt.Errorf("failed to store the %s workloads backup bundle; %w", b.name, err)
+		}
+		bundleNames = append(bundleNames, b.name)
+	}
+
+	index = newBackupObject(kind, BackupIndexName, hc, map[string][]byte{BackupIndexKey: []byte(strings.Join(bundleNames, "\n"))})
+	if err = createOrUpdate(ctx, cli, reader, index); err != nil {
+		return false, fmt.Errorf("failed to store the workloads backup index; %w", err)
+	}
+
+	return true, nil
+}
+
+type bundle struct {
+	name string
+	data map[string][]byte
+	size int
+}
+
+func buildBundles(workloads []NamespaceWorkloads) ([]bundle, error) {
+	var bundles []bundle
+	for _, wl := range workloads {
+		first := len(bundles)
+		add := func(kind string, name string, manifest []byte) error {
+			key := fmt.Sprintf("%s.%s.yaml", strings.ToLower(kind), name)
+			size := len(key) + len(manifest)
+			if size > maxBundleSize {
+				var err error
+				if manifest, err = compressManifest(manifest); err != nil {
+					return fmt.Errorf("failed to compress the manifest of the %s %s/%s; %w", kind, wl.Namespace, name, err)
+				}
+				key += compressedManifestSuffix
+				size = len(key) + len(manifest)
+				if size > maxBundleSize {
+					return fmt.Errorf("the manifest of the %s %s/%s is too large to be backed up, even when compressed (%d bytes, the limit is %d bytes); back it up manually and remove it, or disable the workloads backup",
+						kind, wl.Namespace, name, size, maxBundleSize)
+				}
+			}
+
+			if len(bundles) == first || bundles[len(bundles)-1].size+size > maxBundleSize {
+				bundles = append(bundles, bundle{
+					name: getBundleName(wl.Namespace, len(bundles)-first),
+					data: make(map[string][]byte),
+				})
+			}
+
+			current := &bundles[len(bundles)-1]
+			current.data[key] = manifest
+			current.size += size
+
+			return nil
+		}
+
+		for i := range wl.VirtualMachines {
+			manifest, err := toManifest(&wl.VirtualMachines[i], kubevirtcorev1.VirtualMachineGroupVersionKind)
+			if err != nil {
+				return nil, err
+			}
+			if err = add("VirtualMachine", wl.VirtualMachines[i].Name, manifest); err != nil {
+				return nil, err
+			}
+		}
+
+		for i := range wl.DataVolumes {
+			manifest, err := toManifest(&wl.DataVolumes[i], dataVolumeGroupVersionKind)
+			if err != nil {
+				return nil, err
+			}
+			if err = add("DataVolume", wl.DataVolumes[i].Name, manifest); err != nil {
+				return nil, err
+			}
+		}
+
+		for i := range wl.DataImportCrons {
+			manifest, err := toManifest(&wl.DataImportCrons[i], dataImportCronGroupVersionKind)
+			if err != nil {
+				return nil, err
+			}
+			if err = add("DataImportCron", wl.DataImportCrons[i].Name, manifest); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return bundles, nil
+}
+
+// compressManifest gzip-compresses a manifest that is too large to be stored as is
+func compressManifest(manifest []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	zw := gzip.NewWriter(buf)
+	if _, err := zw.Write(manifest); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// getBundleName returns the name of the i-th bundle of the namespace. The bundle number is always the last part of
+// the name, so the names of different namespaces do not collide.
+func getBundleName(namespace string, i int) string {
+	return BackupIndexName + "-" + namespace + "-" + strconv.Itoa(i)
+}
+
+// toManifest returns the manifest of the object, as YAML, without its status and without the metadata fields that
+// can't be restored
+func toManifest(obj runtime.Object, gvk schema.GroupVersionKind) ([]byte, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	u["apiVersion"] = gvk.GroupVersion().String()
+	u["kind"] = gvk.Kind
+	delete(u, "status")
+	for _, field := range runtimeMetadataFields {
+		unstructured.RemoveNestedField(u, "metadata", field)
+	}
+
+	return yaml.Marshal(u)
+}
+
+func getStorageKind(hc *hcov1.HyperConverged) hcov1.UninstallBackupStorageKind {
+	if hc.Spec.Deployment.UninstallBackup != nil && hc.Spec.Deployment.UninstallBackup.StorageKind == hcov1.UninstallBackupStorageKindConfigMap {
+		return hcov1.UninstallBackupStorageKindConfigMap
+	}
+	return hcov1.UninstallBackupStorageKindSecret
+}
+
+func newBackupObject(kind hcov1.UninstallBackupStorageKind, name string, hc *hcov1.HyperConverged, data map[string][]byte) client.Object {
+	labels := hcoutil.GetLabels(hc.Name, hcoutil.AppComponentDeployment)
+	labels[WorkloadsBackupLabel] = string(hc.UID)
+
+	objectMeta := metav1.ObjectMeta{
+		Name:      name,
+		Namespace: hc.Namespace,
+		Labels:    labels,
+	}
+
+	if kind == hcov1.UninstallBackupStorageKindConfigMap {
+		cm := &corev1.ConfigMap{ObjectMeta: objectMeta}
+		if data != nil {
+			cm.Data = make(map[string]string, len(data))
+			for key, value := range data {
+				// compressed manifests are not valid UTF-8 strings
+				if strings.HasSuffix(key, compressedManifestSuffix) {
+					if cm.BinaryData == nil {
+						cm.BinaryData = make(map[string][]byte)
+					}
+					cm.BinaryData[key] = value
+					continue
+				}
+				cm.Data[key] = string(value)
+			}
+		}
+		return cm
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: objectMeta,
+		Type:       corev1.SecretTypeOpaque,
+		Data:       data,
+	}
+}
+
+// createOrUpdate creates the backup object, or overwrites an existing object with the same name; e.g. a leftover of
+// a previous, failed, backup
+func createOrUpdate(ctx context.Context, cli client.Client, reader client.Reader, obj client.Object) error {
+	err := cli.Create(ctx, obj)
+	if err == nil || !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	existing := obj.DeepCopyObject().(client.Object)
+	if err = reader.Get(ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+		return err
+	}
+
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	return cli.Update(ctx, obj)
+}
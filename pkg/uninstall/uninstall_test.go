@@ -0,0 +1,416 @@
+package uninstall
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+type failingListReader struct {
+	client.Reader
+}
+
+func (r failingListReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if _, ok := list.(*cdiv1beta1.DataVolumeList); ok {
+		return errors.New("fake error")
+	}
+	return r.Reader.List(ctx, list, opts...)
+}
+
+func TestUninstall(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Uninstall Suite")
+}
+
+var _ = Describe("Uninstall workloads", func() {
+	newVM := func(namespace, name string) *kubevirtcorev1.VirtualMachine {
+		return &kubevirtcorev1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: types.UID(name + "-uid")},
+			Spec: kubevirtcorev1.VirtualMachineSpec{
+				RunStrategy: new(kubevirtcorev1.RunStrategyAlways),
+			},
+			Status: kubevirtcorev1.VirtualMachineStatus{Ready: true},
+		}
+	}
+
+	newDV := func(namespace, name string, owners ...metav1.OwnerReference) *cdiv1beta1.DataVolume {
+		return &cdiv1beta1.DataVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, OwnerReferences: owners},
+		}
+	}
+
+	newDIC := func(namespace, name string) *cdiv1beta1.DataImportCron {
+		return &cdiv1beta1.DataImportCron{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		}
+	}
+
+	newManagedDIC := func(namespace, name, managedBy string) *cdiv1beta1.DataImportCron {
+		dic := newDIC(namespace, name)
+		dic.Labels = map[string]string{hcoutil.AppLabelManagedBy: managedBy}
+		return dic
+	}
+
+	Context("ListWorkloads", func() {
+		It("should group the workloads by namespace", func(ctx context.Context) {
+			cli := commontestutils.InitClient([]client.Object{
+				newVM("ns2", "vm2"),
+				newVM("ns1", "vm1"),
+				newDV("ns1", "dv1"),
+				newDV("ns1", "vm1-disk", metav1.OwnerReference{Kind: "VirtualMachine", Name: "vm1"}),
+				newDV("ns3", "dic1-import", metav1.OwnerReference{Kind: "DataImportCron", Name: "dic1"}),
+				newDIC("ns3", "dic1"),
+			})
+
+			workloads, err := ListWorkloads(ctx, cli, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(workloads).To(HaveLen(3))
+
+			Expect(workloads[0].Namespace).To(Equal("ns1"))
+			Expect(workloads[0].VirtualMachines).To(HaveLen(1))
+			Expect(workloads[0].DataVolumes).To(HaveLen(1))
+			Expect(workloads[0].DataVolumes[0].Name).To(Equal("dv1"))
+			Expect(workloads[0].DataImportCrons).To(BeEmpty())
+
+			Expect(workloads[1].Namespace).To(Equal("ns2"))
+			Expect(workloads[1].VirtualMachines).To(HaveLen(1))
+
+			Expect(workloads[2].Namespace).To(Equal("ns3"))
+			Expect(workloads[2].DataVolumes).To(BeEmpty())
+			Expect(workloads[2].DataImportCrons).To(HaveLen(1))
+		})
+
+		It("should not list the golden images DataImportCrons", func(ctx context.Context) {
+			cli := commontestutils.InitClient([]client.Object{
+				newManagedDIC("ns1", "ssp-dic", "ssp-operator"),
+				newManagedDIC("ns1", "hco-dic", hcoutil.OperatorName),
+				newDIC("kubevirt-os-images", "default-ns-dic"),
+				newDIC("openshift-virtualization-os-images", "openshift-ns-dic"),
+				newDIC("ns1", "user-dic"),
+			})
+
+			workloads, err := ListWorkloads(ctx, cli, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(workloads).To(HaveLen(1))
+			Expect(workloads[0].Namespace).To(Equal("ns1"))
+			Expect(workloads[0].DataImportCrons).To(HaveLen(1))
+			Expect(workloads[0].DataImportCrons[0].Name).To(Equal("user-dic"))
+
+			By("use the custom common boot image namespace instead of the default ones")
+			workloads, err = ListWorkloads(ctx, cli, new("ns1"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(workloads).To(HaveLen(2))
+			Expect(workloads[0].Namespace).To(Equal("kubevirt-os-images"))
+			Expect(workloads[1].Namespace).To(Equal("openshift-virtualization-os-images"))
+		})
+
+		It("should return an empty list if there are no workloads", func(ctx context.Context) {
+			cli := commontestutils.InitClient(nil)
+
+			workloads, err := ListWorkloads(ctx, cli, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(workloads).To(BeEmpty())
+		})
+
+		It("should return an error if the listing fails", func(ctx context.Context) {
+			reader := failingListReader{Reader: commontestutils.InitClient(nil)}
+
+			_, err := ListWorkloads(ctx, reader, nil)
+			Expect(err).To(MatchError(ContainSubstring("failed to list the DataVolumes; fake error")))
+		})
+	})
+
+	Context("FormatWorkloads", func() {
+		It("should list the workloads by namespace", func() {
+			msg := FormatWorkloads([]NamespaceWorkloads{
+				{
+					Namespace:       "ns1",
+					VirtualMachines: []kubevirtcorev1.VirtualMachine{*newVM("ns1", "vm1"), *newVM("ns1", "vm2")},
+					DataVolumes:     []cdiv1beta1.DataVolume{*newDV("ns1", "dv1")},
+				},
+				{
+					Namespace:       "ns2",
+					DataImportCrons: []cdiv1beta1.DataImportCron{*newDIC("ns2", "dic1")},
+				},
+			})
+
+			Expect(msg).To(Equal("namespace ns1: VirtualMachines (2): vm1, vm2; DataVolumes (1): dv1. namespace ns2: DataImportCrons (1): dic1"))
+		})
+
+		It("should limit the number of names and namespaces", func() {
+			var workloads []NamespaceWorkloads
+			for _, ns := range []string{"ns01", "ns02", "ns03", "ns04", "ns05", "ns06", "ns07", "ns08", "ns09", "ns10", "ns11", "ns12"} {
+				wl := NamespaceWorkloads{Namespace: ns}
+				for _, name := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+					wl.VirtualMachines = append(wl.VirtualMachines, *newVM(ns, name))
+				}
+				workloads = append(workloads, wl)
+			}
+
+			msg := FormatWorkloads(workloads)
+			Expect(msg).To(HavePrefix("namespace ns01: VirtualMachines (7): a, b, c, d, e and 2 more. namespace ns02:"))
+			Expect(msg).To(HaveSuffix("namespace ns10: VirtualMachines (7): a, b, c, d, e and 2 more. And 2 more namespaces"))
+			Expect(msg).ToNot(ContainSubstring("ns11"))
+		})
+	})
+
+	Context("IsBackupEnabled", func() {
+		DescribeTable("should check the uninstall strategy and the backup configuration", func(strategy hcov1.HyperConvergedUninstallStrategy, backup *hcov1.UninstallBackupConfig, expected bool) {
+			hc := commontestutils.NewHco()
+			hc.Spec.Deployment.UninstallStrategy = strategy
+			hc.Spec.Deployment.UninstallBackup = backup
+
+			Expect(IsBackupEnabled(hc)).To(Equal(expected))
+		},
+			Entry("RemoveWorkloads; enabled", hcov1.HyperConvergedUninstallStrategyRemoveWorkloads, &hcov1.UninstallBackupConfig{Enable: new(true)}, true),
+			Entry("RemoveWorkloads; disabled", hcov1.HyperConvergedUninstallStrategyRemoveWorkloads, &hcov1.UninstallBackupConfig{Enable: new(false)}, false),
+			Entry("RemoveWorkloads; no enable field", hcov1.HyperConvergedUninstallStrategyRemoveWorkloads, &hcov1.UninstallBackupConfig{}, false),
+			Entry("RemoveWorkloads; no backup configuration", hcov1.HyperConvergedUninstallStrategyRemoveWorkloads, nil, false),
+			Entry("BlockUninstallIfWorkloadsExist; enabled", hcov1.HyperConvergedUninstallStrategyBlockUninstallIfWorkloadsExist, &hcov1.UninstallBackupConfig{Enable: new(true)}, false),
+		)
+	})
+
+	Context("BackupWorkloads", func() {
+		var hc *hcov1.HyperConverged
+
+		BeforeEach(func() {
+			hc = commontestutils.NewHco()
+			hc.UID = "hc-uid"
+			hc.Spec.Deployment.UninstallStrategy = hcov1.HyperConvergedUninstallStrategyRemoveWorkloads
+			hc.Spec.Deployment.UninstallBackup = &hcov1.UninstallBackupConfig{Enable: new(true)}
+		})
+
+		It("should store the manifests in Secrets, by default", func(ctx context.Context) {
+			cli := commontestutils.InitClient([]client.Object{hc, newVM("ns1", "vm1"), newDV("ns1", "dv1"), newDIC("ns2", "dic1")})
+
+			created, err := BackupWorkloads(ctx, cli, cli, hc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			index := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: BackupIndexName, Namespace: hc.Namespace}, index)).To(Succeed())
+			Expect(index.Labels).To(HaveKeyWithValue(WorkloadsBackupLabel, "hc-uid"))
+			Expect(strings.Split(string(index.Data[BackupIndexKey]), "\n")).To(Equal([]string{
+				"kubevirt-hyperconverged-workloads-backup-ns1-0",
+				"kubevirt-hyperconverged-workloads-backup-ns2-0",
+			}))
+
+			bundle := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "kubevirt-hyperconverged-workloads-backup-ns1-0", Namespace: hc.Namespace}, bundle)).To(Succeed())
+			Expect(bundle.Labels).To(HaveKeyWithValue(WorkloadsBackupLabel, "hc-uid"))
+			Expect(bundle.Data).To(HaveLen(2))
+			Expect(bundle.Data).To(HaveKey("datavolume.dv1.yaml"))
+
+			vm := map[string]any{}
+			Expect(yaml.Unmarshal(bundle.Data["virtualmachine.vm1.yaml"], &vm)).To(Succeed())
+			Expect(vm).To(HaveKeyWithValue("apiVersion", "kubevirt.io/v1"))
+			Expect(vm).To(HaveKeyWithValue("kind", "VirtualMachine"))
+			Expect(vm).To(HaveKey("spec"))
+			Expect(vm).ToNot(HaveKey("status"))
+			Expect(vm["metadata"]).To(HaveKeyWithValue("name", "vm1"))
+			Expect(vm["metadata"]).To(HaveKeyWithValue("namespace", "ns1"))
+			Expect(vm["metadata"]).ToNot(HaveKey("uid"))
+			Expect(vm["metadata"]).ToNot(HaveKey("resourceVersion"))
+			Expect(vm["metadata"]).ToNot(HaveKey("creationTimestamp"))
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "kubevirt-hyperconverged-workloads-backup-ns2-0", Namespace: hc.Namespace}, bundle)).To(Succeed())
+			Expect(bundle.Data).To(HaveKey("dataimportcron.dic1.yaml"))
+		})
+
+		It("should not back up the golden images DataImportCrons", func(ctx context.Context) {
+			cli := commontestutils.InitClient([]client.Object{
+				hc,
+				newVM("ns1", "vm1"),
+				newManagedDIC("ns1", "ssp-dic", "ssp-operator"),
+				newDIC("kubevirt-os-images", "golden-dic"),
+			})
+
+			created, err := BackupWorkloads(ctx, cli, cli, hc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			index := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: BackupIndexName, Namespace: hc.Namespace}, index)).To(Succeed())
+			Expect(string(index.Data[BackupIndexKey])).To(Equal("kubevirt-hyperconverged-workloads-backup-ns1-0"))
+
+			bundle := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "kubevirt-hyperconverged-workloads-backup-ns1-0", Namespace: hc.Namespace}, bundle)).To(Succeed())
+			Expect(bundle.Data).To(HaveLen(1))
+			Expect(bundle.Data).To(HaveKey("virtualmachine.vm1.yaml"))
+		})
+
+		It("should store the manifests in ConfigMaps, if configured", func(ctx context.Context) {
+			hc.Spec.Deployment.UninstallBackup.StorageKind = hcov1.UninstallBackupStorageKindConfigMap
+			cli := commontestutils.InitClient([]client.Object{hc, newVM("ns1", "vm1")})
+
+			created, err := BackupWorkloads(ctx, cli, cli, hc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			index := &corev1.ConfigMap{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: BackupIndexName, Namespace: hc.Namespace}, index)).To(Succeed())
+			Expect(index.Data).To(HaveKeyWithValue(BackupIndexKey, "kubevirt-hyperconverged-workloads-backup-ns1-0"))
+
+			bundle := &corev1.ConfigMap{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "kubevirt-hyperconverged-workloads-backup-ns1-0", Namespace: hc.Namespace}, bundle)).To(Succeed())
+			Expect(bundle.Data).To(HaveKeyWithValue("virtualmachine.vm1.yaml", ContainSubstring("name: vm1")))
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: BackupIndexName, Namespace: hc.Namespace}, &corev1.Secret{})).ToNot(Succeed())
+		})
+
+		It("should back up only once for each HyperConverged CR", func(ctx context.Context) {
+			cli := commontestutils.InitClient([]client.Object{hc, newVM("ns1", "vm1")})
+
+			created, err := BackupWorkloads(ctx, cli, cli, hc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			// the workloads are being removed
+			Expect(cli.Delete(ctx, newVM("ns1", "vm1"))).To(Succeed())
+
+			created, err = BackupWorkloads(ctx, cli, cli, hc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+
+			bundle := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "kubevirt-hyperconverged-workloads-backup-ns1-0", Namespace: hc.Namespace}, bundle)).To(Succeed())
+			Expect(bundle.Data).To(HaveKey("virtualmachine.vm1.yaml"))
+		})
+
+		It("should overwrite the backup of a previous HyperConverged CR", func(ctx context.Context) {
+			cli := commontestutils.InitClient([]client.Object{hc, newVM("ns1", "vm1")})
+
+			_, err := BackupWorkloads(ctx, cli, cli, hc)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(cli.Create(ctx, newVM("ns1", "vm2"))).To(Succeed())
+			hc.UID = "new-hc-uid"
+
+			created, err := BackupWorkloads(ctx, cli, cli, hc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			bundle := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "kubevirt-hyperconverged-workloads-backup-ns1-0", Namespace: hc.Namespace}, bundle)).To(Succeed())
+			Expect(bundle.Labels).To(HaveKeyWithValue(WorkloadsBackupLabel, "new-hc-uid"))
+			Expect(bundle.Data).To(HaveKey("virtualmachine.vm2.yaml"))
+		})
+
+		It("should split a large namespace to several bundles", func(ctx context.Context) {
+			objects := []client.Object{hc}
+			for _, name := range []string{"vm1", "vm2", "vm3"} {
+				vm := newVM("ns1", name)
+				vm.Annotations = map[string]string{"large": strings.Repeat("x", maxBundleSize/2)}
+				objects = append(objects, vm)
+			}
+			cli := commontestutils.InitClient(objects)
+
+			_, err := BackupWorkloads(ctx, cli, cli, hc)
+			Expect(err).ToNot(HaveOccurred())
+
+			index := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: BackupIndexName, Namespace: hc.Namespace}, index)).To(Succeed())
+			Expect(strings.Split(string(index.Data[BackupIndexKey]), "\n")).To(Equal([]string{
+				"kubevirt-hyperconverged-workloads-backup-ns1-0",
+				"kubevirt-hyperconverged-workloads-backup-ns1-1",
+				"kubevirt-hyperconverged-workloads-backup-ns1-2",
+			}))
+		})
+
+		It("should compress a single manifest that is larger than a bundle", func(ctx context.Context) {
+			vm := newVM("ns1", "vm1")
+			vm.Annotations = map[string]string{"large": strings.Repeat("x", maxBundleSize)}
+			cli := commontestutils.InitClient([]client.Object{hc, vm, newVM("ns1", "vm2")})
+
+			_, err := BackupWorkloads(ctx, cli, cli, hc)
+			Expect(err).ToNot(HaveOccurred())
+
+			bundle := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "kubevirt-hyperconverged-workloads-backup-ns1-0", Namespace: hc.Namespace}, bundle)).To(Succeed())
+			Expect(bundle.Data).To(HaveKey("virtualmachine.vm1.yaml.gz"))
+			Expect(bundle.Data).To(HaveKey("virtualmachine.vm2.yaml"))
+
+			zr, err := gzip.NewReader(bytes.NewReader(bundle.Data["virtualmachine.vm1.yaml.gz"]))
+			Expect(err).ToNot(HaveOccurred())
+			manifest, err := io.ReadAll(zr)
+			Expect(err).ToNot(HaveOccurred())
+
+			restored := &kubevirtcorev1.VirtualMachine{}
+			Expect(yaml.Unmarshal(manifest, restored)).To(Succeed())
+			Expect(restored.Name).To(Equal("vm1"))
+			Expect(restored.Annotations).To(HaveKeyWithValue("large", vm.Annotations["large"]))
+		})
+
+		It("should store a compressed manifest as binary data in a ConfigMap", func(ctx context.Context) {
+			hc.Spec.Deployment.UninstallBackup.StorageKind = hcov1.UninstallBackupStorageKindConfigMap
+			vm := newVM("ns1", "vm1")
+			vm.Annotations = map[string]string{"large": strings.Repeat("x", maxBundleSize)}
+			cli := commontestutils.InitClient([]client.Object{hc, vm})
+
+			_, err := BackupWorkloads(ctx, cli, cli, hc)
+			Expect(err).ToNot(HaveOccurred())
+
+			bundle := &corev1.ConfigMap{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "kubevirt-hyperconverged-workloads-backup-ns1-0", Namespace: hc.Namespace}, bundle)).To(Succeed())
+			Expect(bundle.Data).To(BeEmpty())
+			Expect(bundle.BinaryData).To(HaveKey("virtualmachine.vm1.yaml.gz"))
+		})
+
+		It("should fail, naming the object, if a single manifest is too large even when compressed", func(ctx context.Context) {
+			// random letters can't be compressed enough to fit in a bundle
+			rnd := rand.New(rand.NewPCG(1, 2))
+			large := make([]byte, 2*maxBundleSize)
+			for i := range large {
+				large[i] = byte('a' + rnd.IntN(26))
+			}
+
+			vm := newVM("ns1", "vm1")
+			vm.Annotations = map[string]string{"large": string(large)}
+			cli := commontestutils.InitClient([]client.Object{hc, vm})
+
+			created, err := BackupWorkloads(ctx, cli, cli, hc)
+			Expect(err).To(MatchError(ContainSubstring("the manifest of the VirtualMachine ns1/vm1 is too large to be backed up")))
+			Expect(created).To(BeFalse())
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "kubevirt-hyperconverged-workloads-backup-ns1-0", Namespace: hc.Namespace}, &corev1.Secret{})).ToNot(Succeed())
+			Expect(cli.Get(ctx, client.ObjectKey{Name: BackupIndexName, Namespace: hc.Namespace}, &corev1.Secret{})).ToNot(Succeed())
+		})
+
+		It("should not write the index if storing a bundle fails", func(ctx context.Context) {
+			cli := commontestutils.InitClient([]client.Object{hc, newVM("ns1", "vm1")})
+			cli.InitiateCreateErrors(func(obj client.Object) error {
+				if obj.GetName() == "kubevirt-hyperconverged-workloads-backup-ns1-0" {
+					return errors.New("fake error")
+				}
+				return nil
+			})
+
+			created, err := BackupWorkloads(ctx, cli, cli, hc)
+			Expect(err).To(MatchError(ContainSubstring("fake error")))
+			Expect(created).To(BeFalse())
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: BackupIndexName, Namespace: hc.Namespace}, &corev1.Secret{})).ToNot(Succeed())
+		})
+	})
+})
@@ -0,0 +1,159 @@
+package uninstall
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// the maximum number of object names of each kind, to list for a namespace
+	maxNamesPerKind = 5
+
+	// the maximum number of namespaces to list in a message
+	maxNamespaces = 10
+
+	// the value of the managed-by label of the golden images DataImportCrons, deployed by SSP
+	sspOperatorName = "ssp-operator"
+)
+
+// the default namespaces of the golden images: the SSP default on OpenShift, and the SSP and HCO default on Kubernetes
+var defaultGoldenImagesNamespaces = []string{"openshift-virtualization-os-images", "kubevirt-os-images"}
+
+// NamespaceWorkloads are the workloads in a single namespace, that block the uninstallation of HCO
+type NamespaceWorkloads struct {
+	Namespace       string
+	VirtualMachines []kubevirtcorev1.VirtualMachine
+	DataVolumes     []cdiv1beta1.DataVolume
+	DataImportCrons []cdiv1beta1.DataImportCron
+}
+
+// ListWorkloads lists the VirtualMachines, DataVolumes and DataImportCrons in the cluster, grouped by namespace and
+// sorted by the namespace name. DataVolumes that are owned by a VirtualMachine or by a DataImportCron are not listed,
+// because they are removed and recreated with their owners. The golden images DataImportCrons are not listed either,
+// because they are removed with HCO; commonBootImageNamespace is the spec.workloadSources.commonBootImageNamespace
+// field of the HyperConverged CR.
+func ListWorkloads(ctx context.Context, reader client.Reader, commonBootImageNamespace *string) ([]NamespaceWorkloads, error) {
+	byNamespace := make(map[string]*NamespaceWorkloads)
+	getNamespace := func(ns string) *NamespaceWorkloads {
+		wl, found := byNamespace[ns]
+		if !found {
+			wl = &NamespaceWorkloads{Namespace: ns}
+			byNamespace[ns] = wl
+		}
+		return wl
+	}
+
+	vms := &kubevirtcorev1.VirtualMachineList{}
+	if err := reader.List(ctx, vms); err != nil {
+		return nil, fmt.Errorf("failed to list the VirtualMachines; %w", err)
+	}
+	for _, vm := range vms.Items {
+		wl := getNamespace(vm.Namespace)
+		wl.VirtualMachines = append(wl.VirtualMachines, vm)
+	}
+
+	dvs := &cdiv1beta1.DataVolumeList{}
+	if err := reader.List(ctx, dvs); err != nil {
+		return nil, fmt.Errorf("failed to list the DataVolumes; %w", err)
+	}
+	for _, dv := range dvs.Items {
+		if isOwnedByWorkload(&dv) {
+			continue
+		}
+		wl := getNamespace(dv.Namespace)
+		wl.DataVolumes = append(wl.DataVolumes, dv)
+	}
+
+	dics := &cdiv1beta1.DataImportCronList{}
+	if err := reader.List(ctx, dics); err != nil {
+		return nil, fmt.Errorf("failed to list the DataImportCrons; %w", err)
+	}
+	for _, dic := range dics.Items {
+		if isGoldenImage(&dic, commonBootImageNamespace) {
+			continue
+		}
+		wl := getNamespace(dic.Namespace)
+		wl.DataImportCrons = append(wl.DataImportCrons, dic)
+	}
+
+	workloads := make([]NamespaceWorkloads, 0, len(byNamespace))
+	for _, wl := range byNamespace {
+		workloads = append(workloads, *wl)
+	}
+	slices.SortFunc(workloads, func(a, b NamespaceWorkloads) int {
+		return strings.Compare(a.Namespace, b.Namespace)
+	})
+
+	return workloads, nil
+}
+
+func isOwnedByWorkload(obj client.Object) bool {
+	return slices.ContainsFunc(obj.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+		return ref.Kind == "VirtualMachine" || ref.Kind == "DataImportCron"
+	})
+}
+
+// isGoldenImage returns true if the DataImportCron is managed by SSP or by HCO, or if it is in the common boot images
+// namespace
+func isGoldenImage(dic *cdiv1beta1.DataImportCron, commonBootImageNamespace *string) bool {
+	if managedBy := dic.Labels[hcoutil.AppLabelManagedBy]; managedBy == sspOperatorName || managedBy == hcoutil.OperatorName {
+		return true
+	}
+
+	if commonBootImageNamespace != nil && *commonBootImageNamespace != "" {
+		return dic.Namespace == *commonBootImageNamespace
+	}
+
+	return slices.Contains(defaultGoldenImagesNamespaces, dic.Namespace)
+}
+
+// FormatWorkloads returns a human-readable description of the workloads, by namespace. To keep the message short, only
+// the first names of each kind, and the first namespaces, are listed.
+func FormatWorkloads(workloads []NamespaceWorkloads) string {
+	namespaces := make([]string, 0, min(len(workloads), maxNamespaces))
+	for _, wl := range workloads[:min(len(workloads), maxNamespaces)] {
+		var kinds []string
+		kinds = appendKind(kinds, "VirtualMachines", names(wl.VirtualMachines, func(vm kubevirtcorev1.VirtualMachine) string { return vm.Name }))
+		kinds = appendKind(kinds, "DataVolumes", names(wl.DataVolumes, func(dv cdiv1beta1.DataVolume) string { return dv.Name }))
+		kinds = appendKind(kinds, "DataImportCrons", names(wl.DataImportCrons, func(dic cdiv1beta1.DataImportCron) string { return dic.Name }))
+
+		namespaces = append(namespaces, fmt.Sprintf("namespace %s: %s", wl.Namespace, strings.Join(kinds, "; ")))
+	}
+
+	msg := strings.Join(namespaces, ". ")
+	if len(workloads) > maxNamespaces {
+		msg = fmt.Sprintf("%s. And %d more namespaces", msg, len(workloads)-maxNamespaces)
+	}
+
+	return msg
+}
+
+func names[T any](objs []T, getName func(T) string) []string {
+	res := make([]string, 0, len(objs))
+	for _, obj := range objs {
+		res = append(res, getName(obj))
+	}
+	return res
+}
+
+func appendKind(kinds []string, kind string, objNames []string) []string {
+	if len(objNames) == 0 {
+		return kinds
+	}
+
+	if len(objNames) > maxNamesPerKind {
+		return append(kinds, fmt.Sprintf("%s (%d): %s and %d more", kind, len(objNames), strings.Join(objNames[:maxNamesPerKind], ", "), len(objNames)-maxNamesPerKind))
+	}
+
+	return append(kinds, fmt.Sprintf("%s (%d): %s", kind, len(objNames), strings.Join(objNames, ", ")))
+}
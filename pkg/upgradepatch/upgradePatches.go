@@ -5,6 +5,7 @@ import (
 	_ "embed"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"strings"
@@ -40,6 +41,19 @@ type hcoCRPatch struct {
 	JSONPatchApplyOptions *jsonpatch.ApplyOptions `json:"jsonPatchApplyOptions,omitempty"`
 }
 
+// PatchStatus is the result of applying a single upgrade patch
+type PatchStatus string
+
+const (
+	// PatchApplied means that the source version is in the patch range, and the patch was applied
+	PatchApplied PatchStatus = "Applied"
+	// PatchSkipped means that the source version is not in the patch range
+	PatchSkipped PatchStatus = "Skipped"
+	// PatchTestFailed means that the source version is in the patch range, but a jsonpatch test operation failed, so the
+	// patch was not applied. This is a tolerated failure.
+	PatchTestFailed PatchStatus = "TestFailed"
+)
+
 func (p hcoCRPatch) applyUpgradePatch(logger logr.Logger, hcoJSON []byte, knownHcoSV semver.Version) ([]byte, error) {
 	if p.IsAffectedRange(knownHcoSV) {
 		buff := &bytes.Buffer{}
@@ -47,27 +61,38 @@ func (p hcoCRPatch) applyUpgradePatch(logger logr.Logger, hcoJSON []byte, knownH
 		if err != nil {
 			buff = bytes.NewBuffer([]byte("<unknown>"))
 		}
-
 		logger.Info("applying upgrade patch", "knownHcoSV", knownHcoSV, "affectedRange", p.SemverRange.ver, "patches", buff.String(), "applyOptions", p.JSONPatchApplyOptions)
-		var (
-			patchedBytes []byte
-		)
-		if p.JSONPatchApplyOptions != nil {
-			patchedBytes, err = p.JSONPatch.ApplyWithOptions(hcoJSON, p.JSONPatchApplyOptions)
-		} else {
-			patchedBytes, err = p.JSONPatch.Apply(hcoJSON)
-		}
-		if err != nil {
-			// tolerate jsonpatch test failures
-			if errors.Is(err, jsonpatch.ErrTestFailed) {
-				return hcoJSON, nil
-			}
+	}
+
+	patchedBytes, _, err := p.apply(hcoJSON, knownHcoSV)
+	return patchedBytes, err
+}
+
+func (p hcoCRPatch) apply(hcoJSON []byte, knownHcoSV semver.Version) ([]byte, PatchStatus, error) {
+	if !p.IsAffectedRange(knownHcoSV) {
+		return hcoJSON, PatchSkipped, nil
+	}
+
+	var (
+		patchedBytes []byte
+		err          error
+	)
+	if p.JSONPatchApplyOptions != nil {
+		patchedBytes, err = p.JSONPatch.ApplyWithOptions(hcoJSON, p.JSONPatchApplyOptions)
+	} else {
+		patchedBytes, err = p.JSONPatch.Apply(hcoJSON)
+	}
 
-			return hcoJSON, err
+	if err != nil {
+		// tolerate jsonpatch test failures
+		if errors.Is(err, jsonpatch.ErrTestFailed) {
+			return hcoJSON, PatchTestFailed, nil
 		}
-		return patchedBytes, nil
+
+		return hcoJSON, "", err
 	}
-	return hcoJSON, nil
+
+	return patchedBytes, PatchApplied, nil
 }
 
 func (p hcoCRPatch) IsAffectedRange(ver semver.Version) bool {
@@ -167,6 +192,95 @@ func (up UpgradePatches) applyUpgradePatch(logger logr.Logger, hc *hcov1.HyperCo
 	return tmpInstance, nil
 }
 
+// PatchReport is the simulation result of a single HyperConverged CR upgrade patch
+type PatchReport struct {
+	// Index is the position of the patch in the hcoCRPatchList
+	Index int `json:"index"`
+	// SemverRange is the range of the source versions that are affected by the patch
+	SemverRange string `json:"semverRange"`
+	// Status is the result of applying the patch
+	Status PatchStatus `json:"status"`
+	// JSONPatch is the patch itself
+	JSONPatch jsonpatch.Patch `json:"jsonPatch"`
+}
+
+// RemovedObjectReport is an object that would be removed during the upgrade
+type RemovedObjectReport struct {
+	// SemverRange is the range of the source versions that are affected by the removal
+	SemverRange string `json:"semverRange"`
+	// GroupVersionKind identifies the kind of the object to be removed
+	GroupVersionKind schema.GroupVersionKind `json:"groupVersionKind"`
+	// ObjectKey contains name and namespace of the object to be removed
+	ObjectKey types.NamespacedName `json:"objectKey"`
+}
+
+// SimulationResult is the outcome of upgrading a HyperConverged CR from a specific source version
+type SimulationResult struct {
+	// HyperConverged is the HyperConverged CR after applying the upgrade patches
+	HyperConverged *hcov1.HyperConverged `json:"hyperConverged"`
+	// Patches is the list of all the upgrade patches, with the result of applying each one of them
+	Patches []PatchReport `json:"patches"`
+	// ObjectsToBeRemoved is the list of the objects that would be removed during the upgrade
+	ObjectsToBeRemoved []RemovedObjectReport `json:"objectsToBeRemoved"`
+	// RemovedWrongJSONPatch is true if the patch that adds the wrong "Template" KubeVirt feature gate was removed from
+	// the KubeVirt JSON patch annotation, before applying the upgrade patches
+	RemovedWrongJSONPatch bool `json:"removedWrongJSONPatch"`
+}
+
+// Simulate applies the upgrade patches on a copy of the HyperConverged CR, the same way as it is done during the
+// upgrade from knownHcoSV, and reports the result of each patch and the objects that would be removed. As in the
+// upgrade, the wrong KubeVirt JSON patch is removed from the CR before applying the upgrade patches.
+func (up UpgradePatches) Simulate(hc *hcov1.HyperConverged, knownHcoSV semver.Version) (*SimulationResult, error) {
+	hc = hc.DeepCopy()
+	removedWrongJSONPatch, err := RemoveWrongJSONPatch(hc)
+	if err != nil {
+		return nil, err
+	}
+
+	hcoJSON, err := json.Marshal(hc)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SimulationResult{
+		Patches:               make([]PatchReport, 0, len(up.HCOCRPatchList)),
+		ObjectsToBeRemoved:    make([]RemovedObjectReport, 0),
+		RemovedWrongJSONPatch: removedWrongJSONPatch,
+	}
+
+	for i, patch := range up.HCOCRPatchList {
+		var status PatchStatus
+		hcoJSON, status, err = patch.apply(hcoJSON, knownHcoSV)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply upgrade patch #%d: %w", i, err)
+		}
+
+		result.Patches = append(result.Patches, PatchReport{
+			Index:       i,
+			SemverRange: patch.SemverRange.ver,
+			Status:      status,
+			JSONPatch:   patch.JSONPatch,
+		})
+	}
+
+	result.HyperConverged = &hcov1.HyperConverged{}
+	if err = json.Unmarshal(hcoJSON, result.HyperConverged); err != nil {
+		return nil, err
+	}
+
+	for _, obj := range up.ObjectsToBeRemoved {
+		if obj.IsAffectedRange(knownHcoSV) {
+			result.ObjectsToBeRemoved = append(result.ObjectsToBeRemoved, RemovedObjectReport{
+				SemverRange:      obj.SemverRange.ver,
+				GroupVersionKind: obj.GroupVersionKind,
+				ObjectKey:        obj.ObjectKey,
+			})
+		}
+	}
+
+	return result, nil
+}
+
 var (
 	hcoUpgradeChanges UpgradePatches
 	once              = &sync.Once{}
@@ -177,6 +291,12 @@ func ApplyUpgradePatch(logger logr.Logger, hc *hcov1.HyperConverged, knownHcoSV
 	return hcoUpgradeChanges.applyUpgradePatch(logger, hc, knownHcoSV)
 }
 
+// SimulateUpgradePatch reports the result of applying the upgrade patches on the HyperConverged CR, without
+// modifying it
+func SimulateUpgradePatch(hc *hcov1.HyperConverged, knownHcoSV semver.Version) (*SimulationResult, error) {
+	return hcoUpgradeChanges.Simulate(hc, knownHcoSV)
+}
+
 func GetObjectsToBeRemoved() []ObjectToBeRemoved {
 	return hcoUpgradeChanges.ObjectsToBeRemoved
 }
@@ -194,26 +314,34 @@ func readUpgradePatchesFromFile(pwdFS fs.FS, logger logr.Logger) error {
 }
 
 func readJsonFromReader(file io.Reader) error {
-	hcoUpgradeChanges = UpgradePatches{}
+	var err error
+	hcoUpgradeChanges, err = ReadUpgradePatches(file)
+	return err
+}
+
+// ReadUpgradePatches reads and validates the upgrade patches file
+func ReadUpgradePatches(file io.Reader) (UpgradePatches, error) {
+	upgradePatches := UpgradePatches{}
 
 	jDec := json.NewDecoder(file)
-	err := jDec.Decode(&hcoUpgradeChanges)
+	err := jDec.Decode(&upgradePatches)
 	if err != nil {
-		return err
+		return UpgradePatches{}, err
 	}
 
-	for _, p := range hcoUpgradeChanges.HCOCRPatchList {
+	for _, p := range upgradePatches.HCOCRPatchList {
 		if err = validateUpgradePatch(p); err != nil {
-			return err
+			return UpgradePatches{}, err
 		}
 	}
-	for _, r := range hcoUpgradeChanges.ObjectsToBeRemoved {
+
+	for _, r := range upgradePatches.ObjectsToBeRemoved {
 		if err = validateUpgradeLeftover(r); err != nil {
-			return err
+			return UpgradePatches{}, err
 		}
 	}
 
-	return nil
+	return upgradePatches, nil
 }
 
 func Init(pwdFS fs.FS, logger logr.Logger) error {
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
 
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/dirtest"
 )
@@ -298,4 +299,98 @@ var _ = Describe("upgradePatches", func() {
 			Expect(newHc.Spec.Virtualization.VirtualMachineOptions).To(BeNil())
 		})
 	})
+
+	Context("simulate", func() {
+		var up UpgradePatches
+
+		BeforeEach(func() {
+			var err error
+			up, err = ReadUpgradePatches(bytes.NewReader(upgradePatchesFileContent))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail reading invalid upgrade patches", func() {
+			_, err := ReadUpgradePatches(bytes.NewReader(badPatches2FileContent))
+			Expect(err).To(MatchError("can only modify spec fields"))
+		})
+
+		It("should report the applied patches and the objects to be removed", func() {
+			hc := commontestutils.NewHco()
+
+			ver, err := semver.Parse("1.18.5")
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := up.Simulate(hc, ver)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.HyperConverged.Spec.Virtualization.VirtualMachineOptions.DisableFreePageReporting).To(HaveValue(BeTrue()))
+			Expect(res.HyperConverged.Spec.Virtualization.VirtualMachineOptions.DisableSerialConsoleLog).To(BeNil())
+			Expect(hc.Spec.Virtualization.VirtualMachineOptions.DisableFreePageReporting).To(HaveValue(BeFalse()))
+
+			Expect(res.Patches).To(HaveLen(3))
+			for i, p := range res.Patches {
+				Expect(p.Index).To(Equal(i))
+				Expect(p.Status).To(Equal(PatchApplied))
+			}
+			Expect(res.Patches[0].SemverRange).To(Equal(">=1.18.0 <1.19.0"))
+
+			Expect(res.ObjectsToBeRemoved).To(HaveLen(7))
+			Expect(res.ObjectsToBeRemoved[0].ObjectKey.Name).To(Equal("passt-binding-cni"))
+			Expect(res.ObjectsToBeRemoved[0].GroupVersionKind.Kind).To(Equal("DaemonSet"))
+		})
+
+		It("should report the skipped patches", func() {
+			hc := commontestutils.NewHco()
+
+			ver, err := semver.Parse("1.19.0")
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := up.Simulate(hc, ver)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.HyperConverged.Spec.Virtualization.VirtualMachineOptions.DisableFreePageReporting).To(HaveValue(BeFalse()))
+
+			Expect(res.Patches).To(HaveLen(3))
+			Expect(res.Patches[0].Status).To(Equal(PatchSkipped))
+			Expect(res.Patches[1].Status).To(Equal(PatchApplied))
+			Expect(res.Patches[2].Status).To(Equal(PatchSkipped))
+
+			Expect(res.ObjectsToBeRemoved).To(BeEmpty())
+		})
+
+		It("should report the tolerated test failures", func() {
+			hc := commontestutils.NewHco()
+			hc.Spec.Virtualization.VirtualMachineOptions.DisableFreePageReporting = new(true)
+
+			ver, err := semver.Parse("1.18.5")
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := up.Simulate(hc, ver)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.HyperConverged.Spec.Virtualization.VirtualMachineOptions.DisableFreePageReporting).To(HaveValue(BeTrue()))
+
+			Expect(res.Patches).To(HaveLen(3))
+			Expect(res.Patches[0].Status).To(Equal(PatchTestFailed))
+			Expect(res.Patches[1].Status).To(Equal(PatchApplied))
+			Expect(res.Patches[2].Status).To(Equal(PatchApplied))
+		})
+
+		It("should remove the wrong KubeVirt JSON patch, as in the upgrade", func() {
+			hc := commontestutils.NewHco()
+			hc.Annotations = map[string]string{
+				common.JSONPatchKVAnnotationName: `[{"op": "add", "path": "/spec/configuration/developerConfiguration/featureGates/-", "value": "Template"}]`,
+			}
+
+			ver, err := semver.Parse("1.19.0")
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := up.Simulate(hc, ver)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.RemovedWrongJSONPatch).To(BeTrue())
+			Expect(res.HyperConverged.Annotations).ToNot(HaveKey(common.JSONPatchKVAnnotationName))
+			Expect(hc.Annotations).To(HaveKey(common.JSONPatchKVAnnotationName))
+		})
+	})
 })
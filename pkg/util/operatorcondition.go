@@ -2,10 +2,16 @@ package util
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	operatorframeworkv2 "github.com/operator-framework/api/pkg/operators/v2"
 	"github.com/operator-framework/operator-lib/conditions"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -20,6 +26,9 @@ type Condition interface {
 
 // OperatorCondition wraps operator-lib's Condition to make it not crash,
 // when running locally or in Kubernetes without OLM.
+//
+// When not managed by OLM, the condition is published in the OperatorConditionsConfigMapName ConfigMap instead of
+// in the OperatorCondition CR, so that external upgrade tooling can check it.
 type OperatorCondition struct {
 	cond conditions.Condition
 }
@@ -33,6 +42,10 @@ const (
 
 	UpgradeableAllowReason  = "Upgradeable"
 	UpgradeableAllowMessage = ""
+
+	// OperatorConditionsConfigMapName is the name of the ConfigMap that holds the operator conditions, when HCO is
+	// not managed by OLM. Each condition is stored as a JSON-formatted metav1.Condition, under its type.
+	OperatorConditionsConfigMapName = "hyperconverged-cluster-operator-conditions"
 )
 
 var GetFactory = func(cl client.Client) conditions.Factory {
@@ -50,7 +63,8 @@ func NewOperatorCondition(clusterInfo ClusterInfo, cl client.Client, condType st
 		return oc, nil
 	}
 	if !clusterInfo.IsManagedByOLM() {
-		// We are not managed by OLM -> no OperatorCondition
+		// We are not managed by OLM -> no OperatorCondition; use a ConfigMap instead
+		oc.cond = newConfigMapCondition(cl, condType)
 		return oc, nil
 	}
 
@@ -70,3 +84,108 @@ func (oc *OperatorCondition) Set(ctx context.Context, status metav1.ConditionSta
 
 	return oc.cond.Set(ctx, status, conditions.WithReason(reason), conditions.WithMessage(message))
 }
+
+// configMapCondition implements operator-lib's Condition interface, on top of a ConfigMap in the operator namespace
+type configMapCondition struct {
+	client   client.Client
+	key      types.NamespacedName
+	condType string
+}
+
+var _ conditions.Condition = &configMapCondition{}
+
+func newConfigMapCondition(cl client.Client, condType string) *configMapCondition {
+	return &configMapCondition{
+		client:   cl,
+		key:      types.NamespacedName{Name: OperatorConditionsConfigMapName, Namespace: GetOperatorNamespaceFromEnv()},
+		condType: condType,
+	}
+}
+
+func (c *configMapCondition) Get(ctx context.Context) (*metav1.Condition, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.client.Get(ctx, c.key, cm); err != nil {
+		return nil, err
+	}
+
+	cond, err := c.getFromConfigMap(cm)
+	if err != nil {
+		return nil, err
+	}
+
+	if cond == nil {
+		return nil, fmt.Errorf("conditionType %v not found", c.condType)
+	}
+
+	return cond, nil
+}
+
+func (c *configMapCondition) Set(ctx context.Context, status metav1.ConditionStatus, options ...conditions.Option) error {
+	cm := &corev1.ConfigMap{}
+	found := true
+	if err := c.client.Get(ctx, c.key, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		found = false
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      c.key.Name,
+				Namespace: c.key.Namespace,
+				Labels: map[string]string{
+					AppLabel:          HyperConvergedName,
+					AppLabelManagedBy: OperatorName,
+				},
+			},
+		}
+	}
+
+	var conds []metav1.Condition
+	// a malformed condition is overwritten
+	if cond, err := c.getFromConfigMap(cm); err == nil && cond != nil {
+		conds = append(conds, *cond)
+	}
+
+	newCond := metav1.Condition{
+		Type:   c.condType,
+		Status: status,
+	}
+	for _, opt := range options {
+		opt(&newCond)
+	}
+
+	if !meta.SetStatusCondition(&conds, newCond) && found {
+		return nil
+	}
+
+	condBytes, err := json.Marshal(conds[0])
+	if err != nil {
+		return err
+	}
+
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[c.condType] = string(condBytes)
+
+	if !found {
+		return c.client.Create(ctx, cm)
+	}
+
+	return c.client.Update(ctx, cm)
+}
+
+func (c *configMapCondition) getFromConfigMap(cm *corev1.ConfigMap) (*metav1.Condition, error) {
+	condStr, ok := cm.Data[c.condType]
+	if !ok {
+		return nil, nil
+	}
+
+	cond := &metav1.Condition{}
+	if err := json.Unmarshal([]byte(condStr), cond); err != nil {
+		return nil, fmt.Errorf("can't parse the %s condition from the %s ConfigMap; %w", c.condType, c.key.Name, err)
+	}
+
+	return cond, nil
+}
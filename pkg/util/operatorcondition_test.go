@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 	operatorsapiv2 "github.com/operator-framework/api/pkg/operators/v2"
 	"github.com/operator-framework/operator-lib/conditions"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -24,10 +25,6 @@ var _ = Describe("OperatorCondition", func() {
 		ctx := context.Background()
 		Expect(oc.Set(ctx, metav1.ConditionTrue, "Reason", "message")).To(Succeed())
 	},
-		Entry("should no-op when not managed by OLM", &ClusterInfoImp{
-			managedByOLM:   false,
-			runningLocally: false,
-		}),
 		Entry("should no-op when running locally", &ClusterInfoImp{
 			managedByOLM:   true,
 			runningLocally: true,
@@ -38,6 +35,83 @@ var _ = Describe("OperatorCondition", func() {
 		}),
 	)
 
+	Context("not managed by OLM", func() {
+		var (
+			cl client.Client
+			oc *OperatorCondition
+		)
+
+		getConfigMap := func() *corev1.ConfigMap {
+			cm := &corev1.ConfigMap{}
+			Expect(cl.Get(context.Background(), oc.cond.(*configMapCondition).key, cm)).To(Succeed())
+			return cm
+		}
+
+		BeforeEach(func() {
+			cl = fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+			var err error
+			oc, err = NewOperatorCondition(&ClusterInfoImp{
+				managedByOLM:   false,
+				runningLocally: false,
+			}, cl, operatorsapiv2.Upgradeable)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should create the conditions ConfigMap", func() {
+			Expect(oc.Set(context.Background(), metav1.ConditionFalse, UpgradeableInitReason, UpgradeableInitMessage)).To(Succeed())
+
+			cm := getConfigMap()
+			Expect(cm.Labels).To(HaveKeyWithValue(AppLabel, HyperConvergedName))
+			Expect(cm.Data).To(HaveKey(operatorsapiv2.Upgradeable))
+
+			cond, err := oc.cond.Get(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cond.Type).To(Equal(operatorsapiv2.Upgradeable))
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(UpgradeableInitReason))
+			Expect(cond.Message).To(Equal(UpgradeableInitMessage))
+			Expect(cond.LastTransitionTime.IsZero()).To(BeFalse())
+		})
+
+		It("should update the condition in the ConfigMap", func() {
+			Expect(oc.Set(context.Background(), metav1.ConditionFalse, UpgradeableInitReason, UpgradeableInitMessage)).To(Succeed())
+			Expect(oc.Set(context.Background(), metav1.ConditionTrue, UpgradeableAllowReason, UpgradeableAllowMessage)).To(Succeed())
+
+			cond, err := oc.cond.Get(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(UpgradeableAllowReason))
+			Expect(cond.Message).To(Equal(UpgradeableAllowMessage))
+		})
+
+		It("should keep the other keys of the ConfigMap, and overwrite a malformed condition", func() {
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      OperatorConditionsConfigMapName,
+					Namespace: oc.cond.(*configMapCondition).key.Namespace,
+				},
+				Data: map[string]string{
+					"other":                    "value",
+					operatorsapiv2.Upgradeable: "not a condition",
+				},
+			}
+			Expect(cl.Create(context.Background(), cm)).To(Succeed())
+
+			_, err := oc.cond.Get(context.Background())
+			Expect(err).To(HaveOccurred())
+
+			Expect(oc.Set(context.Background(), metav1.ConditionTrue, UpgradeableAllowReason, UpgradeableAllowMessage)).To(Succeed())
+
+			cm = getConfigMap()
+			Expect(cm.Data).To(HaveKeyWithValue("other", "value"))
+
+			cond, err := oc.cond.Get(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		})
+	})
+
 	It("valid condition", func() {
 		testScheme := scheme.Scheme
 		Expect(operatorsapiv2.AddToScheme(testScheme)).To(Succeed())
@@ -231,9 +231,15 @@ func EnsureDeleted(ctx context.Context, c client.Client, obj client.Object, hcoN
 	return ComponentResourceRemoval(ctx, c, obj, hcoName, logger, dryRun, wait, protectNonHCOObjects)
 }
 
-var hcoKvIoVersion string
+var (
+	hcoKvIoVersion     string
+	hcoKvIoVersionLock sync.Mutex
+)
 
 func GetHcoKvIoVersion() string {
+	hcoKvIoVersionLock.Lock()
+	defer hcoKvIoVersionLock.Unlock()
+
 	if hcoKvIoVersion == "" {
 		hcoKvIoVersion = os.Getenv(HcoKvIoVersionName)
 	}
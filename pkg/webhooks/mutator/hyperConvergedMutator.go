@@ -24,6 +24,7 @@ import (
 	hcov1fg "github.com/kubevirt/hyperconverged-cluster-operator/api/v1/featuregates"
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
+	waspagent "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/wasp-agent"
 )
 
 const (
@@ -68,6 +69,8 @@ const (
 	dictImmediateAnnotationPath = "/cdi.kubevirt.io~1storage.bind.immediate.requested"
 	retentionPolicyPath         = "/spec/retentionPolicy"
 	importsToKeepPath           = "/spec/importsToKeep"
+
+	higherWorkloadDensityPath = "/spec/virtualization/higherWorkloadDensity"
 )
 
 func (hcm *HyperConvergedMutator) mutateHyperConverged(req admission.Request, logger logr.Logger) admission.Response {
@@ -81,6 +84,7 @@ func (hcm *HyperConvergedMutator) mutateHyperConverged(req admission.Request, lo
 	patches := getDICTPatches(hc.Spec.WorkloadSources.DataImportCronTemplates, dictsPathTemplate)
 	patches = mutateEvictionStrategy(hc, patches)
 	patches = mutateTuningPolicy(hc, patches)
+	patches = mutateAutopilotSwap(hc.Annotations, hc.Spec.Virtualization.HigherWorkloadDensity, higherWorkloadDensityPath, patches)
 
 	var warnings []string
 
@@ -238,6 +242,43 @@ func mutateTuningPolicy(hc *hcov1.HyperConverged, patches []jsonpatch.JsonPatchO
 	return patches
 }
 
+// mutateAutopilotSwap converts the platform.kubevirt.io/autopilot annotation to the swap.enabled field. If the
+// annotation opts swap into the autopilot, and the field is not set, it is set to false, so HCO won't deploy
+// wasp-agent. An explicit value of the field is never modified.
+func mutateAutopilotSwap(annotations map[string]string, hwd *hcov1.HigherWorkloadDensityConfiguration, hwdPath string, patches []jsonpatch.JsonPatchOperation) []jsonpatch.JsonPatchOperation {
+	if !waspagent.IsSwapManagedByAutopilot(annotations) {
+		return patches
+	}
+
+	disabledSwap := &hcov1.SwapConfig{Enabled: new(false)}
+
+	switch {
+	case hwd == nil:
+		patches = append(patches, jsonpatch.JsonPatchOperation{
+			Operation: "add",
+			Path:      hwdPath,
+			Value: hcov1.HigherWorkloadDensityConfiguration{
+				MemoryOvercommitPercentage: waspagent.NoOverCommitPercentage,
+				Swap:                       disabledSwap,
+			},
+		})
+	case hwd.Swap == nil:
+		patches = append(patches, jsonpatch.JsonPatchOperation{
+			Operation: "add",
+			Path:      hwdPath + "/swap",
+			Value:     disabledSwap,
+		})
+	case hwd.Swap.Enabled == nil:
+		patches = append(patches, jsonpatch.JsonPatchOperation{
+			Operation: "add",
+			Path:      hwdPath + "/swap/enabled",
+			Value:     false,
+		})
+	}
+
+	return patches
+}
+
 func dropFeatureGate(fgName string, fgs hcov1fg.HyperConvergedFeatureGates, patches []jsonpatch.JsonPatchOperation) []jsonpatch.JsonPatchOperation {
 	if len(fgs) == 0 {
 		return patches
@@ -22,6 +22,7 @@ import (
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
+	waspagent "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/wasp-agent"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -380,6 +381,96 @@ var _ = Describe("test HyperConverged v1 mutator", func() {
 				}))
 			})
 		})
+
+		Context("conversion of the autopilot swap annotation", func() {
+			BeforeEach(func() {
+				cr.Spec.Virtualization.KSMConfiguration = &kubevirtcorev1.KSMConfiguration{}
+				cr.Spec.Virtualization.HigherWorkloadDensity = &hcov1.HigherWorkloadDensityConfiguration{
+					MemoryOvercommitPercentage: 150,
+				}
+			})
+
+			It("should not mutate if the annotation is not set", func(ctx context.Context) {
+				req := admission.Request{AdmissionRequest: newCreateRequest(cr, testCodec)}
+
+				res := mutator.Handle(ctx, req)
+				Expect(res.Allowed).To(BeTrue())
+				Expect(res.Patches).To(BeEmpty())
+			})
+
+			DescribeTable("should disable swap if the annotation opts swap into the autopilot", func(ctx context.Context, annotation string) {
+				cr.Annotations = map[string]string{waspagent.AutopilotSwapAnnotation: annotation}
+				req := admission.Request{AdmissionRequest: newCreateRequest(cr, testCodec)}
+
+				res := mutator.Handle(ctx, req)
+				Expect(res.Allowed).To(BeTrue())
+				Expect(res.Patches).To(Equal([]jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      "/spec/virtualization/higherWorkloadDensity/swap",
+						Value:     &hcov1.SwapConfig{Enabled: new(false)},
+					},
+				}))
+			},
+				Entry("swap opt-in", waspagent.AutopilotSwapAnnotationValue),
+				Entry("full opt-in", waspagent.AutopilotFullOptInAnnotationValue),
+				Entry("swap opt-in in a list", "other-feature, "+waspagent.AutopilotSwapAnnotationValue),
+			)
+
+			It("should not mutate if the annotation does not opt swap into the autopilot", func(ctx context.Context) {
+				cr.Annotations = map[string]string{waspagent.AutopilotSwapAnnotation: "other-feature"}
+				req := admission.Request{AdmissionRequest: newCreateRequest(cr, testCodec)}
+
+				res := mutator.Handle(ctx, req)
+				Expect(res.Allowed).To(BeTrue())
+				Expect(res.Patches).To(BeEmpty())
+			})
+
+			It("should add the enabled field to an existing swap configuration", func(ctx context.Context) {
+				cr.Annotations = map[string]string{waspagent.AutopilotSwapAnnotation: waspagent.AutopilotSwapAnnotationValue}
+				cr.Spec.Virtualization.HigherWorkloadDensity.Swap = &hcov1.SwapConfig{Verbosity: new(int32(3))}
+				req := admission.Request{AdmissionRequest: newCreateRequest(cr, testCodec)}
+
+				res := mutator.Handle(ctx, req)
+				Expect(res.Allowed).To(BeTrue())
+				Expect(res.Patches).To(Equal([]jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      "/spec/virtualization/higherWorkloadDensity/swap/enabled",
+						Value:     false,
+					},
+				}))
+			})
+
+			It("should add the higherWorkloadDensity field, if missing", func(ctx context.Context) {
+				cr.Annotations = map[string]string{waspagent.AutopilotSwapAnnotation: waspagent.AutopilotSwapAnnotationValue}
+				cr.Spec.Virtualization.HigherWorkloadDensity = nil
+				req := admission.Request{AdmissionRequest: newCreateRequest(cr, testCodec)}
+
+				res := mutator.Handle(ctx, req)
+				Expect(res.Allowed).To(BeTrue())
+				Expect(res.Patches).To(Equal([]jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      "/spec/virtualization/higherWorkloadDensity",
+						Value: hcov1.HigherWorkloadDensityConfiguration{
+							MemoryOvercommitPercentage: 100,
+							Swap:                       &hcov1.SwapConfig{Enabled: new(false)},
+						},
+					},
+				}))
+			})
+
+			It("should not modify an explicit value of the enabled field", func(ctx context.Context) {
+				cr.Annotations = map[string]string{waspagent.AutopilotSwapAnnotation: waspagent.AutopilotSwapAnnotationValue}
+				cr.Spec.Virtualization.HigherWorkloadDensity.Swap = &hcov1.SwapConfig{Enabled: new(true)}
+				req := admission.Request{AdmissionRequest: newCreateRequest(cr, testCodec)}
+
+				res := mutator.Handle(ctx, req)
+				Expect(res.Allowed).To(BeTrue())
+				Expect(res.Patches).To(BeEmpty())
+			})
+		})
 	})
 
 	Context("Check mutating webhook for update operation", func() {
@@ -738,6 +829,25 @@ var _ = Describe("test HyperConverged v1 mutator", func() {
 				}))
 			})
 		})
+
+		It("should disable swap when the autopilot swap annotation is added", func(ctx context.Context) {
+			cr.Spec.Virtualization.HigherWorkloadDensity = &hcov1.HigherWorkloadDensityConfiguration{
+				MemoryOvercommitPercentage: 150,
+			}
+			origCR := cr.DeepCopy()
+			cr.Annotations = map[string]string{waspagent.AutopilotSwapAnnotation: waspagent.AutopilotSwapAnnotationValue}
+			req := admission.Request{AdmissionRequest: newUpdateRequest(origCR, cr, testCodec)}
+
+			res := mutator.Handle(ctx, req)
+			Expect(res.Allowed).To(BeTrue())
+			Expect(res.Patches).To(Equal([]jsonpatch.JsonPatchOperation{
+				{
+					Operation: "add",
+					Path:      "/spec/virtualization/higherWorkloadDensity/swap",
+					Value:     &hcov1.SwapConfig{Enabled: new(false)},
+				},
+			}))
+		})
 	})
 })
 
@@ -19,7 +19,8 @@ import (
 const (
 	mutatorV1Beta1Name = "hyperConverged v1beta1 mutator"
 
-	v1beta1DICTPathTemplate = "/spec/dataImportCronTemplates/%d"
+	v1beta1DICTPathTemplate          = "/spec/dataImportCronTemplates/%d"
+	v1beta1HigherWorkloadDensityPath = "/spec/higherWorkloadDensity"
 )
 
 var (
@@ -61,6 +62,7 @@ func (hcm *HyperConvergedV1Beta1Mutator) mutateHyperConverged(req admission.Requ
 
 	patches := getDICTPatches(hc.Spec.DataImportCronTemplates, v1beta1DICTPathTemplate)
 	patches = mutateV1beta1EvictionStrategy(hc, patches)
+	patches = mutateAutopilotSwap(hc.Annotations, hc.Spec.HigherWorkloadDensity, v1beta1HigherWorkloadDensityPath, patches)
 
 	if hc.Spec.MediatedDevicesConfiguration != nil {
 		if len(hc.Spec.MediatedDevicesConfiguration.MediatedDevicesTypes) > 0 && len(hc.Spec.MediatedDevicesConfiguration.MediatedDeviceTypes) == 0 { //nolint SA1019
@@ -21,6 +21,7 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
+	waspagent "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/wasp-agent"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -547,6 +548,26 @@ var _ = Describe("test HyperConverged v1beta1 mutator", func() {
 			Expect(res.Patches).To(BeEmpty())
 		})
 
+		It("should disable swap if the annotation opts swap into the autopilot", func(ctx context.Context) {
+			cr.Annotations = map[string]string{waspagent.AutopilotSwapAnnotation: waspagent.AutopilotSwapAnnotationValue}
+			cr.Spec.KSMConfiguration = &kubevirtcorev1.KSMConfiguration{}
+			cr.Spec.HigherWorkloadDensity = &hcov1.HigherWorkloadDensityConfiguration{
+				MemoryOvercommitPercentage: 150,
+			}
+			req := admission.Request{AdmissionRequest: newCreateRequest(cr, testCodec)}
+
+			res := mutator.Handle(ctx, req)
+			Expect(res.Allowed).To(BeTrue())
+
+			Expect(res.Patches).To(Equal([]jsonpatch.JsonPatchOperation{
+				{
+					Operation: "add",
+					Path:      "/spec/higherWorkloadDensity/swap",
+					Value:     &hcov1.SwapConfig{Enabled: new(false)},
+				},
+			}))
+		})
+
 	})
 
 	Context("Check mutating webhook for update operation", func() {
@@ -207,6 +207,11 @@ func (wh *WebhookV1Beta1Handler) validateUpdateHyperConverged(requested, exists
 func (wh *WebhookV1Beta1Handler) ValidateDelete(ctx context.Context, logger logr.Logger, dryrun bool, hc *v1beta1.HyperConverged) error {
 	logger.Info("Validating delete", "name", hc.Name, "namespace", hc.Namespace)
 
+	if err := validateUninstallWorkloads(ctx, wh.cli, hc.Spec.UninstallStrategy, hc.Spec.CommonBootImageNamespace); err != nil {
+		logger.Error(err, "Delete validation failed")
+		return err
+	}
+
 	kv := handlers.NewKubeVirtWithNameOnly()
 	cdi := handlers.NewCDIWithNameOnly()
 
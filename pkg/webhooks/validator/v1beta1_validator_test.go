@@ -1709,6 +1709,17 @@ var _ = Describe("v1beta1 webhooks validator", func() {
 			Expect(util.GetRuntimeObject(ctx, cli, cdi)).To(Succeed())
 		})
 
+		It("should reject, and list the workloads, if the uninstall strategy is BlockUninstallAndReportWorkloads", func(ctx context.Context) {
+			hco.Spec.UninstallStrategy = hcov1.HyperConvergedUninstallStrategyBlockUninstallAndReportWorkloads
+			cli := getFakeClient(hco)
+			Expect(cli.Create(ctx, &kubevirtcorev1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "ns1"}})).To(Succeed())
+
+			wh := NewWebhookV1Beta1Handler(GinkgoLogr, cli, decoder, HcoValidNamespace, true)
+
+			err := wh.ValidateDelete(ctx, GinkgoLogr, dryRun, hco)
+			Expect(err).To(MatchError(ContainSubstring("namespace ns1: VirtualMachines (1): vm1")))
+		})
+
 		It("should reject if KV deletion fails", func(ctx context.Context) {
 			cli := getFakeClient(hco)
 
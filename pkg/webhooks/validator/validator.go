@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"reflect"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/containers/image/v5/docker/reference"
 	"github.com/go-logr/logr"
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	"github.com/samber/lo"
@@ -16,7 +20,11 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,9 +39,14 @@ import (
 	hcov1fg "github.com/kubevirt/hyperconverged-cluster-operator/api/v1/featuregates"
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/aie"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregatedetails"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregatepolicy"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/featuregates"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/alertoverrides"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/tlssecprofile"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/uninstall"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -155,6 +168,11 @@ func (wh *WebhookHandler) validateUpdate(ctx context.Context, logger logr.Logger
 func (wh *WebhookHandler) validateDelete(ctx context.Context, logger logr.Logger, dryrun bool, hc *hcov1.HyperConverged) admission.Response {
 	logger.Info("Validating delete", "name", hc.Name, "namespace", hc.Namespace)
 
+	if err := validateUninstallWorkloads(ctx, wh.cli, hc.Spec.Deployment.UninstallStrategy, hc.Spec.WorkloadSources.CommonBootImageNamespace); err != nil {
+		logger.Error(err, "Delete validation failed")
+		return errToResponse(err, nil)
+	}
+
 	var err error
 	for _, obj := range []client.Object{
 		handlers.NewKubeVirtWithNameOnly(),
@@ -192,7 +210,51 @@ func (wh *WebhookHandler) validateHyperConverged(hc *hcov1.HyperConverged) ([]st
 		return nil, err
 	}
 
-	if warn := wh.validateTuningPolicy(hc); len(warn) > 0 {
+	if err := validateComponentsConfig(hc.Spec.Deployment.Components); err != nil {
+		return nil, err
+	}
+
+	if err := validateHighAvailability(hc); err != nil {
+		return nil, err
+	}
+
+	if err := validateStandaloneConfig(hc.Spec.Deployment.Standalone); err != nil {
+		return nil, err
+	}
+
+	if err := validateSilences(hc.Spec.Observability); err != nil {
+		return nil, err
+	}
+
+	if err := validateMigrationPolicies(hc.Spec.Virtualization.MigrationPolicies); err != nil {
+		return nil, err
+	}
+
+	if err := alertoverrides.Validate(alertoverrides.GetConfig(hc)); err != nil {
+		return nil, err
+	}
+
+	warn, err := wh.validateOverrides(hc)
+	if err != nil {
+		return nil, err
+	}
+	if len(warn) > 0 {
+		warnings = append(warnings, warn...)
+	}
+
+	warn, err = wh.validateTuningPolicy(hc)
+	if err != nil {
+		return nil, err
+	}
+	if len(warn) > 0 {
+		warnings = append(warnings, warn...)
+	}
+
+	warn, err = validateAIE(hc)
+	if err != nil {
+		return nil, err
+	}
+	if len(warn) > 0 {
 		warnings = append(warnings, warn...)
 	}
 
@@ -207,11 +269,16 @@ func (wh *WebhookHandler) validateCreateHyperConverged(hc *hcov1.HyperConverged)
 		warnings = append(warnings, warn...)
 	}
 
-	if warn = wh.validateFeatureGatesOnCreate(hc); len(warn) > 0 {
+	warn, fgErr := wh.validateFeatureGatesOnCreate(hc)
+	if len(warn) > 0 {
 		warnings = append(warnings, warn...)
 	}
 
-	return warnings, err
+	if err != nil {
+		return warnings, err
+	}
+
+	return warnings, fgErr
 }
 
 func (wh *WebhookHandler) validateUpdateHyperConverged(hc, oldHC *hcov1.HyperConverged) ([]string, error) {
@@ -222,11 +289,16 @@ func (wh *WebhookHandler) validateUpdateHyperConverged(hc, oldHC *hcov1.HyperCon
 		warnings = append(warnings, warn...)
 	}
 
-	if warn = wh.validateFeatureGatesOnUpdate(hc, oldHC); len(warn) > 0 {
+	warn, fgErr := wh.validateFeatureGatesOnUpdate(hc, oldHC)
+	if len(warn) > 0 {
 		warnings = append(warnings, warn...)
 	}
 
-	return warnings, err
+	if err != nil {
+		return warnings, err
+	}
+
+	return warnings, fgErr
 }
 
 func (wh *WebhookHandler) validateCreateComponents(hc *hcov1.HyperConverged) error {
@@ -325,25 +397,68 @@ func (wh *WebhookHandler) validateTLSSecurityProfiles(hc *hcov1.HyperConverged)
 	return nil
 }
 
-func (wh *WebhookHandler) validateTuningPolicy(hc *hcov1.HyperConverged) []string {
-	if hc.Spec.Virtualization.TuningPolicy == hcov1beta1.HyperConvergedHighBurstProfile { //nolint SA1019
-		return []string{"spec.virtualization.tuningPolicy: the highBurst profile is not supported and ignored"}
+// validateOverrides dry-applies the spec.overrides entries on the operand CRs that HCO generates from the
+// HyperConverged spec, so invalid overrides are rejected at admission rather than failing the reconciliation.
+func (wh *WebhookHandler) validateOverrides(hc *hcov1.HyperConverged) ([]string, error) {
+	var warnings []string
+	validated := map[hcov1.OperandOverrideTarget]bool{}
+
+	for _, override := range hc.Spec.Overrides {
+		if validated[override.Target] {
+			continue
+		}
+		validated[override.Target] = true
+
+		var err error
+		switch override.Target {
+		case hcov1.OperandOverrideTargetKubeVirt:
+			_, err = handlers.NewKubeVirt(hc)
+		case hcov1.OperandOverrideTargetCDI:
+			_, err = handlers.NewCDI(hc)
+		case hcov1.OperandOverrideTargetNetworkAddonsConfig:
+			_, err = handlers.NewNetworkAddons(hc)
+		case hcov1.OperandOverrideTargetSSP:
+			if !wh.isOpenshift {
+				warnings = append(warnings, "spec.overrides: SSP is not deployed on this cluster; the SSP overrides are ignored")
+			}
+			_, _, err = handlers.NewSSP(hc, true)
+		default:
+			err = fmt.Errorf("spec.overrides: unknown target %q", override.Target)
+		}
+
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return nil
+	return warnings, nil
 }
 
-func (wh *WebhookHandler) validateFeatureGatesOnCreate(hc *hcov1.HyperConverged) []string {
-	fgMap := v1FGsToMap(hc.Spec.FeatureGates)
+func (wh *WebhookHandler) validateTuningPolicy(hc *hcov1.HyperConverged) ([]string, error) {
+	if hc.Spec.Virtualization.TuningPolicy == hcov1beta1.HyperConvergedHighBurstProfile { //nolint SA1019
+		return []string{"spec.virtualization.tuningPolicy: the highBurst profile is not supported and ignored"}, nil
+	}
+
+	if hc.Spec.Virtualization.TuningPolicy == hcov1.HyperConvergedCustomTuningPolicy {
+		if hc.Spec.Virtualization.Tuning == nil {
+			return nil, fmt.Errorf("the %s tuning policy is set, but spec.virtualization.tuning is not set", hcov1.HyperConvergedCustomTuningPolicy)
+		}
+		return nil, nil
+	}
+
+	if hc.Spec.Virtualization.Tuning != nil {
+		return []string{"spec.virtualization.tuning: the rate limits are ignored, unless spec.virtualization.tuningPolicy is set to custom"}, nil
+	}
 
-	return wh.validateDeprecatedFeatureGates(fgMap, nil)
+	return nil, nil
 }
 
-func (wh *WebhookHandler) validateFeatureGatesOnUpdate(requested, exists *hcov1.HyperConverged) []string {
-	reqFGMap := v1FGsToMap(requested.Spec.FeatureGates)
-	oldFGMap := v1FGsToMap(exists.Spec.FeatureGates)
+func (wh *WebhookHandler) validateFeatureGatesOnCreate(hc *hcov1.HyperConverged) ([]string, error) {
+	return wh.validateFeatureGates(hc.Spec.FeatureGates, nil, hc.Spec.FeatureGatesPolicy)
+}
 
-	return wh.validateDeprecatedFeatureGates(reqFGMap, oldFGMap)
+func (wh *WebhookHandler) validateFeatureGatesOnUpdate(requested, exists *hcov1.HyperConverged) ([]string, error) {
+	return wh.validateFeatureGates(requested.Spec.FeatureGates, exists.Spec.FeatureGates, requested.Spec.FeatureGatesPolicy)
 }
 
 func (wh *WebhookHandler) validateAffinity(hc *hcov1.HyperConverged) error {
@@ -365,34 +480,344 @@ func (wh *WebhookHandler) validateAffinity(hc *hcov1.HyperConverged) error {
 		}
 	}
 
+	return validateComponentsAffinity(nodePlacements.Components)
+}
+
+func validateComponentsAffinity(components []hcov1.ComponentNodePlacement) error {
+	for _, component := range components {
+		if err := validateAffinity(component.Affinity); err != nil {
+			return fmt.Errorf("invalid %s node placement affinity: %v", component.Component, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// validateHighAvailability rejects a PodDisruptionBudget minAvailable that is not lower than the number of replicas of
+// a Deployment with a PodDisruptionBudget, because such a PodDisruptionBudget would block the node drain
+func validateHighAvailability(hc *hcov1.HyperConverged) error {
+	ha := hc.Spec.Deployment.HighAvailability
+	if ha == nil || ha.PodDisruptionBudget == nil || ha.PodDisruptionBudget.MinAvailable == nil || !operands.IsPDBEnabled(hc) {
+		return nil
+	}
+
+	// the number of replicas of a Deployment on a highly available infrastructure, if not set in the components config
+	const defaultReplicas = 2
+
+	minAvailable := ha.PodDisruptionBudget.MinAvailable
+	components := operands.GetComponentsConfig(hc)
+	for _, component := range []struct {
+		name   string
+		config *hcov1.ComponentDeploymentConfig
+	}{
+		{name: "consolePlugin", config: components.ConsolePlugin},
+		{name: "consoleProxy", config: components.ConsoleProxy},
+		{name: "aieWebhook", config: components.AIEWebhook},
+		{name: "networkResourcesInjector", config: components.NetworkResourcesInjector},
+	} {
+		replicas := int32(defaultReplicas)
+		if component.config != nil && component.config.Replicas != nil {
+			replicas = *component.config.Replicas
+		}
+
+		// there is no PodDisruptionBudget for a single replica
+		if replicas <= 1 {
+			continue
+		}
+
+		required, err := intstr.GetScaledValueFromIntOrPercent(minAvailable, int(replicas), true)
+		if err != nil {
+			return fmt.Errorf("invalid podDisruptionBudget.minAvailable: %w", err)
+		}
+
+		if required >= int(replicas) {
+			return fmt.Errorf("invalid podDisruptionBudget.minAvailable (%s): it must be lower than the number of replicas of the %s Deployment (%d)", minAvailable.String(), component.name, replicas)
+		}
+	}
+
+	return nil
+}
+
+func validateComponentsConfig(components *hcov1.ComponentsConfig) error {
+	if components == nil {
+		return nil
+	}
+
+	for _, component := range []struct {
+		name   string
+		config *hcov1.ComponentDeploymentConfig
+	}{
+		{name: "consolePlugin", config: components.ConsolePlugin},
+		{name: "consoleProxy", config: components.ConsoleProxy},
+		{name: "aieWebhook", config: components.AIEWebhook},
+		{name: "networkResourcesInjector", config: components.NetworkResourcesInjector},
+	} {
+		if component.config == nil {
+			continue
+		}
+
+		if component.config.Replicas != nil && *component.config.Replicas < 1 {
+			return fmt.Errorf("invalid %s configuration: the number of replicas must be at least 1", component.name)
+		}
+
+		if err := validateComponentPodConfig(component.name, &component.config.ComponentPodConfig); err != nil {
+			return err
+		}
+	}
+
+	for _, component := range []struct {
+		name   string
+		config *hcov1.ComponentPodConfig
+	}{
+		{name: "observabilityController", config: components.ObservabilityController},
+		{name: "waspAgent", config: components.WaspAgent},
+	} {
+		if err := validateComponentPodConfig(component.name, component.config); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateComponentPodConfig(name string, config *hcov1.ComponentPodConfig) error {
+	if config == nil {
+		return nil
+	}
+
+	if config.PriorityClassName != "" {
+		if errs := validation.IsDNS1123Subdomain(config.PriorityClassName); len(errs) > 0 {
+			return fmt.Errorf("invalid %s configuration: invalid priorityClassName %q: %s", name, config.PriorityClassName, strings.Join(errs, "; "))
+		}
+	}
+
+	if config.Resources == nil {
+		return nil
+	}
+
+	for _, resourceName := range slices.Sorted(maps.Keys(config.Resources.Requests)) {
+		limit, hasLimit := config.Resources.Limits[resourceName]
+		if request := config.Resources.Requests[resourceName]; hasLimit && request.Cmp(limit) > 0 {
+			return fmt.Errorf("invalid %s configuration: the %s request (%s) must be less than or equal to the %s limit (%s)",
+				name, resourceName, request.String(), resourceName, limit.String())
+		}
+	}
+
+	return nil
+}
+
+func validateStandaloneConfig(standalone *hcov1.StandaloneConfig) error {
+	if standalone == nil || standalone.CLIDownloads == nil {
+		return nil
+	}
+
+	cliDownloads := standalone.CLIDownloads
+	if errs := validation.IsDNS1123Subdomain(cliDownloads.Host); len(errs) > 0 {
+		return fmt.Errorf("invalid cliDownloads configuration: invalid host %q: %s", cliDownloads.Host, strings.Join(errs, "; "))
+	}
+
+	if cliDownloads.TLSSecretName != "" {
+		if errs := validation.IsDNS1123Subdomain(cliDownloads.TLSSecretName); len(errs) > 0 {
+			return fmt.Errorf("invalid cliDownloads configuration: invalid tlsSecretName %q: %s", cliDownloads.TLSSecretName, strings.Join(errs, "; "))
+		}
+	}
+
+	return nil
+}
+
+func validateAIE(hc *hcov1.HyperConverged) ([]string, error) {
+	aieConfig := hc.Spec.Virtualization.AIE
+	if aieConfig == nil {
+		return nil, nil
+	}
+
+	var warnings []string
+	if len(aieConfig.Rules) > 0 && hc.Annotations[aie.DeployAIEAnnotation] != "true" {
+		warnings = append(warnings, fmt.Sprintf("spec.virtualization.aie: the AIE webhook is not deployed, and the rules are not applied; set the %s annotation to \"true\" to deploy it", aie.DeployAIEAnnotation))
+	}
+
+	permittedDevices := getPermittedHostDeviceNames(hc.Spec.Virtualization.PermittedHostDevices)
+
+	for _, rule := range aieConfig.Rules {
+		if _, err := reference.ParseNormalizedNamed(rule.Image); err != nil {
+			return nil, fmt.Errorf("invalid AIE rule %q: invalid image %q: %w", rule.Name, rule.Image, err)
+		}
+
+		if len(rule.DeviceNames) == 0 && len(rule.VMLabels) == 0 {
+			return nil, fmt.Errorf("invalid AIE rule %q: at least one of deviceNames and vmLabels must be set", rule.Name)
+		}
+
+		for _, deviceName := range rule.DeviceNames {
+			if errs := validation.IsQualifiedName(deviceName); len(errs) > 0 {
+				return nil, fmt.Errorf("invalid AIE rule %q: invalid device name %q: %s", rule.Name, deviceName, strings.Join(errs, "; "))
+			}
+
+			if !permittedDevices.Has(deviceName) {
+				warnings = append(warnings, fmt.Sprintf("spec.virtualization.aie: the %q device of the %q rule is not enabled in spec.virtualization.permittedHostDevices", deviceName, rule.Name))
+			}
+		}
+
+		if err := validateLabels(rule.VMLabels); err != nil {
+			return nil, fmt.Errorf("invalid AIE rule %q: invalid vmLabels: %w", rule.Name, err)
+		}
+
+		if err := validateLabels(rule.NodeSelector); err != nil {
+			return nil, fmt.Errorf("invalid AIE rule %q: invalid nodeSelector: %w", rule.Name, err)
+		}
+	}
+
+	return warnings, nil
+}
+
+func validateMigrationPolicies(profiles []hcov1.MigrationPolicyProfile) error {
+	for i, profile := range profiles {
+		if profile.BandwidthPerMigration != nil {
+			if _, err := resource.ParseQuantity(*profile.BandwidthPerMigration); err != nil {
+				return fmt.Errorf("invalid migration policy %q: invalid bandwidthPerMigration %q: %w", profile.Name, *profile.BandwidthPerMigration, err)
+			}
+		}
+
+		if err := validateLabels(profile.NamespaceSelector); err != nil {
+			return fmt.Errorf("invalid migration policy %q: invalid namespaceSelector: %w", profile.Name, err)
+		}
+
+		if err := validateLabels(profile.VMSelector); err != nil {
+			return fmt.Errorf("invalid migration policy %q: invalid vmSelector: %w", profile.Name, err)
+		}
+
+		for _, other := range profiles[:i] {
+			if selectorsOverlap(profile.NamespaceSelector, other.NamespaceSelector) && selectorsOverlap(profile.VMSelector, other.VMSelector) {
+				return fmt.Errorf("the selectors of the %q and the %q migration policies overlap; a VM can match both of them", other.Name, profile.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// selectorsOverlap checks if an object can match both label selectors; i.e. if there is no label that the selectors
+// require with different values
+func selectorsOverlap(selector1, selector2 map[string]string) bool {
+	for key, value := range selector1 {
+		if otherValue, ok := selector2[key]; ok && otherValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+func getPermittedHostDeviceNames(devices *hcov1.PermittedHostDevices) sets.Set[string] {
+	names := sets.New[string]()
+	if devices == nil {
+		return names
+	}
+
+	for _, dev := range devices.PciHostDevices {
+		if !dev.Disabled {
+			names.Insert(dev.ResourceName)
+		}
+	}
+
+	for _, dev := range devices.USBHostDevices {
+		if !dev.Disabled {
+			names.Insert(dev.ResourceName)
+		}
+	}
+
+	for _, dev := range devices.MediatedDevices {
+		if !dev.Disabled {
+			names.Insert(dev.ResourceName)
+		}
+	}
+
+	return names
+}
+
+func validateLabels(labels map[string]string) error {
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid label key %q: %s", key, strings.Join(errs, "; "))
+		}
+
+		if errs := validation.IsValidLabelValue(labels[key]); len(errs) > 0 {
+			return fmt.Errorf("invalid value %q of the %q label: %s", labels[key], key, strings.Join(errs, "; "))
+		}
+	}
+
+	return nil
+}
+
+func validateSilences(observability *hcov1.ObservabilityConfig) error {
+	if observability == nil {
+		return nil
+	}
+
+	for _, silence := range observability.Silences {
+		for _, matcher := range silence.Matchers {
+			if matcher.Type == hcov1.AlertSilenceMatcherRegex || matcher.Type == hcov1.AlertSilenceMatcherNotRegex {
+				if _, err := regexp.Compile(matcher.Value); err != nil {
+					return fmt.Errorf("invalid silence %q: invalid regular expression for the %q matcher: %w", silence.Name, matcher.Name, err)
+				}
+			}
+		}
+
+		if silence.RenewBefore != nil && silence.RenewBefore.Duration < time.Hour {
+			return fmt.Errorf("invalid silence %q: renewBefore must be at least one hour", silence.Name)
+		}
+
+		if silence.Duration != nil && silence.RenewBefore != nil && silence.Duration.Duration <= silence.RenewBefore.Duration {
+			return fmt.Errorf("invalid silence %q: duration must be greater than renewBefore", silence.Name)
+		}
+	}
+
 	return nil
 }
 
 const (
-	fgv1Unknown            = "the %s featureGate is unknown and ignored."
-	fgv1DeprecationWarning = "the %s featureGate is deprecated and will be removed in a future release."
+	fgv1Unknown              = "the %s featureGate is unknown and ignored."
+	fgv1AlphaWarning         = "the %s featureGate is in alpha phase; the feature is in Developer Preview."
+	fgv1DeprecationWarning   = "the %s featureGate is deprecated and will be removed in a future release."
+	fgv1DiscontinuedWarning  = "the %s featureGate is discontinued and ignored."
+	fgv1PolicyRemovalWarning = "the %s featureGate is %s; it will be removed, according to spec.featureGatesPolicy."
+	fgv1PolicyDenyError      = "the %s featureGate is %s, and it is not allowed by spec.featureGatesPolicy"
 )
 
-func (wh *WebhookHandler) validateDeprecatedFeatureGates(fgMap, oldFgMap map[string]bool) []string {
+var fgv1PhaseWarnings = map[featuregates.Phase]string{
+	featuregates.PhaseAlpha:        fgv1AlphaWarning,
+	featuregates.PhaseDeprecated:   fgv1DeprecationWarning,
+	featuregates.PhaseDiscontinued: fgv1DiscontinuedWarning,
+}
+
+// validateFeatureGates warns about unknown feature gates, and enforces the feature gates policy on the feature gates
+// that were added or changed by the request. The feature gates that are not changed are enforced by HCO itself.
+func (wh *WebhookHandler) validateFeatureGates(fgs, oldFGs hcov1fg.HyperConvergedFeatureGates, policy *hcov1.FeatureGatesPolicy) ([]string, error) {
 	var warnings []string
 
-	for fgName, enabled := range fgMap {
-		phase, exists := featuregatedetails.GetFeatureGatePhase(fgName)
-		if !exists {
-			warnings = append(warnings, fmt.Sprintf(fgv1Unknown, fgName))
-			continue
+	fgMap := v1FGsToMap(fgs)
+	oldFgMap := v1FGsToMap(oldFGs)
+
+	for _, fg := range fgs {
+		if _, exists := featuregatedetails.GetFeatureGatePhase(fg.Name); !exists {
+			warnings = append(warnings, fmt.Sprintf(fgv1Unknown, fg.Name))
 		}
+	}
 
-		if phase != featuregates.PhaseDeprecated {
+	for _, res := range featuregatepolicy.Evaluate(policy, fgs) {
+		if oldEnabled, oldExists := oldFgMap[res.Name]; oldExists && fgMap[res.Name] == oldEnabled {
 			continue
 		}
 
-		if oldEnabled, oldExists := oldFgMap[fgName]; !oldExists || enabled != oldEnabled {
-			warnings = append(warnings, fmt.Sprintf(fgv1DeprecationWarning, fgName))
+		switch res.Action {
+		case hcov1.FeatureGatePolicyWarn:
+			warnings = append(warnings, fmt.Sprintf(fgv1PhaseWarnings[res.Phase], res.Name))
+		case hcov1.FeatureGatePolicyRemove:
+			warnings = append(warnings, fmt.Sprintf(fgv1PolicyRemovalWarning, res.Name, res.Phase))
+		case hcov1.FeatureGatePolicyDeny:
+			return warnings, fmt.Errorf(fgv1PolicyDenyError, res.Name, res.Phase)
 		}
 	}
 
-	return warnings
+	return warnings, nil
 }
 
 func hasRequiredHTTP2Ciphers(ciphers []string) bool {
@@ -443,6 +868,25 @@ func validateAffinity(affinity *corev1.Affinity) error {
 	return err
 }
 
+// validateUninstallWorkloads denies the deletion of the HyperConverged CR with the list of the existing workloads, if
+// the uninstall strategy is BlockUninstallAndReportWorkloads
+func validateUninstallWorkloads(ctx context.Context, reader client.Reader, strategy hcov1.HyperConvergedUninstallStrategy, commonBootImageNamespace *string) error {
+	if strategy != hcov1.HyperConvergedUninstallStrategyBlockUninstallAndReportWorkloads {
+		return nil
+	}
+
+	workloads, err := uninstall.ListWorkloads(ctx, reader, commonBootImageNamespace)
+	if err != nil {
+		return err
+	}
+
+	if len(workloads) > 0 {
+		return fmt.Errorf("can't remove the HyperConverged CR while workloads still exist. Remove the following workloads, or change spec.deployment.uninstallStrategy: %s", uninstall.FormatWorkloads(workloads))
+	}
+
+	return nil
+}
+
 func errToResponse(err error, warnings []string) admission.Response {
 	if err == nil {
 		return withWarnings(admission.Allowed(""), warnings)
@@ -11,9 +11,12 @@ import (
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -31,6 +34,7 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/aie"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/tlssecprofile"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -444,6 +448,37 @@ var _ = Describe("v1 webhooks validator", func() {
 			)
 		})
 
+		Context("validate the feature gates policy", func() {
+			DescribeTable("should apply the policy", func(policy *hcov1.FeatureGatesPolicy, fgs hcov1fg.HyperConvergedFeatureGates, warnings ...string) {
+				cr.Spec.FeatureGates = fgs
+				cr.Spec.FeatureGatesPolicy = policy
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), warnings...)
+			},
+				Entry("no policy; alpha FG is allowed", nil, hcov1fg.HyperConvergedFeatureGates{{Name: "alignCPUs"}}),
+				Entry("warn on alpha FG", &hcov1.FeatureGatesPolicy{Alpha: hcov1.FeatureGatePolicyWarn},
+					hcov1fg.HyperConvergedFeatureGates{{Name: "alignCPUs"}}, "the alignCPUs featureGate is in alpha phase"),
+				Entry("no warning on disabled alpha FG", &hcov1.FeatureGatesPolicy{Alpha: hcov1.FeatureGatePolicyWarn},
+					hcov1fg.HyperConvergedFeatureGates{{Name: "alignCPUs", State: new(hcov1fg.Disabled)}}),
+				Entry("allow deprecated FG", &hcov1.FeatureGatesPolicy{Deprecated: hcov1.FeatureGatePolicyAllow},
+					hcov1fg.HyperConvergedFeatureGates{{Name: "persistentReservation"}}),
+				Entry("remove deprecated FG", &hcov1.FeatureGatesPolicy{Deprecated: hcov1.FeatureGatePolicyRemove},
+					hcov1fg.HyperConvergedFeatureGates{{Name: "persistentReservation"}}, "the persistentReservation featureGate is deprecated; it will be removed"),
+			)
+
+			DescribeTable("should deny feature gates according to the policy", func(policy *hcov1.FeatureGatesPolicy, fgs hcov1fg.HyperConvergedFeatureGates, reason string) {
+				cr.Spec.FeatureGates = fgs
+				cr.Spec.FeatureGatesPolicy = policy
+				checkRejectedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), reason)
+			},
+				Entry("deny alpha FG", &hcov1.FeatureGatesPolicy{Alpha: hcov1.FeatureGatePolicyDeny},
+					hcov1fg.HyperConvergedFeatureGates{{Name: "alignCPUs"}}, "the alignCPUs featureGate is alpha, and it is not allowed by spec.featureGatesPolicy"),
+				Entry("no alpha FGs", &hcov1.FeatureGatesPolicy{Alpha: hcov1.FeatureGatePolicyWarn, NoAlphaFeatureGates: true},
+					hcov1fg.HyperConvergedFeatureGates{{Name: "downwardMetrics"}}, "the downwardMetrics featureGate is alpha, and it is not allowed by spec.featureGatesPolicy"),
+				Entry("deny deprecated FG", &hcov1.FeatureGatesPolicy{Deprecated: hcov1.FeatureGatePolicyDeny},
+					hcov1fg.HyperConvergedFeatureGates{{Name: "persistentReservation", State: new(hcov1fg.Disabled)}}, "the persistentReservation featureGate is deprecated, and it is not allowed by spec.featureGatesPolicy"),
+			)
+		})
+
 		Context("validate affinity", func() {
 			It("should allow empty nodePlacements", func(ctx context.Context) {
 				cr.Spec.Deployment.NodePlacements = &hcov1.NodePlacements{}
@@ -703,6 +738,445 @@ var _ = Describe("v1 webhooks validator", func() {
 					"must have one element",
 				)
 			})
+
+			Context("component node placements", func() {
+				invalidPlacement := func() *sdkapi.NodePlacement {
+					return &sdkapi.NodePlacement{
+						Affinity: &corev1.Affinity{
+							NodeAffinity: &corev1.NodeAffinity{
+								RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+									NodeSelectorTerms: []corev1.NodeSelectorTerm{
+										{
+											MatchExpressions: []corev1.NodeSelectorRequirement{
+												{
+													Key:      "kubernetes.io/os",
+													Operator: "WrongOperator",
+													Values:   []string{"linux"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					}
+				}
+
+				It("should allow component node placements without the infra and workload node placements", func(ctx context.Context) {
+					cr.Spec.Deployment.NodePlacements = &hcov1.NodePlacements{
+						Components: []hcov1.ComponentNodePlacement{
+							{
+								Component:     hcov1.NodePlacementComponentKubeVirtInfra,
+								NodePlacement: sdkapi.NodePlacement{NodeSelector: map[string]string{"pool": "kubevirt"}},
+							},
+							{
+								Component:     hcov1.NodePlacementComponentConsolePlugin,
+								NodePlacement: sdkapi.NodePlacement{NodeSelector: map[string]string{"pool": "console"}},
+							},
+						},
+					}
+
+					checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
+				})
+
+				DescribeTable("should reject invalid component affinity", func(component hcov1.NodePlacementComponent, reason string) {
+					cr.Spec.Deployment.NodePlacements = &hcov1.NodePlacements{
+						Components: []hcov1.ComponentNodePlacement{
+							{Component: component, NodePlacement: *invalidPlacement()},
+						},
+					}
+
+					checkRejectedRequest(
+						wh.validateCreate(GinkgoLogr, dryRun, cr),
+						reason,
+						`Unsupported value: "WrongOperator"`,
+					)
+				},
+					Entry("kubevirt infra", hcov1.NodePlacementComponentKubeVirtInfra, "invalid kubevirtInfra node placement affinity:"),
+					Entry("cdi workload", hcov1.NodePlacementComponentCDIWorkload, "invalid cdiWorkload node placement affinity:"),
+					Entry("networkAddons infra", hcov1.NodePlacementComponentNetworkAddonsInfra, "invalid networkAddonsInfra node placement affinity:"),
+					Entry("aaq workload", hcov1.NodePlacementComponentAAQWorkload, "invalid aaqWorkload node placement affinity:"),
+					Entry("ssp", hcov1.NodePlacementComponentSSP, "invalid ssp node placement affinity:"),
+					Entry("consolePlugin", hcov1.NodePlacementComponentConsolePlugin, "invalid consolePlugin node placement affinity:"),
+					Entry("waspAgent", hcov1.NodePlacementComponentWaspAgent, "invalid waspAgent node placement affinity:"),
+					Entry("migrationController", hcov1.NodePlacementComponentMigrationController, "invalid migrationController node placement affinity:"),
+				)
+			})
+		})
+
+		Context("validate components configuration", func() {
+			It("should accept a valid components configuration", func(ctx context.Context) {
+				cr.Spec.Deployment.Components = &hcov1.ComponentsConfig{
+					ConsolePlugin: &hcov1.ComponentDeploymentConfig{
+						ComponentPodConfig: hcov1.ComponentPodConfig{
+							Resources: &corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("100m"),
+									corev1.ResourceMemory: resource.MustParse("128Mi"),
+								},
+								Limits: corev1.ResourceList{
+									corev1.ResourceMemory: resource.MustParse("128Mi"),
+								},
+							},
+							PriorityClassName: "system-cluster-critical",
+						},
+						Replicas: new(int32(3)),
+					},
+					WaspAgent: &hcov1.ComponentPodConfig{
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceMemory: resource.MustParse("64Mi"),
+							},
+						},
+					},
+				}
+
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
+			})
+
+			DescribeTable("should reject an invalid components configuration", func(components hcov1.ComponentsConfig, reasons ...string) {
+				cr.Spec.Deployment.Components = &components
+
+				checkRejectedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), reasons...)
+			},
+				Entry("consolePlugin request greater than limit", hcov1.ComponentsConfig{
+					ConsolePlugin: &hcov1.ComponentDeploymentConfig{
+						ComponentPodConfig: hcov1.ComponentPodConfig{
+							Resources: &corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+								Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+							},
+						},
+					},
+				}, "invalid consolePlugin configuration:", "the memory request (256Mi) must be less than or equal to the memory limit (128Mi)"),
+				Entry("consoleProxy zero replicas", hcov1.ComponentsConfig{
+					ConsoleProxy: &hcov1.ComponentDeploymentConfig{
+						Replicas: new(int32(0)),
+					},
+				}, "invalid consoleProxy configuration:", "the number of replicas must be at least 1"),
+				Entry("aieWebhook invalid priority class name", hcov1.ComponentsConfig{
+					AIEWebhook: &hcov1.ComponentDeploymentConfig{
+						ComponentPodConfig: hcov1.ComponentPodConfig{
+							PriorityClassName: "Not_Valid",
+						},
+					},
+				}, "invalid aieWebhook configuration:", `invalid priorityClassName "Not_Valid"`),
+				Entry("networkResourcesInjector request greater than limit", hcov1.ComponentsConfig{
+					NetworkResourcesInjector: &hcov1.ComponentDeploymentConfig{
+						ComponentPodConfig: hcov1.ComponentPodConfig{
+							Resources: &corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+								Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+							},
+						},
+					},
+				}, "invalid networkResourcesInjector configuration:", "the cpu request (1) must be less than or equal to the cpu limit (500m)"),
+				Entry("observabilityController invalid priority class name", hcov1.ComponentsConfig{
+					ObservabilityController: &hcov1.ComponentPodConfig{
+						PriorityClassName: "-invalid",
+					},
+				}, "invalid observabilityController configuration:"),
+				Entry("waspAgent request greater than limit", hcov1.ComponentsConfig{
+					WaspAgent: &hcov1.ComponentPodConfig{
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+							Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+						},
+					},
+				}, "invalid waspAgent configuration:", "the memory request (1Gi) must be less than or equal to the memory limit (512Mi)"),
+			)
+		})
+
+		Context("validate high availability configuration", func() {
+			DescribeTable("should accept a minAvailable that is lower than the number of replicas", func(minAvailable intstr.IntOrString, components *hcov1.ComponentsConfig) {
+				cr.Spec.Deployment.HighAvailability = &hcov1.HighAvailabilityConfig{
+					PodDisruptionBudget: &hcov1.PodDisruptionBudgetConfig{MinAvailable: &minAvailable},
+				}
+				cr.Spec.Deployment.Components = components
+
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
+			},
+				Entry("default replicas", intstr.FromInt32(1), nil),
+				Entry("percentage of the default replicas", intstr.FromString("50%"), nil),
+				Entry("more replicas", intstr.FromInt32(2), &hcov1.ComponentsConfig{
+					ConsolePlugin:            &hcov1.ComponentDeploymentConfig{Replicas: new(int32(3))},
+					ConsoleProxy:             &hcov1.ComponentDeploymentConfig{Replicas: new(int32(3))},
+					AIEWebhook:               &hcov1.ComponentDeploymentConfig{Replicas: new(int32(3))},
+					NetworkResourcesInjector: &hcov1.ComponentDeploymentConfig{Replicas: new(int32(3))},
+				}),
+			)
+
+			It("should ignore minAvailable if the PodDisruptionBudgets are disabled", func() {
+				cr.Spec.Deployment.HighAvailability = &hcov1.HighAvailabilityConfig{
+					PodDisruptionBudget: &hcov1.PodDisruptionBudgetConfig{Enable: new(false), MinAvailable: new(intstr.FromInt32(5))},
+				}
+
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
+			})
+
+			DescribeTable("should reject a minAvailable that would block the node drain", func(minAvailable intstr.IntOrString, components *hcov1.ComponentsConfig, reason string) {
+				cr.Spec.Deployment.HighAvailability = &hcov1.HighAvailabilityConfig{
+					PodDisruptionBudget: &hcov1.PodDisruptionBudgetConfig{MinAvailable: &minAvailable},
+				}
+				cr.Spec.Deployment.Components = components
+
+				checkRejectedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), reason)
+			},
+				Entry("equal to the default replicas", intstr.FromInt32(2), nil,
+					"invalid podDisruptionBudget.minAvailable (2): it must be lower than the number of replicas of the consolePlugin Deployment (2)"),
+				Entry("percentage rounded up to the default replicas", intstr.FromString("60%"), nil,
+					"invalid podDisruptionBudget.minAvailable (60%): it must be lower than the number of replicas of the consolePlugin Deployment (2)"),
+				Entry("greater than the configured replicas", intstr.FromInt32(3), &hcov1.ComponentsConfig{
+					ConsolePlugin:            &hcov1.ComponentDeploymentConfig{Replicas: new(int32(4))},
+					ConsoleProxy:             &hcov1.ComponentDeploymentConfig{Replicas: new(int32(4))},
+					AIEWebhook:               &hcov1.ComponentDeploymentConfig{Replicas: new(int32(4))},
+					NetworkResourcesInjector: &hcov1.ComponentDeploymentConfig{Replicas: new(int32(3))},
+				}, "invalid podDisruptionBudget.minAvailable (3): it must be lower than the number of replicas of the networkResourcesInjector Deployment (3)"),
+				Entry("invalid percentage", intstr.FromString("half"), nil, "invalid podDisruptionBudget.minAvailable:"),
+			)
+		})
+
+		Context("validate standalone configuration", func() {
+			It("should accept a valid standalone configuration", func(ctx context.Context) {
+				cr.Spec.Deployment.Standalone = &hcov1.StandaloneConfig{
+					CLIDownloads: &hcov1.CLIDownloadsConfig{
+						Host:          "virtctl.apps.example.com",
+						TLSSecretName: "virtctl-tls",
+					},
+					DeployGoldenImages: new(true),
+				}
+
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
+			})
+
+			DescribeTable("should reject an invalid standalone configuration", func(cliDownloads hcov1.CLIDownloadsConfig, reasons ...string) {
+				cr.Spec.Deployment.Standalone = &hcov1.StandaloneConfig{
+					CLIDownloads: &cliDownloads,
+				}
+
+				checkRejectedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), reasons...)
+			},
+				Entry("invalid host", hcov1.CLIDownloadsConfig{
+					Host: "https://virtctl.example.com",
+				}, "invalid cliDownloads configuration:", `invalid host "https://virtctl.example.com"`),
+				Entry("invalid TLS secret name", hcov1.CLIDownloadsConfig{
+					Host:          "virtctl.example.com",
+					TLSSecretName: "Not_Valid",
+				}, "invalid cliDownloads configuration:", `invalid tlsSecretName "Not_Valid"`),
+			)
+		})
+
+		Context("validate silences", func() {
+			var silence hcov1.AlertSilence
+
+			BeforeEach(func() {
+				silence = hcov1.AlertSilence{
+					Name: "maintenance",
+					Matchers: []hcov1.AlertSilenceMatcher{
+						{Name: "alertname", Value: "KubeVirt.*", Type: hcov1.AlertSilenceMatcherRegex},
+					},
+					Duration:    &metav1.Duration{Duration: 24 * time.Hour},
+					RenewBefore: &metav1.Duration{Duration: 2 * time.Hour},
+				}
+			})
+
+			It("should accept a valid silence", func(ctx context.Context) {
+				cr.Spec.Observability = &hcov1.ObservabilityConfig{Silences: []hcov1.AlertSilence{silence}}
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
+			})
+
+			DescribeTable("should reject an invalid silence", func(modify func(*hcov1.AlertSilence), reason string) {
+				modify(&silence)
+				cr.Spec.Observability = &hcov1.ObservabilityConfig{Silences: []hcov1.AlertSilence{silence}}
+
+				checkRejectedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), `invalid silence "maintenance":`, reason)
+			},
+				Entry("invalid regular expression", func(s *hcov1.AlertSilence) {
+					s.Matchers[0].Value = "KubeVirt.*("
+				}, `invalid regular expression for the "alertname" matcher`),
+				Entry("renewBefore is too short", func(s *hcov1.AlertSilence) {
+					s.RenewBefore = &metav1.Duration{Duration: 30 * time.Minute}
+				}, "renewBefore must be at least one hour"),
+				Entry("duration is not greater than renewBefore", func(s *hcov1.AlertSilence) {
+					s.Duration = &metav1.Duration{Duration: 2 * time.Hour}
+				}, "duration must be greater than renewBefore"),
+			)
+		})
+
+		Context("validate alert overrides", func() {
+			It("should accept a valid alert override", func(ctx context.Context) {
+				cr.Spec.Observability = &hcov1.ObservabilityConfig{
+					AlertOverrides: []hcov1.AlertOverride{
+						{Alert: "HighCPUWorkload", Threshold: "0.8", Labels: map[string]string{"team": "sre"}},
+					},
+				}
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
+			})
+
+			It("should reject an override of an unknown alert", func(ctx context.Context) {
+				cr.Spec.Observability = &hcov1.ObservabilityConfig{
+					AlertOverrides: []hcov1.AlertOverride{
+						{Alert: "NotAnAlert", Severity: hcov1.AlertSeverityCritical},
+					},
+				}
+				checkRejectedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), "invalid alert override for NotAnAlert: unknown alert")
+			})
+
+			It("should accept a valid alerting configuration", func(ctx context.Context) {
+				cr.Spec.Observability = &hcov1.ObservabilityConfig{
+					Alerting: &hcov1.AlertingConfig{
+						RunbookBaseURL:   "https://runbooks.example.com/kubevirt/",
+						AdditionalLabels: map[string]string{"team": "virt"},
+						SeverityMapping: []hcov1.AlertSeverityMapping{
+							{From: hcov1.AlertSeverityCritical, To: hcov1.AlertSeverityWarning},
+						},
+					},
+				}
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
+			})
+
+			It("should reject a severity label in the additional labels", func(ctx context.Context) {
+				cr.Spec.Observability = &hcov1.ObservabilityConfig{
+					Alerting: &hcov1.AlertingConfig{
+						AdditionalLabels: map[string]string{"severity": "info"},
+					},
+				}
+				checkRejectedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), "invalid alerting configuration: use the severityMapping field")
+			})
+		})
+
+		Context("validate AIE rules", func() {
+			var rule hcov1.AIELauncherRule
+
+			BeforeEach(func() {
+				cr.Annotations = map[string]string{aie.DeployAIEAnnotation: "true"}
+				cr.Spec.Virtualization.PermittedHostDevices = &hcov1.PermittedHostDevices{
+					PciHostDevices: []hcov1.PciHostDevice{
+						{PCIDeviceSelector: "10DE:1DB4", ResourceName: "nvidia.com/GV100GL_Tesla_V100"},
+						{PCIDeviceSelector: "10DE:1EB8", ResourceName: "nvidia.com/TU104GL_Tesla_T4", Disabled: true},
+					},
+				}
+
+				rule = hcov1.AIELauncherRule{
+					Name:         "gpu-optimized-launcher",
+					Image:        "quay.io/my-org/virt-launcher-gpu:latest",
+					DeviceNames:  []string{"nvidia.com/GV100GL_Tesla_V100"},
+					VMLabels:     map[string]string{"my-org.io/use-custom-launcher": "true"},
+					NodeSelector: map[string]string{"nvidia.com/gpu.present": "true"},
+				}
+			})
+
+			It("should accept valid rules", func(ctx context.Context) {
+				cr.Spec.Virtualization.AIE = &hcov1.AIEConfig{Rules: []hcov1.AIELauncherRule{rule}}
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
+			})
+
+			It("should warn about a device that is not enabled in permittedHostDevices", func(ctx context.Context) {
+				rule.DeviceNames = append(rule.DeviceNames, "nvidia.com/TU104GL_Tesla_T4")
+				cr.Spec.Virtualization.AIE = &hcov1.AIEConfig{Rules: []hcov1.AIELauncherRule{rule}}
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr),
+					`the "nvidia.com/TU104GL_Tesla_T4" device of the "gpu-optimized-launcher" rule is not enabled in spec.virtualization.permittedHostDevices`)
+			})
+
+			It("should warn if the AIE webhook is not deployed", func(ctx context.Context) {
+				cr.Annotations = nil
+				cr.Spec.Virtualization.AIE = &hcov1.AIEConfig{Rules: []hcov1.AIELauncherRule{rule}}
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), "the AIE webhook is not deployed, and the rules are not applied")
+			})
+
+			DescribeTable("should reject an invalid rule", func(modify func(*hcov1.AIELauncherRule), reason string) {
+				modify(&rule)
+				cr.Spec.Virtualization.AIE = &hcov1.AIEConfig{Rules: []hcov1.AIELauncherRule{rule}}
+
+				checkRejectedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), `invalid AIE rule "gpu-optimized-launcher":`, reason)
+			},
+				Entry("invalid image", func(r *hcov1.AIELauncherRule) {
+					r.Image = "quay.io/My-Org/virt-launcher-gpu:latest"
+				}, `invalid image "quay.io/My-Org/virt-launcher-gpu:latest"`),
+				Entry("no selector", func(r *hcov1.AIELauncherRule) {
+					r.DeviceNames = nil
+					r.VMLabels = nil
+				}, "at least one of deviceNames and vmLabels must be set"),
+				Entry("invalid device name", func(r *hcov1.AIELauncherRule) {
+					r.DeviceNames = []string{"nvidia.com/Tesla V100"}
+				}, `invalid device name "nvidia.com/Tesla V100"`),
+				Entry("invalid vmLabels key", func(r *hcov1.AIELauncherRule) {
+					r.VMLabels = map[string]string{"my-org.io/use custom launcher": "true"}
+				}, `invalid vmLabels: invalid label key "my-org.io/use custom launcher"`),
+				Entry("invalid nodeSelector value", func(r *hcov1.AIELauncherRule) {
+					r.NodeSelector = map[string]string{"nvidia.com/gpu.present": "not valid"}
+				}, `invalid nodeSelector: invalid value "not valid" of the "nvidia.com/gpu.present" label`),
+			)
+		})
+
+		Context("validate migration policies", func() {
+			var profiles []hcov1.MigrationPolicyProfile
+
+			BeforeEach(func() {
+				profiles = []hcov1.MigrationPolicyProfile{
+					{
+						Name:          "latency-sensitive",
+						VMSelector:    map[string]string{"workload": "latency-sensitive"},
+						AllowPostCopy: new(true),
+					},
+					{
+						Name:                  "bulk",
+						NamespaceSelector:     map[string]string{"tier": "batch"},
+						VMSelector:            map[string]string{"workload": "bulk"},
+						BandwidthPerMigration: new("64Mi"),
+					},
+				}
+			})
+
+			It("should accept valid migration policies", func() {
+				cr.Spec.Virtualization.MigrationPolicies = profiles
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
+			})
+
+			It("should accept migration policies that select the same namespaces, but different VMs", func() {
+				profiles[0].NamespaceSelector = map[string]string{"tier": "batch"}
+				cr.Spec.Virtualization.MigrationPolicies = profiles
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
+			})
+
+			DescribeTable("should reject overlapping migration policies", func(modify func([]hcov1.MigrationPolicyProfile)) {
+				modify(profiles)
+				cr.Spec.Virtualization.MigrationPolicies = profiles
+
+				checkRejectedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), `the selectors of the "latency-sensitive" and the "bulk" migration policies overlap`)
+			},
+				Entry("same selectors", func(p []hcov1.MigrationPolicyProfile) {
+					p[1].NamespaceSelector = nil
+					p[1].VMSelector = map[string]string{"workload": "latency-sensitive"}
+				}),
+				Entry("a VM selector that is a subset of the other", func(p []hcov1.MigrationPolicyProfile) {
+					p[1].VMSelector = map[string]string{"workload": "latency-sensitive", "size": "large"}
+				}),
+				Entry("different labels", func(p []hcov1.MigrationPolicyProfile) {
+					p[1].VMSelector = map[string]string{"size": "large"}
+				}),
+				Entry("no selectors", func(p []hcov1.MigrationPolicyProfile) {
+					p[1].NamespaceSelector = nil
+					p[1].VMSelector = nil
+				}),
+			)
+
+			DescribeTable("should reject an invalid migration policy", func(modify func(*hcov1.MigrationPolicyProfile), reason string) {
+				modify(&profiles[1])
+				cr.Spec.Virtualization.MigrationPolicies = profiles
+
+				checkRejectedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), `invalid migration policy "bulk":`, reason)
+			},
+				Entry("invalid bandwidth", func(p *hcov1.MigrationPolicyProfile) {
+					p.BandwidthPerMigration = new("64 MiB")
+				}, `invalid bandwidthPerMigration "64 MiB"`),
+				Entry("invalid namespaceSelector key", func(p *hcov1.MigrationPolicyProfile) {
+					p.NamespaceSelector = map[string]string{"my tier": "batch"}
+				}, `invalid namespaceSelector: invalid label key "my tier"`),
+				Entry("invalid vmSelector value", func(p *hcov1.MigrationPolicyProfile) {
+					p.VMSelector = map[string]string{"workload": "not valid"}
+				}, `invalid vmSelector: invalid value "not valid" of the "workload" label`),
+			)
 		})
 
 		Context("validate tuning policy", func() {
@@ -716,6 +1190,118 @@ var _ = Describe("v1 webhooks validator", func() {
 				cr.Spec.Virtualization.TuningPolicy = ""
 				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
 			})
+
+			DescribeTable("should accept the predefined and the auto tuning policies", func(policy hcov1.HyperConvergedTuningPolicy) {
+				cr.Spec.Virtualization.TuningPolicy = policy
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
+			},
+				Entry("small", hcov1.HyperConvergedSmallTuningPolicy),
+				Entry("medium", hcov1.HyperConvergedMediumTuningPolicy),
+				Entry("large", hcov1.HyperConvergedLargeTuningPolicy),
+				Entry("auto", hcov1.HyperConvergedAutoTuningPolicy),
+			)
+
+			It("should accept the custom tuning policy with the tuning field", func() {
+				cr.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedCustomTuningPolicy
+				cr.Spec.Virtualization.Tuning = &hcov1.TuningConfig{
+					Handler: &hcov1.RateLimit{QPS: 30, Burst: 60},
+				}
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
+			})
+
+			It("should reject the custom tuning policy without the tuning field", func() {
+				cr.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedCustomTuningPolicy
+				checkRejectedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), "the custom tuning policy is set, but spec.virtualization.tuning is not set")
+			})
+
+			It("should reject the custom tuning policy without the tuning field, without creating the KubeVirt CR", func() {
+				cr.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedCustomTuningPolicy
+
+				warnings, err := wh.validateTuningPolicy(cr)
+				Expect(err).To(MatchError("the custom tuning policy is set, but spec.virtualization.tuning is not set"))
+				Expect(warnings).To(BeEmpty())
+			})
+
+			It("should return warning when the tuning field is set without the custom tuning policy", func() {
+				cr.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedLargeTuningPolicy
+				cr.Spec.Virtualization.Tuning = &hcov1.TuningConfig{
+					Handler: &hcov1.RateLimit{QPS: 30, Burst: 60},
+				}
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), "the rate limits are ignored, unless spec.virtualization.tuningPolicy is set to custom")
+			})
+		})
+
+		Context("validate overrides", func() {
+			DescribeTable("should accept valid overrides", func(override hcov1.OperandOverride) {
+				cr.Spec.Overrides = []hcov1.OperandOverride{override}
+				checkAcceptedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr))
+			},
+				Entry("KubeVirt JSONPatch", hcov1.OperandOverride{
+					Target: hcov1.OperandOverrideTargetKubeVirt,
+					Type:   hcov1.OperandOverridePatchTypeJSONPatch,
+					JSONPatch: []hcov1.JSONPatchOperation{
+						{Op: "add", Path: "/spec/configuration/cpuRequest", Value: &apiextensionsv1.JSON{Raw: []byte(`"12m"`)}},
+					},
+				}),
+				Entry("CDI StrategicMerge", hcov1.OperandOverride{
+					Target:              hcov1.OperandOverrideTargetCDI,
+					Type:                hcov1.OperandOverridePatchTypeStrategicMerge,
+					StrategicMergePatch: &apiextensionsv1.JSON{Raw: []byte(`{"spec": {"config": {"featureGates": ["fg1"]}}}`)},
+				}),
+				Entry("NetworkAddonsConfig JSONPatch", hcov1.OperandOverride{
+					Target: hcov1.OperandOverrideTargetNetworkAddonsConfig,
+					JSONPatch: []hcov1.JSONPatchOperation{
+						{Op: "add", Path: "/spec/kubeMacPool/rangeStart", Value: &apiextensionsv1.JSON{Raw: []byte(`"1.1.1.1.1.1"`)}},
+					},
+				}),
+				Entry("SSP StrategicMerge", hcov1.OperandOverride{
+					Target:              hcov1.OperandOverrideTargetSSP,
+					Type:                hcov1.OperandOverridePatchTypeStrategicMerge,
+					StrategicMergePatch: &apiextensionsv1.JSON{Raw: []byte(`{"spec": {"templateValidator": {"replicas": 5}}}`)},
+				}),
+			)
+
+			DescribeTable("should reject invalid overrides", func(override hcov1.OperandOverride, reasons ...string) {
+				cr.Spec.Overrides = []hcov1.OperandOverride{override}
+				checkRejectedRequest(wh.validateCreate(GinkgoLogr, dryRun, cr), reasons...)
+			},
+				Entry("unknown field", hcov1.OperandOverride{
+					Target: hcov1.OperandOverrideTargetKubeVirt,
+					JSONPatch: []hcov1.JSONPatchOperation{
+						{Op: "add", Path: "/spec/configuration/notAField", Value: &apiextensionsv1.JSON{Raw: []byte(`true`)}},
+					},
+				}, "invalid spec.overrides[0] for KubeVirt", `unknown field "notAField"`),
+				Entry("missing path", hcov1.OperandOverride{
+					Target: hcov1.OperandOverrideTargetCDI,
+					JSONPatch: []hcov1.JSONPatchOperation{
+						{Op: "replace", Path: "/spec/notExists/field", Value: &apiextensionsv1.JSON{Raw: []byte(`true`)}},
+					},
+				}, "invalid spec.overrides[0] for CDI"),
+				Entry("non-spec field", hcov1.OperandOverride{
+					Target:              hcov1.OperandOverrideTargetNetworkAddonsConfig,
+					Type:                hcov1.OperandOverridePatchTypeStrategicMerge,
+					StrategicMergePatch: &apiextensionsv1.JSON{Raw: []byte(`{"metadata": {"name": "other"}}`)},
+				}, "invalid spec.overrides[0] for NetworkAddonsConfig", "can only modify spec fields"),
+				Entry("unknown target", hcov1.OperandOverride{
+					Target: "Unknown",
+					JSONPatch: []hcov1.JSONPatchOperation{
+						{Op: "add", Path: "/spec/field", Value: &apiextensionsv1.JSON{Raw: []byte(`true`)}},
+					},
+				}, `spec.overrides: unknown target "Unknown"`),
+			)
+
+			It("should warn about SSP overrides on non-OpenShift clusters", func(ctx context.Context) {
+				cr.Spec.Overrides = []hcov1.OperandOverride{
+					{
+						Target:              hcov1.OperandOverrideTargetSSP,
+						Type:                hcov1.OperandOverridePatchTypeStrategicMerge,
+						StrategicMergePatch: &apiextensionsv1.JSON{Raw: []byte(`{"spec": {"templateValidator": {"replicas": 5}}}`)},
+					},
+				}
+
+				k8sWH := NewWebhookHandler(GinkgoLogr, cli, decoder, HcoValidNamespace, false)
+				checkAcceptedRequest(k8sWH.validateCreate(GinkgoLogr, dryRun, cr), "SSP is not deployed on this cluster")
+			})
 		})
 	})
 
@@ -1398,6 +1984,27 @@ var _ = Describe("v1 webhooks validator", func() {
 			})
 		})
 
+		Context("validate the feature gates policy on update", func() {
+			It("should deny enabling an alpha feature gate, if the alpha feature gates are not allowed", func(ctx context.Context) {
+				newHCO := cr.DeepCopy()
+				newHCO.Spec.FeatureGatesPolicy = &hcov1.FeatureGatesPolicy{NoAlphaFeatureGates: true}
+				newHCO.Spec.FeatureGates = hcov1fg.HyperConvergedFeatureGates{{Name: "alignCPUs"}}
+
+				checkRejectedRequest(
+					wh.validateUpdate(ctx, GinkgoLogr, dryRun, newHCO, cr),
+					"the alignCPUs featureGate is alpha, and it is not allowed by spec.featureGatesPolicy",
+				)
+			})
+
+			It("should allow an alpha feature gate that is already enabled", func(ctx context.Context) {
+				cr.Spec.FeatureGates = hcov1fg.HyperConvergedFeatureGates{{Name: "alignCPUs"}}
+				newHCO := cr.DeepCopy()
+				newHCO.Spec.FeatureGatesPolicy = &hcov1.FeatureGatesPolicy{NoAlphaFeatureGates: true}
+
+				checkAcceptedRequest(wh.validateUpdate(ctx, GinkgoLogr, dryRun, newHCO, cr))
+			})
+		})
+
 		Context("validate tuning policy on update", func() {
 
 			It("should return warning for deprecated highBurst tuning policy", func(ctx context.Context) {
@@ -1412,6 +2019,50 @@ var _ = Describe("v1 webhooks validator", func() {
 				newHCO.Spec.Virtualization.TuningPolicy = ""
 				checkAcceptedRequest(wh.validateUpdate(ctx, GinkgoLogr, dryRun, newHCO, cr))
 			})
+
+			It("should reject the custom tuning policy without the tuning field", func(ctx context.Context) {
+				newHCO := cr.DeepCopy()
+				newHCO.Spec.Virtualization.TuningPolicy = hcov1.HyperConvergedCustomTuningPolicy
+				checkRejectedRequest(wh.validateUpdate(ctx, GinkgoLogr, dryRun, newHCO, cr), "the custom tuning policy is set, but spec.virtualization.tuning is not set")
+			})
+		})
+
+		Context("validate overrides on update", func() {
+			It("should accept a valid override", func(ctx context.Context) {
+				newHCO := cr.DeepCopy()
+				newHCO.Spec.Overrides = []hcov1.OperandOverride{
+					{
+						Target: hcov1.OperandOverrideTargetKubeVirt,
+						JSONPatch: []hcov1.JSONPatchOperation{
+							{Op: "add", Path: "/spec/configuration/cpuRequest", Value: &apiextensionsv1.JSON{Raw: []byte(`"12m"`)}},
+						},
+					},
+				}
+				checkAcceptedRequest(wh.validateUpdate(ctx, GinkgoLogr, dryRun, newHCO, cr))
+			})
+
+			It("should reject an invalid override", func(ctx context.Context) {
+				newHCO := cr.DeepCopy()
+				newHCO.Spec.Overrides = []hcov1.OperandOverride{
+					{
+						Target: hcov1.OperandOverrideTargetKubeVirt,
+						JSONPatch: []hcov1.JSONPatchOperation{
+							{Op: "add", Path: "/spec/configuration/cpuRequest", Value: &apiextensionsv1.JSON{Raw: []byte(`"12m"`)}},
+						},
+					},
+					{
+						Target: hcov1.OperandOverrideTargetKubeVirt,
+						JSONPatch: []hcov1.JSONPatchOperation{
+							{Op: "add", Path: "/spec/configuration/notAField", Value: &apiextensionsv1.JSON{Raw: []byte(`true`)}},
+						},
+					},
+				}
+				checkRejectedRequest(
+					wh.validateUpdate(ctx, GinkgoLogr, dryRun, newHCO, cr),
+					"invalid spec.overrides[1] for KubeVirt",
+					`unknown field "notAField"`,
+				)
+			})
 		})
 	})
 
@@ -1466,6 +2117,39 @@ var _ = Describe("v1 webhooks validator", func() {
 			Expect(util.GetRuntimeObject(ctx, cli, cdi)).To(Succeed())
 		})
 
+		Context("uninstall strategy is BlockUninstallAndReportWorkloads", func() {
+			BeforeEach(func() {
+				cr.Spec.Deployment.UninstallStrategy = hcov1.HyperConvergedUninstallStrategyBlockUninstallAndReportWorkloads
+			})
+
+			It("should reject, and list the workloads by namespace, if workloads exist", func(ctx context.Context) {
+				Expect(cli.Create(ctx, &kubevirtcorev1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "ns1"}})).To(Succeed())
+				Expect(cli.Create(ctx, &cdiv1beta1.DataVolume{ObjectMeta: metav1.ObjectMeta{Name: "dv1", Namespace: "ns2"}})).To(Succeed())
+
+				req := newRequest(admissionv1.Delete, cr, hcoCodec, false)
+				checkRejectedRequest(wh.Handle(ctx, req),
+					"can't remove the HyperConverged CR while workloads still exist",
+					"namespace ns1: VirtualMachines (1): vm1. namespace ns2: DataVolumes (1): dv1",
+				)
+
+				By("Validate that KV still exists")
+				Expect(util.GetRuntimeObject(ctx, cli, handlers.NewKubeVirtWithNameOnly())).To(Succeed())
+			})
+
+			It("should accept if there are no workloads", func(ctx context.Context) {
+				req := newRequest(admissionv1.Delete, cr, hcoCodec, false)
+				checkAcceptedRequest(wh.Handle(ctx, req))
+			})
+		})
+
+		It("should not list the workloads, if the uninstall strategy is RemoveWorkloads", func(ctx context.Context) {
+			cr.Spec.Deployment.UninstallStrategy = hcov1.HyperConvergedUninstallStrategyRemoveWorkloads
+			Expect(cli.Create(ctx, &kubevirtcorev1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "ns1"}})).To(Succeed())
+
+			req := newRequest(admissionv1.Delete, cr, hcoCodec, false)
+			checkAcceptedRequest(wh.Handle(ctx, req))
+		})
+
 		It("should reject if KV deletion fails", func(ctx context.Context) {
 			cli.(*commontestutils.HcoTestClient).InitiateDeleteErrors(func(obj client.Object) error {
 				if unstructed, ok := obj.(runtime.Unstructured); ok {
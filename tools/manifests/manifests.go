@@ -15,6 +15,7 @@ import (
 
 	cnaoapi "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
 	kvapi "kubevirt.io/api/core"
+	kvmigrationsapi "kubevirt.io/api/migrations"
 	aaqapi "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core"
 	cdiapi "kubevirt.io/containerized-data-importer-api/pkg/apis/core"
 	migrationapi "kubevirt.io/kubevirt-migration-operator/api/v1alpha1"
@@ -517,6 +518,11 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 			Resources: stringListToSlice("virtualmachines", "virtualmachineinstancemigrations"),
 			Verbs:     stringListToSlice("get", "list", "watch"),
 		},
+		{
+			APIGroups: stringListToSlice(kvmigrationsapi.GroupName),
+			Resources: stringListToSlice("migrationpolicies"),
+			Verbs:     stringListToSlice("get", "list", "watch", "create", "update", "delete"),
+		},
 		{
 			APIGroups: stringListToSlice("instancetype.kubevirt.io"),
 			Resources: stringListToSlice("virtualmachineinstancetypes", "virtualmachineclusterinstancetypes", "virtualmachinepreferences", "virtualmachineclusterpreferences"),
@@ -533,6 +539,16 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 			Verbs:     stringListToSlice("create"),
 		},
 		roleWithAllPermissions(cdiapi.GroupName, stringListToSlice("cdis", "cdis/finalizers")),
+		{
+			APIGroups: stringListToSlice(cdiapi.GroupName),
+			Resources: stringListToSlice("datavolumes"),
+			Verbs:     stringListToSlice("get", "list"),
+		},
+		{
+			APIGroups: stringListToSlice(cdiapi.GroupName),
+			Resources: stringListToSlice("dataimportcrons"),
+			Verbs:     stringListToSlice("get", "list", "watch", "create", "update", "delete"),
+		},
 		roleWithAllPermissions(sspapi.GroupVersion.Group, stringListToSlice("ssps", "ssps/finalizers")),
 		roleWithAllPermissions(cnaoapi.GroupVersion.Group, stringListToSlice("networkaddonsconfigs", "networkaddonsconfigs/finalizers")),
 		roleWithAllPermissions(aaqapi.GroupName, stringListToSlice("aaqs", "aaqs/finalizers")),
@@ -681,7 +697,7 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 		},
 		{
 			APIGroups: stringListToSlice(networkingv1.GroupName),
-			Resources: stringListToSlice("networkpolicies"),
+			Resources: stringListToSlice("networkpolicies", "ingresses"),
 			Verbs:     stringListToSlice("get", "list", "watch", "create", "update", "delete"),
 		},
 		{
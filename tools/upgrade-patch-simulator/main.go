@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/blang/semver/v4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/upgradepatch"
+)
+
+var (
+	crFile      string
+	version     string
+	patchesFile string
+	format      string
+	outputFile  string
+)
+
+func init() {
+	flag.StringVar(&crFile, "cr", "", "path to the HyperConverged CR file (yaml or json); v1 and v1beta1 CRs are supported")
+	flag.StringVar(&version, "version", "", "the HCO version to upgrade from, e.g. 1.18.5")
+	flag.StringVar(&patchesFile, "patches", "assets/upgradePatches.json", "path to the upgrade patches file")
+	flag.StringVar(&format, "format", "yaml", `output format. May be "json" or "yaml"`)
+	flag.StringVar(&outputFile, "out", "", "output file name. The output is written to the standard output if empty")
+}
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	if err := validateFlags(); err != nil {
+		return err
+	}
+
+	knownHcoSV, err := semver.ParseTolerant(version)
+	if err != nil {
+		return fmt.Errorf("can't parse the version %q; %w", version, err)
+	}
+
+	hc, err := readHyperConverged(crFile)
+	if err != nil {
+		return fmt.Errorf("can't read the HyperConverged CR from %s; %w", crFile, err)
+	}
+
+	patches, err := readUpgradePatches(patchesFile)
+	if err != nil {
+		return fmt.Errorf("can't read the upgrade patches from %s; %w", patchesFile, err)
+	}
+
+	result, err := patches.Simulate(hc, knownHcoSV)
+	if err != nil {
+		return fmt.Errorf("can't simulate the upgrade; %w", err)
+	}
+
+	// the whole output is rendered before writing it, so a failure does not leave a partial output file
+	buff := &bytes.Buffer{}
+	if err = writeResult(result, buff); err != nil {
+		return fmt.Errorf("can't write the simulation result; %w", err)
+	}
+
+	if outputFile == "" {
+		_, err = os.Stdout.Write(buff.Bytes())
+		return err
+	}
+
+	if err = os.WriteFile(outputFile, buff.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("can't write the output file %s; %w", outputFile, err)
+	}
+
+	return nil
+}
+
+func validateFlags() error {
+	switch format {
+	case "json", "yaml":
+	default:
+		return errors.New("format must be one of [json, yaml]")
+	}
+
+	if crFile == "" {
+		return errors.New("missing the --cr flag")
+	}
+
+	if version == "" {
+		return errors.New("missing the --version flag")
+	}
+
+	return nil
+}
+
+func readHyperConverged(fileName string) (*hcov1.HyperConverged, error) {
+	crBytes, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	// YAMLToJSON also accepts json input
+	crJSON, err := yaml.YAMLToJSON(crBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	typeMeta := metav1.TypeMeta{}
+	if err = json.Unmarshal(crJSON, &typeMeta); err != nil {
+		return nil, err
+	}
+
+	if typeMeta.Kind != "" && typeMeta.Kind != "HyperConverged" {
+		return nil, fmt.Errorf("unexpected kind %q", typeMeta.Kind)
+	}
+
+	hc := &hcov1.HyperConverged{}
+	switch typeMeta.APIVersion {
+	case hcov1.APIVersion, "":
+		if err = json.Unmarshal(crJSON, hc); err != nil {
+			return nil, err
+		}
+
+	case hcov1beta1.APIVersion:
+		v1beta1HC := &hcov1beta1.HyperConverged{}
+		if err = json.Unmarshal(crJSON, v1beta1HC); err != nil {
+			return nil, err
+		}
+
+		if err = v1beta1HC.ConvertTo(hc); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported API version %q", typeMeta.APIVersion)
+	}
+
+	hc.TypeMeta = metav1.TypeMeta{
+		APIVersion: hcov1.APIVersion,
+		Kind:       "HyperConverged",
+	}
+
+	return hc, nil
+}
+
+func readUpgradePatches(fileName string) (upgradepatch.UpgradePatches, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return upgradepatch.UpgradePatches{}, err
+	}
+	defer file.Close()
+
+	return upgradepatch.ReadUpgradePatches(file)
+}
+
+func writeResult(result *upgradepatch.SimulationResult, w io.Writer) error {
+	buff := &bytes.Buffer{}
+	enc := json.NewEncoder(buff)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(result); err != nil {
+		return err
+	}
+
+	if format == "json" {
+		_, err := w.Write(buff.Bytes())
+		return err
+	}
+
+	yamlBytes, err := yaml.JSONToYAML(buff.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(yamlBytes)
+	return err
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/upgradepatch"
+)
+
+func TestUpgradePatchSimulator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Upgrade Patch Simulator Suite")
+}
+
+var _ = Describe("upgrade-patch-simulator", func() {
+	BeforeEach(func() {
+		crFile = "testdata/hyperconverged.yaml"
+		version = "1.18.5"
+		patchesFile = "../../pkg/upgradepatch/test-files/upgradePatches.json"
+		format = "yaml"
+		outputFile = filepath.Join(GinkgoT().TempDir(), "result.yaml")
+	})
+
+	readResult := func() *upgradepatch.SimulationResult {
+		GinkgoHelper()
+
+		resultBytes, err := os.ReadFile(outputFile)
+		Expect(err).ToNot(HaveOccurred())
+
+		result := &upgradepatch.SimulationResult{}
+		Expect(yaml.Unmarshal(resultBytes, result)).To(Succeed())
+		return result
+	}
+
+	It("should apply the upgrade patches on the CR", func() {
+		Expect(run()).To(Succeed())
+
+		result := readResult()
+
+		Expect(result.HyperConverged.APIVersion).To(Equal("hco.kubevirt.io/v1"))
+		Expect(result.HyperConverged.Spec.Virtualization.VirtualMachineOptions.DisableFreePageReporting).To(HaveValue(BeTrue()))
+		Expect(result.HyperConverged.Spec.Virtualization.VirtualMachineOptions.DisableSerialConsoleLog).To(BeNil())
+
+		Expect(result.Patches).To(HaveLen(3))
+		for _, p := range result.Patches {
+			Expect(p.Status).To(Equal(upgradepatch.PatchApplied))
+		}
+
+		Expect(result.ObjectsToBeRemoved).ToNot(BeEmpty())
+	})
+
+	It("should remove the wrong KubeVirt JSON patch, as in the upgrade", func() {
+		Expect(run()).To(Succeed())
+
+		result := readResult()
+
+		Expect(result.RemovedWrongJSONPatch).To(BeTrue())
+		Expect(result.HyperConverged.Annotations).To(HaveKeyWithValue(
+			common.JSONPatchKVAnnotationName,
+			MatchJSON(`[{"op": "add", "path": "/spec/configuration/developerConfiguration/featureGates/-", "value": "Sidecar"}]`),
+		))
+	})
+
+	It("should not write the output file if the simulation fails", func() {
+		patchesFile = "../../pkg/upgradepatch/test-files/badJson.json"
+
+		Expect(run()).To(MatchError(ContainSubstring("can't read the upgrade patches")))
+		Expect(outputFile).ToNot(BeAnExistingFile())
+	})
+
+	It("should reject an unknown output format", func() {
+		format = "xml"
+
+		Expect(run()).To(MatchError("format must be one of [json, yaml]"))
+	})
+})